@@ -99,6 +99,44 @@ type ACP interface {
 		docID string,
 	) (bool, error)
 
+	// AddDocActorRelationship creates a relationship between the document and the target actor.
+	//
+	// If failure occurs, the result will return an error. Upon success the boolean value will
+	// be true if the relationship already existed (no-op), and false if a new relationship was made.
+	//
+	// Note(s):
+	// - The request actor must have a relation on the document that is allowed to manage the
+	//   target relation (i.e. the owner, or a relation that `manages` the target relation).
+	// - If the target actor is "*", then the relationship applies to all actors implicitly.
+	AddDocActorRelationship(
+		ctx context.Context,
+		policyID string,
+		resourceName string,
+		docID string,
+		relation string,
+		requestActor identity.Identity,
+		targetActor string,
+	) (bool, error)
+
+	// DeleteDocActorRelationship deletes a relationship between the document and the target actor.
+	//
+	// If failure occurs, the result will return an error. Upon success the boolean value will
+	// be true if the relationship record was found and deleted, and false if it was not found (no-op).
+	//
+	// Note(s):
+	// - The request actor must have a relation on the document that is allowed to manage the
+	//   target relation (i.e. the owner, or a relation that `manages` the target relation).
+	// - If the target actor is "*", then the implicit relationship with all actors is deleted.
+	DeleteDocActorRelationship(
+		ctx context.Context,
+		policyID string,
+		resourceName string,
+		docID string,
+		relation string,
+		requestActor identity.Identity,
+		targetActor string,
+	) (bool, error)
+
 	// SupportsP2P returns true if the implementation supports ACP across a peer network.
 	SupportsP2P() bool
 }
@@ -236,3 +236,73 @@ func (l *ACPLocal) VerifyAccessRequest(
 
 	return resp.Valid, nil
 }
+
+func (l *ACPLocal) AddActorRelationship(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+	objectID string,
+	relation string,
+	requester identity.Identity,
+	targetActor string,
+	creationTime *protoTypes.Timestamp,
+) (bool, error) {
+	principal, err := auth.NewDIDPrincipal(requester.DID)
+	if err != nil {
+		return false, newErrInvalidActorID(err, requester.DID)
+	}
+
+	ctx = auth.InjectPrincipal(ctx, principal)
+
+	req := types.SetRelationshipRequest{
+		PolicyId:     policyID,
+		Relationship: newActorRelationship(resourceName, objectID, relation, targetActor),
+		CreationTime: creationTime,
+	}
+
+	setRelationshipResponse, err := l.engine.SetRelationship(ctx, &req)
+	if err != nil {
+		return false, err
+	}
+
+	return setRelationshipResponse.RecordExisted, nil
+}
+
+func (l *ACPLocal) DeleteActorRelationship(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+	objectID string,
+	relation string,
+	requester identity.Identity,
+	targetActor string,
+) (bool, error) {
+	principal, err := auth.NewDIDPrincipal(requester.DID)
+	if err != nil {
+		return false, newErrInvalidActorID(err, requester.DID)
+	}
+
+	ctx = auth.InjectPrincipal(ctx, principal)
+
+	req := types.DeleteRelationshipRequest{
+		PolicyId:     policyID,
+		Relationship: newActorRelationship(resourceName, objectID, relation, targetActor),
+	}
+
+	deleteRelationshipResponse, err := l.engine.DeleteRelationship(ctx, &req)
+	if err != nil {
+		return false, err
+	}
+
+	return deleteRelationshipResponse.RecordFound, nil
+}
+
+// newActorRelationship returns the relationship tying the target actor to the given object.
+//
+// If the target actor is "*" the relationship applies to all actors.
+func newActorRelationship(resourceName, objectID, relation, targetActor string) *types.Relationship {
+	if targetActor == allActorsTarget {
+		return types.NewAllActorsRelationship(resourceName, objectID, relation)
+	}
+	return types.NewActorRelationship(resourceName, objectID, relation, targetActor)
+}
@@ -663,6 +663,139 @@ func Test_LocalACP_PersistentMemory_CheckDocAccess_TrueIfHaveAccessFalseIfNotErr
 	require.Nil(t, errClose)
 }
 
+func Test_LocalACP_InMemory_AddAndDeleteDocActorRelationship_AccessChangesImmediately(t *testing.T) {
+	ctx := context.Background()
+	localACP := NewLocalACP()
+
+	localACP.Init(ctx, "")
+	errStart := localACP.Start(ctx)
+	require.Nil(t, errStart)
+
+	policyID, errAddPolicy := localACP.AddPolicy(
+		ctx,
+		identity1,
+		validPolicy,
+	)
+	require.Nil(t, errAddPolicy)
+	require.Equal(
+		t,
+		validPolicyID,
+		policyID,
+	)
+
+	errRegisterDoc := localACP.RegisterDocObject(
+		ctx,
+		identity1,
+		validPolicyID,
+		"users",
+		"documentID_XYZ",
+	)
+	require.Nil(t, errRegisterDoc)
+
+	// Invalid empty arguments such that we can't add the relationship.
+	exists, errAddRelationship := localACP.AddDocActorRelationship(
+		ctx,
+		validPolicyID,
+		"users",
+		"",
+		"reader",
+		identity1,
+		identity2.DID,
+	)
+	require.ErrorIs(t, errAddRelationship, ErrMissingReqArgToAddDocActorRelationship)
+	require.False(t, exists)
+
+	// A non-owner can not give access to the document.
+	exists, errAddRelationship = localACP.AddDocActorRelationship(
+		ctx,
+		validPolicyID,
+		"users",
+		"documentID_XYZ",
+		"reader",
+		identity2,
+		identity2.DID,
+	)
+	require.ErrorIs(t, errAddRelationship, ErrFailedToAddDocActorRelationshipWithACP)
+	require.False(t, exists)
+
+	// The owner gives read access to the other identity.
+	exists, errAddRelationship = localACP.AddDocActorRelationship(
+		ctx,
+		validPolicyID,
+		"users",
+		"documentID_XYZ",
+		"reader",
+		identity1,
+		identity2.DID,
+	)
+	require.Nil(t, errAddRelationship)
+	require.False(t, exists)
+
+	// Adding the same relationship again is a no-op.
+	exists, errAddRelationship = localACP.AddDocActorRelationship(
+		ctx,
+		validPolicyID,
+		"users",
+		"documentID_XYZ",
+		"reader",
+		identity1,
+		identity2.DID,
+	)
+	require.Nil(t, errAddRelationship)
+	require.True(t, exists)
+
+	hasAccess, errCheckDocAccess := localACP.CheckDocAccess(
+		ctx,
+		ReadPermission,
+		identity2.DID,
+		validPolicyID,
+		"users",
+		"documentID_XYZ",
+	)
+	require.Nil(t, errCheckDocAccess)
+	require.True(t, hasAccess)
+
+	// The owner revokes the read access of the other identity.
+	recordFound, errDeleteRelationship := localACP.DeleteDocActorRelationship(
+		ctx,
+		validPolicyID,
+		"users",
+		"documentID_XYZ",
+		"reader",
+		identity1,
+		identity2.DID,
+	)
+	require.Nil(t, errDeleteRelationship)
+	require.True(t, recordFound)
+
+	// Deleting the same relationship again is a no-op.
+	recordFound, errDeleteRelationship = localACP.DeleteDocActorRelationship(
+		ctx,
+		validPolicyID,
+		"users",
+		"documentID_XYZ",
+		"reader",
+		identity1,
+		identity2.DID,
+	)
+	require.Nil(t, errDeleteRelationship)
+	require.False(t, recordFound)
+
+	hasAccess, errCheckDocAccess = localACP.CheckDocAccess(
+		ctx,
+		ReadPermission,
+		identity2.DID,
+		validPolicyID,
+		"users",
+		"documentID_XYZ",
+	)
+	require.Nil(t, errCheckDocAccess)
+	require.False(t, hasAccess)
+
+	errClose := localACP.Close()
+	require.Nil(t, errClose)
+}
+
 func Test_LocalACP_InMemory_AddPolicy_InvalidCreatorIDReturnsError(t *testing.T) {
 	ctx := context.Background()
 	localACP := NewLocalACP()
@@ -258,6 +258,105 @@ func (a *acpSourceHub) VerifyAccessRequest(
 	return checkDocResponse.Valid, nil
 }
 
+func (a *acpSourceHub) AddActorRelationship(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+	objectID string,
+	relation string,
+	requester identity.Identity,
+	targetActor string,
+	creationTime *protoTypes.Timestamp,
+) (bool, error) {
+	msgSet := sourcehub.MsgSet{}
+	cmdMapper := msgSet.WithBearerPolicyCmd(&acptypes.MsgBearerPolicyCmd{
+		Creator:     a.signer.GetAccAddress(),
+		BearerToken: requester.BearerToken,
+		PolicyId:    policyID,
+		Cmd: acptypes.NewSetRelationshipCmd(
+			newSourceHubActorRelationship(resourceName, objectID, relation, targetActor),
+		),
+		CreationTime: creationTime,
+	})
+	tx, err := a.txBuilder.Build(ctx, a.signer, &msgSet)
+	if err != nil {
+		return false, err
+	}
+	resp, err := a.client.BroadcastTx(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := a.client.AwaitTx(ctx, resp.TxHash)
+	if err != nil {
+		return false, err
+	}
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	cmdResult, err := cmdMapper.Map(result.TxPayload())
+	if err != nil {
+		return false, err
+	}
+
+	return cmdResult.GetResult().GetSetRelationshipResult().RecordExisted, nil
+}
+
+func (a *acpSourceHub) DeleteActorRelationship(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+	objectID string,
+	relation string,
+	requester identity.Identity,
+	targetActor string,
+) (bool, error) {
+	msgSet := sourcehub.MsgSet{}
+	cmdMapper := msgSet.WithBearerPolicyCmd(&acptypes.MsgBearerPolicyCmd{
+		Creator:     a.signer.GetAccAddress(),
+		BearerToken: requester.BearerToken,
+		PolicyId:    policyID,
+		Cmd: acptypes.NewDeleteRelationshipCmd(
+			newSourceHubActorRelationship(resourceName, objectID, relation, targetActor),
+		),
+		CreationTime: protoTypes.TimestampNow(),
+	})
+	tx, err := a.txBuilder.Build(ctx, a.signer, &msgSet)
+	if err != nil {
+		return false, err
+	}
+	resp, err := a.client.BroadcastTx(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := a.client.AwaitTx(ctx, resp.TxHash)
+	if err != nil {
+		return false, err
+	}
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	cmdResult, err := cmdMapper.Map(result.TxPayload())
+	if err != nil {
+		return false, err
+	}
+
+	return cmdResult.GetResult().GetDeleteRelationshipResult().RecordFound, nil
+}
+
+// newSourceHubActorRelationship returns the relationship tying the target actor to the given object.
+//
+// If the target actor is "*" the relationship applies to all actors.
+func newSourceHubActorRelationship(resourceName, objectID, relation, targetActor string) *acptypes.Relationship {
+	if targetActor == allActorsTarget {
+		return acptypes.NewAllActorsRelationship(resourceName, objectID, relation)
+	}
+	return acptypes.NewActorRelationship(resourceName, objectID, relation, targetActor)
+}
+
 func (a *acpSourceHub) Close() error {
 	return nil
 }
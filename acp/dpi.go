@@ -35,6 +35,9 @@ func (dpiPermission DPIPermission) String() string {
 
 const requiredRegistererRelationName string = "owner"
 
+// allActorsTarget is the target actor value that ties a relationship to all actors.
+const allActorsTarget string = "*"
+
 // validateDPIExpressionOfRequiredPermission validates that the expression under the
 // permission is valid. Moreover, DPI requires that for all required permissions, the
 // expression start with "owner" then a space or symbol, and then follow-up expression.
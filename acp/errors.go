@@ -15,12 +15,16 @@ import (
 )
 
 const (
-	errInitializationOfACPFailed             = "initialization of acp failed"
-	errStartingACPInEmptyPath                = "starting acp in an empty path"
-	errFailedToAddPolicyWithACP              = "failed to add policy with acp"
-	errFailedToRegisterDocWithACP            = "failed to register document with acp"
-	errFailedToCheckIfDocIsRegisteredWithACP = "failed to check if doc is registered with acp"
-	errFailedToVerifyDocAccessWithACP        = "failed to verify doc access with acp"
+	errInitializationOfACPFailed                 = "initialization of acp failed"
+	errStartingACPInEmptyPath                    = "starting acp in an empty path"
+	errFailedToAddPolicyWithACP                  = "failed to add policy with acp"
+	errFailedToRegisterDocWithACP                = "failed to register document with acp"
+	errFailedToCheckIfDocIsRegisteredWithACP     = "failed to check if doc is registered with acp"
+	errFailedToVerifyDocAccessWithACP            = "failed to verify doc access with acp"
+	errFailedToAddDocActorRelationshipWithACP    = "failed to add document actor relationship with acp"
+	errFailedToDeleteDocActorRelationshipWithACP = "failed to delete document actor relationship with acp"
+	errMissingReqArgToAddDocActorRelationship    = "missing a required argument needed to add doc actor relationship"
+	errMissingReqArgToDeleteDocActorRelationship = "missing a required argument needed to delete doc actor relationship"
 
 	errObjectDidNotRegister = "no-op while registering object (already exists or error) with acp"
 	errNoPolicyArgs         = "missing policy arguments, must have both id and resource"
@@ -40,12 +44,16 @@ const (
 )
 
 var (
-	ErrInitializationOfACPFailed             = errors.New(errInitializationOfACPFailed)
-	ErrFailedToAddPolicyWithACP              = errors.New(errFailedToAddPolicyWithACP)
-	ErrFailedToRegisterDocWithACP            = errors.New(errFailedToRegisterDocWithACP)
-	ErrFailedToCheckIfDocIsRegisteredWithACP = errors.New(errFailedToCheckIfDocIsRegisteredWithACP)
-	ErrFailedToVerifyDocAccessWithACP        = errors.New(errFailedToVerifyDocAccessWithACP)
-	ErrPolicyDoesNotExistWithACP             = errors.New(errPolicyDoesNotExistWithACP)
+	ErrInitializationOfACPFailed                 = errors.New(errInitializationOfACPFailed)
+	ErrFailedToAddPolicyWithACP                  = errors.New(errFailedToAddPolicyWithACP)
+	ErrFailedToRegisterDocWithACP                = errors.New(errFailedToRegisterDocWithACP)
+	ErrFailedToCheckIfDocIsRegisteredWithACP     = errors.New(errFailedToCheckIfDocIsRegisteredWithACP)
+	ErrFailedToVerifyDocAccessWithACP            = errors.New(errFailedToVerifyDocAccessWithACP)
+	ErrPolicyDoesNotExistWithACP                 = errors.New(errPolicyDoesNotExistWithACP)
+	ErrFailedToAddDocActorRelationshipWithACP    = errors.New(errFailedToAddDocActorRelationshipWithACP)
+	ErrFailedToDeleteDocActorRelationshipWithACP = errors.New(errFailedToDeleteDocActorRelationshipWithACP)
+	ErrMissingReqArgToAddDocActorRelationship    = errors.New(errMissingReqArgToAddDocActorRelationship)
+	ErrMissingReqArgToDeleteDocActorRelationship = errors.New(errMissingReqArgToDeleteDocActorRelationship)
 
 	ErrResourceDoesNotExistOnTargetPolicy = errors.New(errResourceDoesNotExistOnTargetPolicy)
 
@@ -139,6 +147,90 @@ func NewErrFailedToVerifyDocAccessWithACP(
 	)
 }
 
+func NewErrFailedToAddDocActorRelationshipWithACP(
+	inner error,
+	Type string,
+	policyID string,
+	resourceName string,
+	docID string,
+	relation string,
+	requestActor string,
+	targetActor string,
+) error {
+	return errors.Wrap(
+		errFailedToAddDocActorRelationshipWithACP,
+		inner,
+		errors.NewKV("Type", Type),
+		errors.NewKV("PolicyID", policyID),
+		errors.NewKV("ResourceName", resourceName),
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Relation", relation),
+		errors.NewKV("RequestActor", requestActor),
+		errors.NewKV("TargetActor", targetActor),
+	)
+}
+
+func NewErrFailedToDeleteDocActorRelationshipWithACP(
+	inner error,
+	Type string,
+	policyID string,
+	resourceName string,
+	docID string,
+	relation string,
+	requestActor string,
+	targetActor string,
+) error {
+	return errors.Wrap(
+		errFailedToDeleteDocActorRelationshipWithACP,
+		inner,
+		errors.NewKV("Type", Type),
+		errors.NewKV("PolicyID", policyID),
+		errors.NewKV("ResourceName", resourceName),
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Relation", relation),
+		errors.NewKV("RequestActor", requestActor),
+		errors.NewKV("TargetActor", targetActor),
+	)
+}
+
+func NewErrMissingRequiredArgToAddDocActorRelationship(
+	policyID string,
+	resourceName string,
+	docID string,
+	relation string,
+	requestActor string,
+	targetActor string,
+) error {
+	return errors.New(
+		errMissingReqArgToAddDocActorRelationship,
+		errors.NewKV("PolicyID", policyID),
+		errors.NewKV("ResourceName", resourceName),
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Relation", relation),
+		errors.NewKV("RequestActor", requestActor),
+		errors.NewKV("TargetActor", targetActor),
+	)
+}
+
+func NewErrMissingRequiredArgToDeleteDocActorRelationship(
+	policyID string,
+	resourceName string,
+	docID string,
+	relation string,
+	requestActor string,
+	targetActor string,
+) error {
+	return errors.New(
+		errMissingReqArgToDeleteDocActorRelationship,
+		errors.NewKV("PolicyID", policyID),
+		errors.NewKV("ResourceName", resourceName),
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Relation", relation),
+		errors.NewKV("RequestActor", requestActor),
+		errors.NewKV("TargetActor", targetActor),
+	)
+}
+
 func newErrPolicyDoesNotExistWithACP(
 	inner error,
 	policyID string,
@@ -85,6 +85,39 @@ type sourceHubClient interface {
 		docID string,
 	) (bool, error)
 
+	// AddActorRelationship creates a relationship within a policy which ties the target actor
+	// with the specified object, which means that the set of high level rules defined in the
+	// policy will now apply to target actor as well.
+	//
+	// If failure occurs, the result will return an error. Upon success the boolean value will
+	// be true if the relationship already existed (no-op), and false if a new relationship was made.
+	AddActorRelationship(
+		ctx context.Context,
+		policyID string,
+		resourceName string,
+		objectID string,
+		relation string,
+		requester identity.Identity,
+		targetActor string,
+		creationTime *protoTypes.Timestamp,
+	) (bool, error)
+
+	// DeleteActorRelationship deletes a relationship within a policy which ties the target actor
+	// with the specified object, which means that the set of high level rules defined in the
+	// policy will no longer apply to target actor.
+	//
+	// If failure occurs, the result will return an error. Upon success the boolean value will
+	// be true if the relationship record was found and deleted, and false if it was not found (no-op).
+	DeleteActorRelationship(
+		ctx context.Context,
+		policyID string,
+		resourceName string,
+		objectID string,
+		relation string,
+		requester identity.Identity,
+		targetActor string,
+	) (bool, error)
+
 	// Close closes any resources in use by acp.
 	Close() error
 }
@@ -335,6 +368,131 @@ func (a *sourceHubBridge) CheckDocAccess(
 	}
 }
 
+func (a *sourceHubBridge) AddDocActorRelationship(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+	docID string,
+	relation string,
+	requestActor identity.Identity,
+	targetActor string,
+) (bool, error) {
+	if policyID == "" ||
+		resourceName == "" ||
+		docID == "" ||
+		relation == "" ||
+		requestActor.DID == "" ||
+		targetActor == "" {
+		return false, NewErrMissingRequiredArgToAddDocActorRelationship(
+			policyID,
+			resourceName,
+			docID,
+			relation,
+			requestActor.DID,
+			targetActor,
+		)
+	}
+
+	exists, err := a.client.AddActorRelationship(
+		ctx,
+		policyID,
+		resourceName,
+		docID,
+		relation,
+		requestActor,
+		targetActor,
+		protoTypes.TimestampNow(),
+	)
+	if err != nil {
+		return false, NewErrFailedToAddDocActorRelationshipWithACP(
+			err,
+			"Local",
+			policyID,
+			resourceName,
+			docID,
+			relation,
+			requestActor.DID,
+			targetActor,
+		)
+	}
+
+	log.InfoContext(
+		ctx,
+		"Document and actor relationship set",
+		corelog.Any("PolicyID", policyID),
+		corelog.Any("ResourceName", resourceName),
+		corelog.Any("DocID", docID),
+		corelog.Any("Relation", relation),
+		corelog.Any("RequestActor", requestActor.DID),
+		corelog.Any("TargetActor", targetActor),
+		corelog.Any("Existed", exists),
+	)
+
+	return exists, nil
+}
+
+func (a *sourceHubBridge) DeleteDocActorRelationship(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+	docID string,
+	relation string,
+	requestActor identity.Identity,
+	targetActor string,
+) (bool, error) {
+	if policyID == "" ||
+		resourceName == "" ||
+		docID == "" ||
+		relation == "" ||
+		requestActor.DID == "" ||
+		targetActor == "" {
+		return false, NewErrMissingRequiredArgToDeleteDocActorRelationship(
+			policyID,
+			resourceName,
+			docID,
+			relation,
+			requestActor.DID,
+			targetActor,
+		)
+	}
+
+	recordFound, err := a.client.DeleteActorRelationship(
+		ctx,
+		policyID,
+		resourceName,
+		docID,
+		relation,
+		requestActor,
+		targetActor,
+	)
+	if err != nil {
+		return false, NewErrFailedToDeleteDocActorRelationshipWithACP(
+			err,
+			"Local",
+			policyID,
+			resourceName,
+			docID,
+			relation,
+			requestActor.DID,
+			targetActor,
+		)
+	}
+
+	log.InfoContext(
+		ctx,
+		"Document and actor relationship deleted",
+		corelog.Any("PolicyID", policyID),
+		corelog.Any("ResourceName", resourceName),
+		corelog.Any("DocID", docID),
+		corelog.Any("Relation", relation),
+		corelog.Any("RequestActor", requestActor.DID),
+		corelog.Any("TargetActor", targetActor),
+		corelog.Any("RecordFound", recordFound),
+	)
+
+	return recordFound, nil
+}
+
 func (a *sourceHubBridge) SupportsP2P() bool {
 	_, ok := a.client.(*acpSourceHub)
 	return ok
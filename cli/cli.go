@@ -42,6 +42,7 @@ func NewDefraCommand() *cobra.Command {
 		p2p_replicator,
 		p2p_collection,
 		MakeP2PInfoCommand(),
+		MakeP2PSyncCommand(),
 	)
 
 	schema_migrate := MakeSchemaMigrationCommand()
@@ -67,9 +68,16 @@ func NewDefraCommand() *cobra.Command {
 		MakeACPPolicyAddCommand(),
 	)
 
+	relationship := MakeACPRelationshipCommand()
+	relationship.AddCommand(
+		MakeACPRelationshipAddCommand(),
+		MakeACPRelationshipDeleteCommand(),
+	)
+
 	acp := MakeACPCommand()
 	acp.AddCommand(
 		policy,
+		relationship,
 	)
 
 	view := MakeViewCommand()
@@ -103,10 +111,13 @@ func NewDefraCommand() *cobra.Command {
 		MakeCollectionGetCommand(),
 		MakeCollectionListDocIDsCommand(),
 		MakeCollectionDeleteCommand(),
+		MakeCollectionPurgeCommand(),
 		MakeCollectionUpdateCommand(),
+		MakeCollectionUpsertCommand(),
 		MakeCollectionCreateCommand(),
 		MakeCollectionDescribeCommand(),
 		MakeCollectionPatchCommand(),
+		MakeCollectionCompactCommand(),
 	)
 
 	client := MakeClientCommand()
@@ -0,0 +1,73 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionCompactCommand() *cobra.Command {
+	var depth uint64
+	var age time.Duration
+	var cmd = &cobra.Command{
+		Use:   "compact [--depth <depth>] [--age <age>]",
+		Short: "Compact the history of the documents in a collection.",
+		Long: `Compact the history of the documents in a collection.
+
+The history that is older than the retention policy is collapsed into a snapshot,
+and the blocks that are no longer needed are removed from the blockstore. If neither
+a depth nor an age is given, the retention policy of the collection is used.
+
+Example: compact using the retention policy of the collection
+  defradb client collection compact --name User
+
+Example: keep the last 10 versions of each document
+  defradb client collection compact --name User --depth 10
+
+Example: keep the versions of the last 30 days
+  defradb client collection compact --name User --age 720h
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetContextDB(cmd)
+
+			col, ok := tryGetContextCollection(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			policy := immutable.None[client.RetentionPolicy]()
+			if cmd.Flags().Changed("depth") || cmd.Flags().Changed("age") {
+				var retention client.RetentionPolicy
+				if cmd.Flags().Changed("depth") {
+					retention.Depth = immutable.Some(depth)
+				}
+				if cmd.Flags().Changed("age") {
+					retention.Age = immutable.Some(age)
+				}
+				policy = immutable.Some(retention)
+			}
+
+			res, err := db.CompactCollection(cmd.Context(), col.Name().Value(), policy)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, res)
+		},
+	}
+	cmd.Flags().Uint64Var(&depth, "depth", 0, "Number of versions of each document to retain")
+	cmd.Flags().DurationVar(&age, "age", 0, "Duration for which the versions of each document are retained")
+	return cmd
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionPurgeCommand() *cobra.Command {
+	var argDocID string
+	var cmd = &cobra.Command{
+		Use:   "purge [-i --identity] --docID <docID>",
+		Short: "Purge a document and its history by docID.",
+		Long: `Purge a document and its history by docID.
+
+The field values, index entries, encryption keys and blocks of the document are
+permanently removed. Only a tombstone remains, which prevents the document from
+being recreated and is propagated to the peers so that they purge it too.
+
+Example: purge by docID:
+  defradb client collection purge --name User --docID bae-123
+
+Example: purge by docID with identity:
+  defradb client collection purge --name User --docID bae-123 \
+  	-i 028d53f37a19afb9a0dbc5b4be30c65731479ee8cfa0c9bc8f8bf198cc3c075f
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetContextCollection(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			docID, err := client.NewDocIDFromString(argDocID)
+			if err != nil {
+				return err
+			}
+			_, err = col.Purge(cmd.Context(), docID)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&argDocID, "docID", "", "Document ID")
+	return cmd
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionUpsertCommand() *cobra.Command {
+	var filter string
+	var updater string
+	var cmd = &cobra.Command{
+		Use:   "upsert [-i --identity] --filter <filter> --updater <updater> <document>",
+		Short: "Update the document matching a filter, or create it if none match.",
+		Long: `Update the document matching a filter, or create it if none match.
+
+The lookup and the write are executed atomically within a single transaction.
+An error is returned if more than one document matches the filter.
+
+Example: upsert by filter:
+  defradb client collection upsert --name User \
+  --filter '{ "name": { "_eq": "Bob" } }' --updater '{ "verified": true }' \
+  '{ "name": "Bob", "verified": true }'
+
+Example: upsert with identity:
+  defradb client collection upsert -i 028d53f37a19afb9a0dbc5b4be30c65731479ee8cfa0c9bc8f8bf198cc3c075f --name User \
+  --filter '{ "name": { "_eq": "Bob" } }' --updater '{ "verified": true }' \
+  '{ "name": "Bob", "verified": true }'
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetContextCollection(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			if filter == "" || updater == "" {
+				return ErrNoFilterOrUpdater
+			}
+
+			var filterValue any
+			if err := json.Unmarshal([]byte(filter), &filterValue); err != nil {
+				return err
+			}
+
+			doc, err := client.NewDocFromJSON([]byte(args[0]), col.Definition())
+			if err != nil {
+				return err
+			}
+
+			res, err := col.Upsert(cmd.Context(), filterValue, doc, updater)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "Document filter")
+	cmd.Flags().StringVar(&updater, "updater", "", "Document updater")
+	return cmd
+}
@@ -52,10 +52,13 @@ var configFlags = map[string]string{
 	"url":                "api.address",
 	"max-txn-retries":    "datastore.maxtxnretries",
 	"store":              "datastore.store",
+	"sort-memory-budget": "datastore.sortmemorybudget",
 	"valuelogfilesize":   "datastore.badger.valuelogfilesize",
 	"peers":              "net.peers",
 	"p2paddr":            "net.p2paddresses",
 	"no-p2p":             "net.p2pdisabled",
+	"retry-intervals":    "net.replicatorretryintervals",
+	"require-signed":     "net.requiresignedblocks",
 	"allowed-origins":    "api.allowed-origins",
 	"pubkeypath":         "api.pubkeypath",
 	"privkeypath":        "api.privkeypath",
@@ -73,12 +76,15 @@ var configDefaults = map[string]any{
 	"datastore.badger.path":             "data",
 	"datastore.maxtxnretries":           5,
 	"datastore.store":                   "badger",
+	"datastore.sortmemorybudget":        64 << 20,
 	"datastore.badger.valuelogfilesize": 1 << 30,
 	"net.p2pdisabled":                   false,
 	"net.p2paddresses":                  []string{"/ip4/127.0.0.1/tcp/9171"},
 	"net.peers":                         []string{},
 	"net.pubSubEnabled":                 true,
 	"net.relay":                         false,
+	"net.replicatorretryintervals":      []int{30, 60, 120, 240, 480, 960, 1920},
+	"net.requiresignedblocks":           false,
 	"keyring.backend":                   "file",
 	"keyring.disabled":                  false,
 	"keyring.namespace":                 "defradb",
@@ -44,6 +44,7 @@ func TestLoadConfigNotExist(t *testing.T) {
 	assert.Equal(t, filepath.Join(rootdir, "data"), cfg.GetString("datastore.badger.path"))
 	assert.Equal(t, 1<<30, cfg.GetInt("datastore.badger.valuelogfilesize"))
 	assert.Equal(t, "badger", cfg.GetString("datastore.store"))
+	assert.Equal(t, 64<<20, cfg.GetInt("datastore.sortmemorybudget"))
 
 	assert.Equal(t, "127.0.0.1:9181", cfg.GetString("api.address"))
 	assert.Equal(t, []string{}, cfg.GetStringSlice("api.allowed-origins"))
@@ -55,6 +56,8 @@ func TestLoadConfigNotExist(t *testing.T) {
 	assert.Equal(t, true, cfg.GetBool("net.pubsubenabled"))
 	assert.Equal(t, false, cfg.GetBool("net.relay"))
 	assert.Equal(t, []string{}, cfg.GetStringSlice("net.peers"))
+	assert.Equal(t, []int{30, 60, 120, 240, 480, 960, 1920}, cfg.GetIntSlice("net.replicatorretryintervals"))
+	assert.Equal(t, false, cfg.GetBool("net.requiresignedblocks"))
 
 	assert.Equal(t, "info", cfg.GetString("log.level"))
 	assert.Equal(t, "stderr", cfg.GetString("log.output"))
@@ -34,6 +34,7 @@ var (
 	ErrNoDocOrFile                = errors.New("document or file must be defined")
 	ErrInvalidDocument            = errors.New("invalid document")
 	ErrNoDocIDOrFilter            = errors.New("docID or filter must be defined")
+	ErrNoFilterOrUpdater          = errors.New("filter and updater must be defined")
 	ErrInvalidExportFormat        = errors.New("invalid export format")
 	ErrNoLensConfig               = errors.New("lens config cannot be empty")
 	ErrInvalidLensConfig          = errors.New("invalid lens configuration")
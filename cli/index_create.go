@@ -21,19 +21,27 @@ func MakeIndexCreateCommand() *cobra.Command {
 	var nameArg string
 	var fieldsArg []string
 	var uniqueArg bool
+	var typeArg string
+	var analyzerArg []string
 	var cmd = &cobra.Command{
-		Use:   "create -c --collection <collection> --fields <fields> [-n --name <name>] [--unique]",
+		Use:   "create -c --collection <collection> --fields <fields> [-n --name <name>] [--unique] [--type <type>] [--analyzer <filters>]",
 		Short: "Creates a secondary index on a collection's field(s)",
 		Long: `Creates a secondary index on a collection's field(s).
 		
 The --name flag is optional. If not provided, a name will be generated automatically.
 The --unique flag is optional. If provided, the index will be unique.
+The --type flag is optional. If set to 'fulltext', a full-text index is created on a single String field.
+The --analyzer flag is optional. It sets the filters applied to the tokens of a full-text index,
+any of 'lowercase', 'stopwords' and 'stemming'. All filters are applied if not provided.
 
 Example: create an index for 'Users' collection on 'name' field:
   defradb client index create --collection Users --fields name
 
 Example: create a named index for 'Users' collection on 'name' field:
-  defradb client index create --collection Users --fields name --name UsersByName`,
+  defradb client index create --collection Users --fields name --name UsersByName
+
+Example: create a full-text index for 'Users' collection on 'bio' field:
+  defradb client index create --collection Users --fields bio --type fulltext`,
 		ValidArgs: []string{"collection", "fields", "name"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			store := mustGetContextStore(cmd)
@@ -42,10 +50,16 @@ Example: create a named index for 'Users' collection on 'name' field:
 			for _, name := range fieldsArg {
 				fields = append(fields, client.IndexedFieldDescription{Name: name})
 			}
+			var analyzer []client.IndexAnalyzerFilter
+			for _, filter := range analyzerArg {
+				analyzer = append(analyzer, client.IndexAnalyzerFilter(filter))
+			}
 			desc := client.IndexDescription{
-				Name:   nameArg,
-				Fields: fields,
-				Unique: uniqueArg,
+				Name:     nameArg,
+				Fields:   fields,
+				Unique:   uniqueArg,
+				Type:     client.IndexType(typeArg),
+				Analyzer: analyzer,
 			}
 			col, err := store.GetCollectionByName(cmd.Context(), collectionArg)
 			if err != nil {
@@ -62,6 +76,8 @@ Example: create a named index for 'Users' collection on 'name' field:
 	cmd.Flags().StringVarP(&nameArg, "name", "n", "", "Index name")
 	cmd.Flags().StringSliceVar(&fieldsArg, "fields", []string{}, "Fields to index")
 	cmd.Flags().BoolVarP(&uniqueArg, "unique", "u", false, "Make the index unique")
+	cmd.Flags().StringVar(&typeArg, "type", "", "Index type")
+	cmd.Flags().StringSliceVar(&analyzerArg, "analyzer", []string{}, "Filters of the full-text index analyzer")
 
 	return cmd
 }
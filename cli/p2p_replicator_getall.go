@@ -21,6 +21,9 @@ func MakeP2PReplicatorGetAllCommand() *cobra.Command {
 		Long: `Get all the replicators active in the P2P data sync system.
 A replicator synchronizes one or all collection(s) from this node to another.
 
+The status of each replicator is reported as either active or inactive, along with
+the last error and the number of documents pending a retry if updates failed to be pushed to it.
+
 Example:
   defradb client p2p replicator getall
   		`,
@@ -0,0 +1,47 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/spf13/cobra"
+)
+
+func MakeP2PSyncCommand() *cobra.Command {
+	var collections []string
+	var cmd = &cobra.Command{
+		Use:   "sync [-c, --collection] <peer>",
+		Short: "Pull and merge the documents of collection(s) from a peer",
+		Long: `Pull and merge the documents of collection(s) from a peer.
+Fetches the current document heads of one or all collection(s) from the given peer
+and merges them into the local documents, forcing this node to catch up with the peer.
+
+Example:
+  defradb client p2p sync -c Users '{"ID": "12D3", "Addrs": ["/ip4/0.0.0.0/tcp/9171"]}'
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p2p := mustGetContextP2P(cmd)
+
+			var info peer.AddrInfo
+			if err := json.Unmarshal([]byte(args[0]), &info); err != nil {
+				return err
+			}
+			return p2p.SyncCollections(cmd.Context(), info, collections)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&collections, "collection", "c",
+		[]string{}, "Collection(s) to sync")
+	return cmd
+}
@@ -14,6 +14,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/sourcenetwork/immutable"
 	"github.com/spf13/cobra"
@@ -54,6 +55,9 @@ func MakeStartCommand() *cobra.Command {
 				node.WithSourceHubCometRPCAddress(cfg.GetString("acp.sourceHub.CometRPCAddress")),
 				// db options
 				db.WithMaxRetries(cfg.GetInt("datastore.MaxTxnRetries")),
+				db.WithSortMemoryBudget(cfg.GetInt("datastore.sortmemorybudget")),
+				db.WithRetryInterval(getRetryIntervals(cfg.GetIntSlice("net.replicatorRetryIntervals"))),
+				db.WithRequireSignedBlocks(cfg.GetBool("net.requireSignedBlocks")),
 				// net node options
 				net.WithListenAddresses(cfg.GetStringSlice("net.p2pAddresses")...),
 				net.WithEnablePubSub(cfg.GetBool("net.pubSubEnabled")),
@@ -155,6 +159,11 @@ func MakeStartCommand() *cobra.Command {
 		cfg.GetString(configFlags["store"]),
 		"Specify the datastore to use (supported: badger, memory)",
 	)
+	cmd.PersistentFlags().Int(
+		"sort-memory-budget",
+		cfg.GetInt(configFlags["sort-memory-budget"]),
+		"Specify the approximate number of bytes of documents sorted in memory before spilling to temporary files",
+	)
 	cmd.PersistentFlags().Int(
 		"valuelogfilesize",
 		cfg.GetInt(configFlags["valuelogfilesize"]),
@@ -165,6 +174,16 @@ func MakeStartCommand() *cobra.Command {
 		cfg.GetStringSlice(configFlags["p2paddr"]),
 		"Listen addresses for the p2p network (formatted as a libp2p MultiAddr)",
 	)
+	cmd.PersistentFlags().IntSlice(
+		"retry-intervals",
+		cfg.GetIntSlice(configFlags["retry-intervals"]),
+		"Intervals (in seconds) to wait for between the retries of a replicator that updates failed to be pushed to",
+	)
+	cmd.PersistentFlags().Bool(
+		"require-signed",
+		cfg.GetBool(configFlags["require-signed"]),
+		"Reject unsigned blocks received from peers",
+	)
 	cmd.PersistentFlags().Bool(
 		"no-p2p",
 		cfg.GetBool(configFlags["no-p2p"]),
@@ -187,3 +206,12 @@ func MakeStartCommand() *cobra.Command {
 	)
 	return cmd
 }
+
+// getRetryIntervals converts the given intervals in seconds to durations.
+func getRetryIntervals(seconds []int) []time.Duration {
+	intervals := make([]time.Duration, len(seconds))
+	for i, s := range seconds {
+		intervals[i] = time.Duration(s) * time.Second
+	}
+	return intervals
+}
@@ -73,6 +73,14 @@ type Collection interface {
 	// This includes data, block, and head storage.
 	Delete(ctx context.Context, docID DocID) (bool, error)
 
+	// Purge will attempt to permanently remove a document by DocID, including its history.
+	//
+	// Will return true if the purge is successful, and return false along with an error
+	// if it cannot. If the document doesn't exist, then it will return false and a ErrDocumentNotFound error.
+	// The field values, index entries, encryption keys and blocks of the document are removed, leaving only
+	// a tombstone that prevents the document from being recreated and that is propagated to the peers.
+	Purge(ctx context.Context, docID DocID) (bool, error)
+
 	// Exists checks if a given document exists with supplied DocID.
 	//
 	// Will return true if a matching document exists, otherwise will return false.
@@ -88,6 +96,20 @@ type Collection interface {
 		updater string,
 	) (*UpdateResult, error)
 
+	// Upsert updates the document matching the given filter or creates it if no document matches.
+	//
+	// If exactly one document matches the filter it will be updated using the given updater, which
+	// must be a string Merge Patch. If no document matches the filter the given document will be created.
+	// Both the lookup and the write are executed atomically within a single transaction.
+	//
+	// Will return a ErrUpsertMultipleDocuments error if more than one document matches the filter.
+	Upsert(
+		ctx context.Context,
+		filter any,
+		doc *Document,
+		updater string,
+	) (*UpsertResult, error)
+
 	// DeleteWithFilter deletes documents matching the given filter.
 	//
 	// This operation will soft-delete documents related to the given filter and update the composite block
@@ -140,6 +162,15 @@ type UpdateResult struct {
 	DocIDs []string
 }
 
+// UpsertResult wraps the result of an upsert call.
+type UpsertResult struct {
+	// DocID contains the DocID of the document created or updated by the upsert call.
+	DocID string
+	// Created is true if the upsert call created a new document, and false if it
+	// updated an existing one.
+	Created bool
+}
+
 // DeleteResult wraps the result of an delete call.
 type DeleteResult struct {
 	// Count contains the number of documents deleted by the delete call.
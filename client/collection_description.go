@@ -88,6 +88,11 @@ type CollectionDescription struct {
 	// At the moment this can only be set to `false` if this collection sources its data from
 	// another collection/query (is a View).
 	IsMaterialized bool
+
+	// Retention is the optional retention policy of the history of the documents in this collection.
+	//
+	// It is used when the collection is compacted without an explicit policy.
+	Retention immutable.Option[RetentionPolicy]
 }
 
 // QuerySource represents a collection data source from a query.
@@ -190,6 +195,7 @@ type collectionDescription struct {
 	SchemaVersionID string
 	IsMaterialized  bool
 	Policy          immutable.Option[PolicyDescription]
+	Retention       immutable.Option[RetentionPolicy]
 	Indexes         []IndexDescription
 	Fields          []CollectionFieldDescription
 
@@ -213,6 +219,7 @@ func (c *CollectionDescription) UnmarshalJSON(bytes []byte) error {
 	c.Fields = descMap.Fields
 	c.Sources = make([]any, len(descMap.Sources))
 	c.Policy = descMap.Policy
+	c.Retention = descMap.Retention
 
 	for i, source := range descMap.Sources {
 		sourceJson, err := json.Marshal(source)
@@ -0,0 +1,83 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+)
+
+// RetentionPolicy describes how much of the history of the documents within a collection
+// is kept when the collection is compacted.
+//
+// The history that is not retained is collapsed into a snapshot block, and the blocks
+// that are no longer reachable are removed from the blockstore.  If both limits are set,
+// the history is retained as long as it is within either of them.
+type RetentionPolicy struct {
+	// Depth is the number of versions of a document that are retained, including the
+	// current version.
+	//
+	// If set, it must be greater than zero.
+	Depth immutable.Option[uint64]
+
+	// Age is the duration for which the versions of a document are retained.
+	//
+	// The age of a version is measured from the compaction run that first saw it, so
+	// compactions of collections with an age limit should be run periodically.
+	Age immutable.Option[time.Duration]
+}
+
+// retentionPolicy is a private type used to facilitate the (un)marshalling
+// of json to a [RetentionPolicy].
+type retentionPolicy struct {
+	Depth immutable.Option[uint64]
+	Age   immutable.Option[string]
+}
+
+func (p RetentionPolicy) MarshalJSON() ([]byte, error) {
+	policy := retentionPolicy{
+		Depth: p.Depth,
+	}
+	if p.Age.HasValue() {
+		policy.Age = immutable.Some(p.Age.Value().String())
+	}
+	return json.Marshal(policy)
+}
+
+func (p *RetentionPolicy) UnmarshalJSON(bytes []byte) error {
+	var policy retentionPolicy
+	err := json.Unmarshal(bytes, &policy)
+	if err != nil {
+		return err
+	}
+
+	p.Depth = policy.Depth
+	p.Age = immutable.None[time.Duration]()
+	if policy.Age.HasValue() {
+		age, err := time.ParseDuration(policy.Age.Value())
+		if err != nil {
+			return err
+		}
+		p.Age = immutable.Some(age)
+	}
+	return nil
+}
+
+// CompactionResult wraps the result of compacting the history of a collection.
+type CompactionResult struct {
+	// CompactedDocs is the number of documents whose history was collapsed.
+	CompactedDocs uint64
+
+	// RemovedBlocks is the number of blocks that were removed from the blockstore.
+	RemovedBlocks uint64
+}
@@ -24,12 +24,15 @@ const (
 	COMPOSITE
 	PN_COUNTER
 	P_COUNTER
+	OR_SET
+	LIST
+	BOUNDED_COUNTER
 )
 
 // IsSupportedFieldCType returns true if the type is supported as a document field type.
 func (t CType) IsSupportedFieldCType() bool {
 	switch t {
-	case NONE_CRDT, LWW_REGISTER, PN_COUNTER, P_COUNTER:
+	case NONE_CRDT, LWW_REGISTER, PN_COUNTER, P_COUNTER, OR_SET, LIST, BOUNDED_COUNTER:
 		return true
 	default:
 		return false
@@ -44,6 +47,10 @@ func (t CType) IsCompatibleWith(kind FieldKind) bool {
 			return true
 		}
 		return false
+	case BOUNDED_COUNTER:
+		return kind == FieldKind_NILLABLE_INT
+	case OR_SET, LIST:
+		return kind.IsArray() && !kind.IsObject()
 	default:
 		return true
 	}
@@ -64,6 +71,12 @@ func (t CType) String() string {
 		return "pncounter"
 	case P_COUNTER:
 		return "pcounter"
+	case OR_SET:
+		return "orset"
+	case LIST:
+		return "list"
+	case BOUNDED_COUNTER:
+		return "bcounter"
 	default:
 		return "unknown"
 	}
@@ -99,6 +99,51 @@ type DB interface {
 	//
 	// Note: A policy can not be added without the creatorID (identity).
 	AddPolicy(ctx context.Context, policy string) (AddPolicyResult, error)
+
+	// AddDocActorRelationship creates a relationship between document and the target actor.
+	//
+	// If failure occurs, the result will return an error. Upon success the boolean value will
+	// be true if the relationship already existed (no-op), and false if a new relationship was made.
+	//
+	// Note:
+	// - The request actor must either be the owner or manager of the document.
+	// - If the target actor arg is "*", then the relationship applies to all actors implicitly.
+	AddDocActorRelationship(
+		ctx context.Context,
+		collectionName string,
+		docID string,
+		relation string,
+		targetActor string,
+	) (AddDocActorRelationshipResult, error)
+
+	// DeleteDocActorRelationship deletes a relationship between document and the target actor.
+	//
+	// If failure occurs, the result will return an error. Upon success the boolean value will
+	// be true if the relationship record was found and deleted, and false if it was not found (no-op).
+	//
+	// Note:
+	// - The request actor must either be the owner or manager of the document.
+	// - If the target actor arg is "*", then the implicitly added relationship with all actors is
+	//   removed, however this does not revoke access from actors that had explicit relationships.
+	DeleteDocActorRelationship(
+		ctx context.Context,
+		collectionName string,
+		docID string,
+		relation string,
+		targetActor string,
+	) (DeleteDocActorRelationshipResult, error)
+
+	// CompactCollection collapses the history of the documents in the given collection that is older
+	// than the retention policy into a snapshot block, and removes the blocks that are no longer
+	// reachable from the blockstore.
+	//
+	// If no policy is given, the retention policy of the collection is used. The current heads of the
+	// documents are always retained, so that they remain mergeable with the peers.
+	CompactCollection(
+		ctx context.Context,
+		collectionName string,
+		policy immutable.Option[RetentionPolicy],
+	) (CompactionResult, error)
 }
 
 // Store contains the core DefraDB read-write operations.
@@ -13,7 +13,9 @@ package client
 import (
 	"encoding/json"
 	"errors"
+	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -668,6 +670,121 @@ func (doc *Document) setAndParseObjectType(value map[string]any) error {
 	return nil
 }
 
+// SetWithMap sets the values of all the fields in the given map.
+//
+// The [request.AppendOperatorName] and [request.RemoveOperatorName] keys may hold a map
+// of array field names to values that should be appended to, or removed from, the array
+// fields. They are applied after all other fields have been set, removals first.
+func (doc *Document) SetWithMap(value map[string]any) error {
+	for k, v := range value {
+		if k == request.AppendOperatorName || k == request.RemoveOperatorName {
+			continue
+		}
+		err := doc.Set(k, v)
+		if err != nil {
+			return err
+		}
+	}
+	if v, ok := value[request.RemoveOperatorName]; ok {
+		err := doc.applyArrayOperator(request.RemoveOperatorName, v, doc.Remove)
+		if err != nil {
+			return err
+		}
+	}
+	if v, ok := value[request.AppendOperatorName]; ok {
+		err := doc.applyArrayOperator(request.AppendOperatorName, v, doc.Append)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (doc *Document) applyArrayOperator(name string, input any, apply func(string, any) error) error {
+	fields, ok := input.(map[string]any)
+	if !ok {
+		return NewErrUnexpectedType[map[string]any](name, input)
+	}
+	for field, values := range fields {
+		err := apply(field, values)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Append adds the given values to the end of the array field.
+func (doc *Document) Append(field string, values any) error {
+	current, given, err := doc.getArrayValues(field, values)
+	if err != nil {
+		return err
+	}
+	return doc.Set(field, append(current, given...))
+}
+
+// Remove removes all occurrences of the given values from the array field.
+func (doc *Document) Remove(field string, values any) error {
+	current, given, err := doc.getArrayValues(field, values)
+	if err != nil {
+		return err
+	}
+	result := make([]any, 0, len(current))
+	for _, v := range current {
+		if !slices.Contains(given, v) {
+			result = append(result, v)
+		}
+	}
+	return doc.Set(field, result)
+}
+
+// getArrayValues returns the current values of the given array field along with the
+// given values, both normalized to the element type of the field.
+func (doc *Document) getArrayValues(field string, values any) ([]any, []any, error) {
+	fd, exists := doc.collectionDefinition.GetFieldByName(field)
+	if !exists {
+		return nil, nil, NewErrFieldNotExist(field)
+	}
+	if !fd.Kind.IsArray() || fd.Kind.IsObject() {
+		return nil, nil, NewErrFieldNotArray(field)
+	}
+	given, err := validateFieldSchema(values, fd)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := doc.TryGetValue(field)
+	if err != nil {
+		return nil, nil, err
+	}
+	if current == nil {
+		return nil, normalArrayToAny(given), nil
+	}
+	return normalArrayToAny(current.NormalValue()), normalArrayToAny(given), nil
+}
+
+// normalArrayToAny returns the elements of the given normal array value, with nil
+// representing nil elements.
+func normalArrayToAny(value NormalValue) []any {
+	if v, ok := value.NillableStringArray(); ok {
+		return convertImmutable(v)
+	} else if v, ok := value.NillableIntArray(); ok {
+		return convertImmutable(v)
+	} else if v, ok := value.NillableFloatArray(); ok {
+		return convertImmutable(v)
+	} else if v, ok := value.NillableBoolArray(); ok {
+		return convertImmutable(v)
+	}
+	array := reflect.ValueOf(value.Unwrap())
+	if array.Kind() != reflect.Slice {
+		return nil
+	}
+	result := make([]any, array.Len())
+	for i := range result {
+		result[i] = array.Index(i).Interface()
+	}
+	return result
+}
+
 func (doc *Document) setDefaultValues() error {
 	for _, field := range doc.collectionDefinition.GetFields() {
 		if field.DefaultValue == nil {
@@ -851,7 +968,7 @@ func (doc *Document) generateAndSetDocID() error {
 }
 
 // DocumentStatus represent the state of the document in the DAG store.
-// It can either be `Active“ or `Deleted`.
+// It can either be `Active“, `Deleted` or `Purged`.
 type DocumentStatus uint8
 
 const (
@@ -861,11 +978,15 @@ const (
 	// can still be in the datastore but a normal request won't return it. The DAG store will still have all
 	// the associated links.
 	Deleted DocumentStatus = 2
+	// Purged represents a document that has been permanently removed along with its history.
+	// Only a tombstone remains in the DAG store, which prevents the document from being recreated.
+	Purged DocumentStatus = 3
 )
 
 var DocumentStatusToString = map[DocumentStatus]string{
 	Active:  "Active",
 	Deleted: "Deleted",
+	Purged:  "Purged",
 }
 
 func (dStatus DocumentStatus) UInt8() uint8 {
@@ -876,6 +997,10 @@ func (dStatus DocumentStatus) IsDeleted() bool {
 	return dStatus > 1
 }
 
+func (dStatus DocumentStatus) IsPurged() bool {
+	return dStatus == Purged
+}
+
 // parses a document field path, can have sub elements if we have embedded objects.
 // Returns the first path, the remaining split paths, and a bool indicating if there are sub paths
 func parseFieldPath(path string) (string, string, bool) {
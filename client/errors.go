@@ -33,6 +33,8 @@ const (
 	errCanNotTurnNormalValueIntoArray      string = "can not turn normal value into array"
 	errCanNotMakeNormalNilFromFieldKind    string = "can not make normal nil from field kind"
 	errFailedToParseKind                   string = "failed to parse kind"
+	errFieldNotArray                       string = "field is not an array"
+	errInvalidReplicatorStatus             string = "invalid replicator status"
 )
 
 // Errors returnable from this package.
@@ -40,25 +42,30 @@ const (
 // This list is incomplete and undefined errors may also be returned.
 // Errors returned from this package may be tested against these errors with errors.Is.
 var (
-	ErrFieldNotExist                       = errors.New(errFieldNotExist)
-	ErrUnexpectedType                      = errors.New(errUnexpectedType)
-	ErrFailedToUnmarshalCollection         = errors.New(errFailedToUnmarshalCollection)
-	ErrOperationNotPermittedOnNamelessCols = errors.New(errOperationNotPermittedOnNamelessCols)
-	ErrFieldNotObject                      = errors.New("trying to access field on a non object type")
-	ErrValueTypeMismatch                   = errors.New("value does not match indicated type")
-	ErrDocumentNotFoundOrNotAuthorized     = errors.New("document not found or not authorized to access")
-	ErrPolicyAddFailureNoACP               = errors.New("failure adding policy because ACP was not available")
-	ErrInvalidUpdateTarget                 = errors.New("the target document to update is of invalid type")
-	ErrInvalidUpdater                      = errors.New("the updater of a document is of invalid type")
-	ErrInvalidDeleteTarget                 = errors.New("the target document to delete is of invalid type")
-	ErrMalformedDocID                      = errors.New("malformed document ID, missing either version or cid")
-	ErrInvalidDocIDVersion                 = errors.New("invalid document ID version")
-	ErrInvalidJSONPayload                  = errors.New(errInvalidJSONPayload)
-	ErrCanNotNormalizeValue                = errors.New(errCanNotNormalizeValue)
-	ErrCanNotTurnNormalValueIntoArray      = errors.New(errCanNotTurnNormalValueIntoArray)
-	ErrCanNotMakeNormalNilFromFieldKind    = errors.New(errCanNotMakeNormalNilFromFieldKind)
-	ErrCollectionNotFound                  = errors.New(errCollectionNotFound)
-	ErrFailedToParseKind                   = errors.New(errFailedToParseKind)
+	ErrFieldNotExist                        = errors.New(errFieldNotExist)
+	ErrUnexpectedType                       = errors.New(errUnexpectedType)
+	ErrFailedToUnmarshalCollection          = errors.New(errFailedToUnmarshalCollection)
+	ErrOperationNotPermittedOnNamelessCols  = errors.New(errOperationNotPermittedOnNamelessCols)
+	ErrFieldNotObject                       = errors.New("trying to access field on a non object type")
+	ErrValueTypeMismatch                    = errors.New("value does not match indicated type")
+	ErrDocumentNotFoundOrNotAuthorized      = errors.New("document not found or not authorized to access")
+	ErrPolicyAddFailureNoACP                = errors.New("failure adding policy because ACP was not available")
+	ErrACPOperationButACPNotAvailable       = errors.New("operation requires ACP, but ACP not available")
+	ErrACPOperationButCollectionHasNoPolicy = errors.New("operation requires ACP, but collection has no policy")
+	ErrInvalidUpdateTarget                  = errors.New("the target document to update is of invalid type")
+	ErrInvalidUpdater                       = errors.New("the updater of a document is of invalid type")
+	ErrInvalidDeleteTarget                  = errors.New("the target document to delete is of invalid type")
+	ErrMalformedDocID                       = errors.New("malformed document ID, missing either version or cid")
+	ErrInvalidDocIDVersion                  = errors.New("invalid document ID version")
+	ErrInvalidJSONPayload                   = errors.New(errInvalidJSONPayload)
+	ErrCanNotNormalizeValue                 = errors.New(errCanNotNormalizeValue)
+	ErrCanNotTurnNormalValueIntoArray       = errors.New(errCanNotTurnNormalValueIntoArray)
+	ErrCanNotMakeNormalNilFromFieldKind     = errors.New(errCanNotMakeNormalNilFromFieldKind)
+	ErrCollectionNotFound                   = errors.New(errCollectionNotFound)
+	ErrFailedToParseKind                    = errors.New(errFailedToParseKind)
+	ErrUpsertMultipleDocuments              = errors.New("cannot upsert multiple matching documents")
+	ErrFieldNotArray                        = errors.New(errFieldNotArray)
+	ErrInvalidReplicatorStatus              = errors.New(errInvalidReplicatorStatus)
 )
 
 // NewErrFieldNotExist returns an error indicating that the given field does not exist.
@@ -66,6 +73,18 @@ func NewErrFieldNotExist(name string) error {
 	return errors.New(errFieldNotExist, errors.NewKV("Name", name))
 }
 
+// NewErrFieldNotArray returns an error indicating that the given field is not an array
+// of scalar values.
+func NewErrFieldNotArray(name string) error {
+	return errors.New(errFieldNotArray, errors.NewKV("Name", name))
+}
+
+// NewErrInvalidReplicatorStatus returns an error indicating that the given replicator
+// status is not valid.
+func NewErrInvalidReplicatorStatus(status any) error {
+	return errors.New(errInvalidReplicatorStatus, errors.NewKV("Status", status))
+}
+
 // NewErrFieldIndexNotExist returns an error indicating that a field does not exist at the
 // given location.
 func NewErrFieldIndexNotExist(index int) error {
@@ -24,6 +24,47 @@ type IndexedFieldDescription struct {
 	Descending bool
 }
 
+// IndexType is the type of an index.
+type IndexType string
+
+const (
+	// IndexTypeDefault is the type of indexes that store the values of the indexed fields.
+	IndexTypeDefault IndexType = ""
+	// IndexTypeFullText is the type of indexes that store the analyzed tokens of a text field.
+	//
+	// They serve the `_search` filter operator.
+	IndexTypeFullText IndexType = "fulltext"
+)
+
+// IndexAnalyzerFilter is a filter applied to the tokens of a text indexed by a full-text index.
+type IndexAnalyzerFilter string
+
+const (
+	// IndexAnalyzerLowercase converts tokens to lowercase.
+	IndexAnalyzerLowercase IndexAnalyzerFilter = "lowercase"
+	// IndexAnalyzerStopwords drops common English words.
+	IndexAnalyzerStopwords IndexAnalyzerFilter = "stopwords"
+	// IndexAnalyzerStemming reduces tokens to their stem, so that "runs" and "running" match.
+	IndexAnalyzerStemming IndexAnalyzerFilter = "stemming"
+)
+
+// IndexState is the build state of an index.
+type IndexState string
+
+const (
+	// IndexStateReady is the state of indexes that index all the documents of their collection.
+	//
+	// Only ready indexes are used to serve requests.
+	IndexStateReady IndexState = "ready"
+	// IndexStateBuilding is the state of indexes whose existing documents are being indexed
+	// in the background.
+	IndexStateBuilding IndexState = "building"
+	// IndexStateFailed is the state of indexes whose background build failed.
+	//
+	// They are not used to serve requests and should be dropped.
+	IndexStateFailed IndexState = "failed"
+)
+
 // IndexDescription describes an index.
 type IndexDescription struct {
 	// Name contains the name of the index.
@@ -34,6 +75,38 @@ type IndexDescription struct {
 	Fields []IndexedFieldDescription
 	// Unique indicates whether the index is unique.
 	Unique bool
+	// Type contains the type of the index.
+	Type IndexType `json:",omitempty"`
+	// Analyzer contains the filters applied to the tokens of a full-text index.
+	//
+	// If empty, all filters are applied.
+	Analyzer []IndexAnalyzerFilter `json:",omitempty"`
+	// Background indicates whether the existing documents are indexed in the background
+	// when the index is created.
+	//
+	// The index is then created in the building state, and is only used to serve requests
+	// once it is ready.
+	Background bool `json:",omitempty"`
+	// State contains the build state of the index.
+	State IndexState `json:",omitempty"`
+	// IndexedDocs contains the number of documents indexed so far by the background build.
+	IndexedDocs uint64 `json:",omitempty"`
+	// TotalDocs contains the number of documents to index by the background build.
+	TotalDocs uint64 `json:",omitempty"`
+	// BuildError contains the reason of the failure of the background build.
+	BuildError string `json:",omitempty"`
+}
+
+// IsFullText returns true if the index is a full-text index.
+func (d IndexDescription) IsFullText() bool {
+	return d.Type == IndexTypeFullText
+}
+
+// IsReady returns true if the index indexes all the documents of its collection.
+//
+// Indexes created before index states were introduced have no state and are ready.
+func (d IndexDescription) IsReady() bool {
+	return d.State == "" || d.State == IndexStateReady
 }
 
 // CollectionIndex is an interface for indexing documents in a collection.
@@ -70,12 +143,14 @@ func (d CollectionDefinition) CollectIndexedFields() []FieldDefinition {
 	return fields
 }
 
-// GetIndexesOnField returns all indexes that are indexing the given field.
+// GetIndexesOnField returns all ready indexes that are indexing the given field.
 // If the field is not the first field of a composite index, the index is not returned.
+//
+// Indexes that are still being built are not returned, as they do not index all documents yet.
 func (d CollectionDescription) GetIndexesOnField(fieldName string) []IndexDescription {
 	result := []IndexDescription{}
 	for _, index := range d.Indexes {
-		if index.Fields[0].Name == fieldName {
+		if index.IsReady() && index.Fields[0].Name == fieldName {
 			result = append(result, index)
 		}
 	}
@@ -752,6 +752,63 @@ func (_c *Collection_Name_Call) RunAndReturn(run func() immutable.Option[string]
 	return _c
 }
 
+// Purge provides a mock function with given fields: ctx, docID
+func (_m *Collection) Purge(ctx context.Context, docID client.DocID) (bool, error) {
+	ret := _m.Called(ctx, docID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.DocID) (bool, error)); ok {
+		return rf(ctx, docID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, client.DocID) bool); ok {
+		r0 = rf(ctx, docID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, client.DocID) error); ok {
+		r1 = rf(ctx, docID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Collection_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type Collection_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - docID client.DocID
+func (_e *Collection_Expecter) Purge(ctx interface{}, docID interface{}) *Collection_Purge_Call {
+	return &Collection_Purge_Call{Call: _e.mock.On("Purge", ctx, docID)}
+}
+
+func (_c *Collection_Purge_Call) Run(run func(ctx context.Context, docID client.DocID)) *Collection_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DocID))
+	})
+	return _c
+}
+
+func (_c *Collection_Purge_Call) Return(_a0 bool, _a1 error) *Collection_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Collection_Purge_Call) RunAndReturn(run func(context.Context, client.DocID) (bool, error)) *Collection_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, doc
 func (_m *Collection) Save(ctx context.Context, doc *client.Document) error {
 	ret := _m.Called(ctx, doc)
@@ -996,6 +1053,67 @@ func (_c *Collection_UpdateWithFilter_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Upsert provides a mock function with given fields: ctx, filter, doc, updater
+func (_m *Collection) Upsert(ctx context.Context, filter interface{}, doc *client.Document, updater string) (*client.UpsertResult, error) {
+	ret := _m.Called(ctx, filter, doc, updater)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 *client.UpsertResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, *client.Document, string) (*client.UpsertResult, error)); ok {
+		return rf(ctx, filter, doc, updater)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, *client.Document, string) *client.UpsertResult); ok {
+		r0 = rf(ctx, filter, doc, updater)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.UpsertResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, *client.Document, string) error); ok {
+		r1 = rf(ctx, filter, doc, updater)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Collection_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type Collection_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter interface{}
+//   - doc *client.Document
+//   - updater string
+func (_e *Collection_Expecter) Upsert(ctx interface{}, filter interface{}, doc interface{}, updater interface{}) *Collection_Upsert_Call {
+	return &Collection_Upsert_Call{Call: _e.mock.On("Upsert", ctx, filter, doc, updater)}
+}
+
+func (_c *Collection_Upsert_Call) Run(run func(ctx context.Context, filter interface{}, doc *client.Document, updater string)) *Collection_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(*client.Document), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Collection_Upsert_Call) Return(_a0 *client.UpsertResult, _a1 error) *Collection_Upsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Collection_Upsert_Call) RunAndReturn(run func(context.Context, interface{}, *client.Document, string) (*client.UpsertResult, error)) *Collection_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewCollection creates a new instance of Collection. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCollection(t interface {
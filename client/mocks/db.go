@@ -35,6 +35,66 @@ func (_m *DB) EXPECT() *DB_Expecter {
 	return &DB_Expecter{mock: &_m.Mock}
 }
 
+// AddDocActorRelationship provides a mock function with given fields: ctx, collectionName, docID, relation, targetActor
+func (_m *DB) AddDocActorRelationship(ctx context.Context, collectionName string, docID string, relation string, targetActor string) (client.AddDocActorRelationshipResult, error) {
+	ret := _m.Called(ctx, collectionName, docID, relation, targetActor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDocActorRelationship")
+	}
+
+	var r0 client.AddDocActorRelationshipResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (client.AddDocActorRelationshipResult, error)); ok {
+		return rf(ctx, collectionName, docID, relation, targetActor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) client.AddDocActorRelationshipResult); ok {
+		r0 = rf(ctx, collectionName, docID, relation, targetActor)
+	} else {
+		r0 = ret.Get(0).(client.AddDocActorRelationshipResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, collectionName, docID, relation, targetActor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_AddDocActorRelationship_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDocActorRelationship'
+type DB_AddDocActorRelationship_Call struct {
+	*mock.Call
+}
+
+// AddDocActorRelationship is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionName string
+//   - docID string
+//   - relation string
+//   - targetActor string
+func (_e *DB_Expecter) AddDocActorRelationship(ctx interface{}, collectionName interface{}, docID interface{}, relation interface{}, targetActor interface{}) *DB_AddDocActorRelationship_Call {
+	return &DB_AddDocActorRelationship_Call{Call: _e.mock.On("AddDocActorRelationship", ctx, collectionName, docID, relation, targetActor)}
+}
+
+func (_c *DB_AddDocActorRelationship_Call) Run(run func(ctx context.Context, collectionName string, docID string, relation string, targetActor string)) *DB_AddDocActorRelationship_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *DB_AddDocActorRelationship_Call) Return(_a0 client.AddDocActorRelationshipResult, _a1 error) *DB_AddDocActorRelationship_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_AddDocActorRelationship_Call) RunAndReturn(run func(context.Context, string, string, string, string) (client.AddDocActorRelationshipResult, error)) *DB_AddDocActorRelationship_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddP2PCollections provides a mock function with given fields: ctx, collectionIDs
 func (_m *DB) AddP2PCollections(ctx context.Context, collectionIDs []string) error {
 	ret := _m.Called(ctx, collectionIDs)
@@ -432,6 +492,124 @@ func (_c *DB_Close_Call) RunAndReturn(run func()) *DB_Close_Call {
 	return _c
 }
 
+// CompactCollection provides a mock function with given fields: ctx, collectionName, policy
+func (_m *DB) CompactCollection(ctx context.Context, collectionName string, policy immutable.Option[client.RetentionPolicy]) (client.CompactionResult, error) {
+	ret := _m.Called(ctx, collectionName, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompactCollection")
+	}
+
+	var r0 client.CompactionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, immutable.Option[client.RetentionPolicy]) (client.CompactionResult, error)); ok {
+		return rf(ctx, collectionName, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, immutable.Option[client.RetentionPolicy]) client.CompactionResult); ok {
+		r0 = rf(ctx, collectionName, policy)
+	} else {
+		r0 = ret.Get(0).(client.CompactionResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, immutable.Option[client.RetentionPolicy]) error); ok {
+		r1 = rf(ctx, collectionName, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_CompactCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompactCollection'
+type DB_CompactCollection_Call struct {
+	*mock.Call
+}
+
+// CompactCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionName string
+//   - policy immutable.Option[client.RetentionPolicy]
+func (_e *DB_Expecter) CompactCollection(ctx interface{}, collectionName interface{}, policy interface{}) *DB_CompactCollection_Call {
+	return &DB_CompactCollection_Call{Call: _e.mock.On("CompactCollection", ctx, collectionName, policy)}
+}
+
+func (_c *DB_CompactCollection_Call) Run(run func(ctx context.Context, collectionName string, policy immutable.Option[client.RetentionPolicy])) *DB_CompactCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(immutable.Option[client.RetentionPolicy]))
+	})
+	return _c
+}
+
+func (_c *DB_CompactCollection_Call) Return(_a0 client.CompactionResult, _a1 error) *DB_CompactCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_CompactCollection_Call) RunAndReturn(run func(context.Context, string, immutable.Option[client.RetentionPolicy]) (client.CompactionResult, error)) *DB_CompactCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDocActorRelationship provides a mock function with given fields: ctx, collectionName, docID, relation, targetActor
+func (_m *DB) DeleteDocActorRelationship(ctx context.Context, collectionName string, docID string, relation string, targetActor string) (client.DeleteDocActorRelationshipResult, error) {
+	ret := _m.Called(ctx, collectionName, docID, relation, targetActor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDocActorRelationship")
+	}
+
+	var r0 client.DeleteDocActorRelationshipResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (client.DeleteDocActorRelationshipResult, error)); ok {
+		return rf(ctx, collectionName, docID, relation, targetActor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) client.DeleteDocActorRelationshipResult); ok {
+		r0 = rf(ctx, collectionName, docID, relation, targetActor)
+	} else {
+		r0 = ret.Get(0).(client.DeleteDocActorRelationshipResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, collectionName, docID, relation, targetActor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_DeleteDocActorRelationship_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDocActorRelationship'
+type DB_DeleteDocActorRelationship_Call struct {
+	*mock.Call
+}
+
+// DeleteDocActorRelationship is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionName string
+//   - docID string
+//   - relation string
+//   - targetActor string
+func (_e *DB_Expecter) DeleteDocActorRelationship(ctx interface{}, collectionName interface{}, docID interface{}, relation interface{}, targetActor interface{}) *DB_DeleteDocActorRelationship_Call {
+	return &DB_DeleteDocActorRelationship_Call{Call: _e.mock.On("DeleteDocActorRelationship", ctx, collectionName, docID, relation, targetActor)}
+}
+
+func (_c *DB_DeleteDocActorRelationship_Call) Run(run func(ctx context.Context, collectionName string, docID string, relation string, targetActor string)) *DB_DeleteDocActorRelationship_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *DB_DeleteDocActorRelationship_Call) Return(_a0 client.DeleteDocActorRelationshipResult, _a1 error) *DB_DeleteDocActorRelationship_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_DeleteDocActorRelationship_Call) RunAndReturn(run func(context.Context, string, string, string, string) (client.DeleteDocActorRelationshipResult, error)) *DB_DeleteDocActorRelationship_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteReplicator provides a mock function with given fields: ctx, rep
 func (_m *DB) DeleteReplicator(ctx context.Context, rep client.Replicator) error {
 	ret := _m.Called(ctx, rep)
@@ -1771,6 +1949,54 @@ func (_c *DB_SetReplicator_Call) RunAndReturn(run func(context.Context, client.R
 	return _c
 }
 
+// SyncCollections provides a mock function with given fields: ctx, info, collectionNames
+func (_m *DB) SyncCollections(ctx context.Context, info peer.AddrInfo, collectionNames []string) error {
+	ret := _m.Called(ctx, info, collectionNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncCollections")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, peer.AddrInfo, []string) error); ok {
+		r0 = rf(ctx, info, collectionNames)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_SyncCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncCollections'
+type DB_SyncCollections_Call struct {
+	*mock.Call
+}
+
+// SyncCollections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - info peer.AddrInfo
+//   - collectionNames []string
+func (_e *DB_Expecter) SyncCollections(ctx interface{}, info interface{}, collectionNames interface{}) *DB_SyncCollections_Call {
+	return &DB_SyncCollections_Call{Call: _e.mock.On("SyncCollections", ctx, info, collectionNames)}
+}
+
+func (_c *DB_SyncCollections_Call) Run(run func(ctx context.Context, info peer.AddrInfo, collectionNames []string)) *DB_SyncCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(peer.AddrInfo), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *DB_SyncCollections_Call) Return(_a0 error) *DB_SyncCollections_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_SyncCollections_Call) RunAndReturn(run func(context.Context, peer.AddrInfo, []string) error) *DB_SyncCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewDB creates a new instance of DB. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDB(t interface {
@@ -44,4 +44,11 @@ type P2P interface {
 	// GetAllP2PCollections returns the list of persisted collection IDs that
 	// the P2P system subscribes to.
 	GetAllP2PCollections(ctx context.Context) ([]string, error)
+
+	// SyncCollections pulls the current document heads of the given collections from the
+	// given peer and merges them into the local documents, returning once they are merged.
+	//
+	// If no collection names are provided, all collections are synced. It will error if any
+	// of the provided collection names are invalid.
+	SyncCollections(ctx context.Context, info peer.AddrInfo, collectionNames []string) error
 }
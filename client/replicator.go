@@ -10,10 +10,71 @@
 
 package client
 
-import "github.com/libp2p/go-libp2p/core/peer"
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
 
 // Replicator is a peer that a set of local collections are replicated to.
 type Replicator struct {
 	Info    peer.AddrInfo
 	Schemas []string
+	// Status is the current status of the replicator.
+	Status ReplicatorStatus
+	// LastStatusChange is the time at which the status of the replicator last changed.
+	LastStatusChange time.Time
+	// LastError is the error returned by the last failed push to the replicator, if any.
+	LastError string
+	// PendingCount is the number of documents waiting to be pushed to the replicator.
+	PendingCount int
+}
+
+// ReplicatorStatus is the status of a Replicator.
+type ReplicatorStatus uint8
+
+const (
+	// ReplicatorStatusActive is the status of a replicator that updates are successfully pushed to.
+	ReplicatorStatusActive ReplicatorStatus = iota
+	// ReplicatorStatusInactive is the status of a replicator that updates failed to be pushed to.
+	//
+	// Failed updates are retried with an exponential backoff until the replicator is reachable again.
+	ReplicatorStatusInactive
+)
+
+// String returns the string representation of the status.
+func (s ReplicatorStatus) String() string {
+	switch s {
+	case ReplicatorStatusActive:
+		return "active"
+	case ReplicatorStatusInactive:
+		return "inactive"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// This ensures the status is serialized as "active" or "inactive" instead of a number.
+func (s ReplicatorStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReplicatorStatusActive, ReplicatorStatusInactive:
+		return []byte(s.String()), nil
+	default:
+		return nil, NewErrInvalidReplicatorStatus(s)
+	}
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *ReplicatorStatus) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case ReplicatorStatusActive.String():
+		*s = ReplicatorStatusActive
+	case ReplicatorStatusInactive.String():
+		*s = ReplicatorStatusInactive
+	default:
+		return NewErrInvalidReplicatorStatus(string(text))
+	}
+	return nil
 }
@@ -0,0 +1,43 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicatorJSON_WithInactiveStatus_ShouldMarshalStatusAsString(t *testing.T) {
+	pid, err := peer.Decode("12D3KooWB8Na2fKhdGtej5GjoVhmBBYFvqXiqFCSkR7fJFWHUbNr")
+	require.NoError(t, err)
+	rep := Replicator{
+		Info:   peer.AddrInfo{ID: pid},
+		Status: ReplicatorStatusInactive,
+	}
+
+	data, err := json.Marshal(rep)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"Status":"inactive"`)
+
+	var result Replicator
+	err = json.Unmarshal(data, &result)
+	require.NoError(t, err)
+	require.Equal(t, ReplicatorStatusInactive, result.Status)
+}
+
+func TestReplicatorStatusUnmarshalText_WithInvalidStatus_ShouldError(t *testing.T) {
+	var status ReplicatorStatus
+	err := status.UnmarshalText([]byte("unknown"))
+	require.ErrorIs(t, err, ErrInvalidReplicatorStatus)
+}
@@ -49,4 +49,9 @@ type AggregateTarget struct {
 	// When averaging Friends.Age on the User collection, this property would be
 	// "Age".
 	ChildName immutable.Option[string]
+
+	// Distinct specifies whether only distinct values should be aggregated.
+	//
+	// It is only supported by the `_count` aggregate.
+	Distinct bool
 }
@@ -22,6 +22,8 @@ const (
 	Cid         = "cid"
 	Input       = "input"
 	Inputs      = "inputs"
+	CreateInput = "create"
+	UpdateInput = "update"
 	FieldName   = "field"
 	FieldIDName = "fieldId"
 	ShowDeleted = "showDeleted"
@@ -29,28 +31,37 @@ const (
 	EncryptDocArgName    = "encrypt"
 	EncryptFieldsArgName = "encryptFields"
 
-	FilterClause  = "filter"
-	GroupByClause = "groupBy"
-	LimitClause   = "limit"
-	OffsetClause  = "offset"
-	OrderClause   = "order"
-	DepthClause   = "depth"
+	FilterClause   = "filter"
+	GroupByClause  = "groupBy"
+	LimitClause    = "limit"
+	OffsetClause   = "offset"
+	OrderClause    = "order"
+	DepthClause    = "depth"
+	DistinctClause = "distinct"
 
 	DocIDArgName  = "docID"
 	DocIDsArgName = "docIDs"
 
-	AverageFieldName = "_avg"
-	CountFieldName   = "_count"
-	DocIDFieldName   = "_docID"
-	GroupFieldName   = "_group"
-	DeletedFieldName = "_deleted"
-	SumFieldName     = "_sum"
-	VersionFieldName = "_version"
+	AllowanceFieldName = "_allowance"
+	AverageFieldName   = "_avg"
+	CountFieldName     = "_count"
+	DocIDFieldName     = "_docID"
+	GroupFieldName     = "_group"
+	DeletedFieldName   = "_deleted"
+	MaxFieldName       = "_max"
+	MinFieldName       = "_min"
+	ScoreFieldName     = "_score"
+	SumFieldName       = "_sum"
+	VersionFieldName   = "_version"
 
 	// New generated document id from a backed up document,
 	// which might have a different _docID originally.
 	NewDocIDFieldName = "_docIDNew"
 
+	// Mutation input operators that append values to, and remove values from, array fields.
+	AppendOperatorName = "_append"
+	RemoveOperatorName = "_remove"
+
 	ExplainLabel = "explain"
 
 	LatestCommitsName = "latestCommits"
@@ -65,6 +76,7 @@ const (
 	FieldNameFieldName       = "fieldName"
 	FieldIDFieldName         = "fieldId"
 	DeltaFieldName           = "delta"
+	SignatureFieldName       = "signature"
 
 	DeltaArgFieldName       = "FieldName"
 	DeltaArgData            = "Data"
@@ -81,6 +93,10 @@ const (
 	LinksNameFieldName = "name"
 	LinksCidFieldName  = "cid"
 
+	SignatureTypeFieldName     = "type"
+	SignatureIdentityFieldName = "identity"
+	SignatureValueFieldName    = "value"
+
 	ASC  = OrderDirection("ASC")
 	DESC = OrderDirection("DESC")
 )
@@ -101,20 +117,26 @@ var (
 	}
 
 	ReservedFields = map[string]struct{}{
-		TypeNameFieldName: {},
-		VersionFieldName:  {},
-		GroupFieldName:    {},
-		CountFieldName:    {},
-		SumFieldName:      {},
-		AverageFieldName:  {},
-		DocIDFieldName:    {},
-		DeletedFieldName:  {},
+		TypeNameFieldName:  {},
+		VersionFieldName:   {},
+		GroupFieldName:     {},
+		CountFieldName:     {},
+		SumFieldName:       {},
+		AverageFieldName:   {},
+		MinFieldName:       {},
+		MaxFieldName:       {},
+		DocIDFieldName:     {},
+		DeletedFieldName:   {},
+		AllowanceFieldName: {},
+		ScoreFieldName:     {},
 	}
 
 	Aggregates = map[string]struct{}{
 		CountFieldName:   {},
 		SumFieldName:     {},
 		AverageFieldName: {},
+		MinFieldName:     {},
+		MaxFieldName:     {},
 	}
 
 	CommitQueries = map[string]struct{}{
@@ -137,4 +159,10 @@ var (
 		LinksNameFieldName,
 		LinksCidFieldName,
 	}
+
+	SignatureFields = []string{
+		SignatureTypeFieldName,
+		SignatureIdentityFieldName,
+		SignatureValueFieldName,
+	}
 )
@@ -13,9 +13,17 @@ package request
 import "github.com/sourcenetwork/immutable"
 
 const (
-	FilterOpOr  = "_or"
-	FilterOpAnd = "_and"
-	FilterOpNot = "_not"
+	FilterOpOr     = "_or"
+	FilterOpAnd    = "_and"
+	FilterOpNot    = "_not"
+	FilterOpSearch = "_search"
+
+	FilterOpEqual          = "_eq"
+	FilterOpIn             = "_in"
+	FilterOpGreaterThan    = "_gt"
+	FilterOpGreaterOrEqual = "_ge"
+	FilterOpLessThan       = "_lt"
+	FilterOpLessOrEqual    = "_le"
 )
 
 // Filter contains the parsed condition map to be
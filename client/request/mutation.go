@@ -17,6 +17,8 @@ const (
 	CreateObjects
 	UpdateObjects
 	DeleteObjects
+	UpsertObjects
+	PurgeObjects
 )
 
 // ObjectMutation is a field on the `mutation` operation of a graphql request. It includes
@@ -48,6 +50,18 @@ type ObjectMutation struct {
 	// This is ignored for [DeleteObjects] mutations.
 	Inputs []map[string]any
 
+	// CreateInput is the json representation of the fieldName-value pairs of the document
+	// properties to create if no existing document matches the filter.
+	//
+	// This is only used by [UpsertObjects] mutations.
+	CreateInput map[string]any
+
+	// UpdateInput is the json representation of the fieldName-value pairs of the document
+	// properties to update if an existing document matches the filter.
+	//
+	// This is only used by [UpsertObjects] mutations.
+	UpdateInput map[string]any
+
 	// Encrypt is a boolean flag that indicates whether the input data should be encrypted.
 	Encrypt bool
 
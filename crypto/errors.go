@@ -0,0 +1,50 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crypto
+
+import (
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errMissingPrivateKey        string = "missing private key"
+	errInvalidPublicKey         string = "invalid public key"
+	errInvalidSignature         string = "invalid signature"
+	errSignatureVerification    string = "signature verification failed"
+	errUnsupportedSignatureType string = "unsupported signature type"
+)
+
+// Errors returnable from this package.
+//
+// This list is incomplete and undefined errors may also be returned.
+// Errors returned from this package may be tested against these errors with errors.Is.
+var (
+	ErrMissingPrivateKey        = errors.New(errMissingPrivateKey)
+	ErrInvalidPublicKey         = errors.New(errInvalidPublicKey)
+	ErrInvalidSignature         = errors.New(errInvalidSignature)
+	ErrSignatureVerification    = errors.New(errSignatureVerification)
+	ErrUnsupportedSignatureType = errors.New(errUnsupportedSignatureType)
+)
+
+// NewErrInvalidPublicKey returns an error indicating that the public key could not be parsed.
+func NewErrInvalidPublicKey(inner error) error {
+	return errors.Wrap(errInvalidPublicKey, inner)
+}
+
+// NewErrInvalidSignature returns an error indicating that the signature could not be parsed.
+func NewErrInvalidSignature(inner error) error {
+	return errors.Wrap(errInvalidSignature, inner)
+}
+
+// NewErrUnsupportedSignatureType returns an error indicating that the signature type is not supported.
+func NewErrUnsupportedSignatureType(sigType SignatureType) error {
+	return errors.New(errUnsupportedSignatureType, errors.NewKV("Type", sigType))
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crypto
+
+import (
+	"crypto/sha256"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// SignatureType is the algorithm used to produce a signature.
+type SignatureType string
+
+const (
+	// SignatureTypeSecp256k1 is an ECDSA signature over the SHA-256 hash
+	// of the data using a secp256k1 key.
+	SignatureTypeSecp256k1 SignatureType = "ES256K"
+)
+
+// SignSecp256k1 signs the SHA-256 hash of the given data using the given secp256k1 private key.
+//
+// The returned signature is DER encoded.
+func SignSecp256k1(privKey *secp256k1.PrivateKey, data []byte) ([]byte, error) {
+	if privKey == nil {
+		return nil, ErrMissingPrivateKey
+	}
+	hash := sha256.Sum256(data)
+	return ecdsa.Sign(privKey, hash[:]).Serialize(), nil
+}
+
+// Verify checks that the given signature of the given type is a valid signature
+// of the data by the holder of the given public key.
+//
+// The public key is expected to be in its serialized secp256k1 form, compressed or uncompressed.
+func Verify(sigType SignatureType, publicKey []byte, data []byte, signature []byte) error {
+	switch sigType {
+	case SignatureTypeSecp256k1:
+		pubKey, err := secp256k1.ParsePubKey(publicKey)
+		if err != nil {
+			return NewErrInvalidPublicKey(err)
+		}
+		sig, err := ecdsa.ParseDERSignature(signature)
+		if err != nil {
+			return NewErrInvalidSignature(err)
+		}
+		hash := sha256.Sum256(data)
+		if !sig.Verify(hash[:], pubKey) {
+			return ErrSignatureVerification
+		}
+		return nil
+
+	default:
+		return NewErrUnsupportedSignatureType(sigType)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignSecp256k1_WithValidData_ShouldVerify(t *testing.T) {
+	privKey, err := GenerateSecp256k1()
+	require.NoError(t, err)
+
+	sig, err := SignSecp256k1(privKey, []byte("data"))
+	require.NoError(t, err)
+
+	err = Verify(SignatureTypeSecp256k1, privKey.PubKey().SerializeCompressed(), []byte("data"), sig)
+	require.NoError(t, err)
+
+	err = Verify(SignatureTypeSecp256k1, privKey.PubKey().SerializeUncompressed(), []byte("data"), sig)
+	require.NoError(t, err)
+}
+
+func TestSignSecp256k1_WithOtherData_ShouldFailVerification(t *testing.T) {
+	privKey, err := GenerateSecp256k1()
+	require.NoError(t, err)
+
+	sig, err := SignSecp256k1(privKey, []byte("data"))
+	require.NoError(t, err)
+
+	err = Verify(SignatureTypeSecp256k1, privKey.PubKey().SerializeCompressed(), []byte("other"), sig)
+	require.ErrorIs(t, err, ErrSignatureVerification)
+}
+
+func TestSignSecp256k1_WithNilKey_Error(t *testing.T) {
+	_, err := SignSecp256k1(nil, []byte("data"))
+	require.ErrorIs(t, err, ErrMissingPrivateKey)
+}
+
+func TestVerify_WithInvalidPublicKey_Error(t *testing.T) {
+	err := Verify(SignatureTypeSecp256k1, []byte("invalid"), []byte("data"), []byte("sig"))
+	require.ErrorIs(t, err, ErrInvalidPublicKey)
+}
+
+func TestVerify_WithUnsupportedType_Error(t *testing.T) {
+	err := Verify(SignatureType("RSA"), []byte("key"), []byte("data"), []byte("sig"))
+	require.ErrorIs(t, err, ErrUnsupportedSignatureType)
+}
@@ -5,6 +5,7 @@
 package badger
 
 import (
+	"bytes"
 	"context"
 	"runtime"
 	"strings"
@@ -653,6 +654,11 @@ func (t *txn) query(q dsq.Query) (dsq.Results, error) {
 
 		// All iterators must be started by rewinding.
 		it.Rewind()
+		if opt.Reverse && len(opt.Prefix) > 0 {
+			// A reversed iterator rewinds to the last key before the prefix,
+			// so it must be moved to the last key with the prefix instead.
+			seekToPrefixEnd(it, opt.Prefix)
+		}
 
 		// skip to the offset
 		for skipped := 0; skipped < q.Offset && it.Valid(); it.Next() {
@@ -796,3 +802,20 @@ func filter(filters []dsq.Filter, entry dsq.Entry) bool {
 func expires(item *badger.Item) time.Time {
 	return time.Unix(int64(item.ExpiresAt()), 0)
 }
+
+// seekToPrefixEnd moves the given reversed iterator to the last key with the given prefix.
+func seekToPrefixEnd(it *badger.Iterator, prefix []byte) {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			end = end[:i+1]
+			break
+		}
+	}
+	it.Seek(end)
+	// the key the iterator was moved to may be the end key itself
+	if !it.Valid() && it.Item() != nil && bytes.Equal(it.Item().Key(), end) {
+		it.Next()
+	}
+}
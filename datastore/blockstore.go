@@ -45,9 +45,12 @@ import (
 
 // NewBlockstore returns a default Blockstore implementation
 // using the provided datastore.Batching backend.
-func newBlockstore(store DSReaderWriter) *bstore {
+//
+// The compacted store holds the markers of the blocks removed by a compaction.
+func newBlockstore(store DSReaderWriter, compacted DSReaderWriter) *bstore {
 	return &bstore{
-		store: store,
+		store:     store,
+		compacted: compacted,
 	}
 }
 
@@ -56,7 +59,8 @@ func newIPLDStore(store blockstore.Blockstore) *bsadapter.Adapter {
 }
 
 type bstore struct {
-	store DSReaderWriter
+	store     DSReaderWriter
+	compacted DSReaderWriter
 
 	rehash bool
 }
@@ -152,6 +156,16 @@ func (bs *bstore) DeleteBlock(ctx context.Context, k cid.Cid) error {
 	return bs.store.Delete(ctx, dshelp.MultihashToDsKey(k.Hash()))
 }
 
+// MarkCompacted marks the block with the given CID as removed by a compaction.
+func (bs *bstore) MarkCompacted(ctx context.Context, k cid.Cid) error {
+	return bs.compacted.Put(ctx, dshelp.MultihashToDsKey(k.Hash()), []byte{})
+}
+
+// IsCompacted returns whether the block with the given CID was removed by a compaction.
+func (bs *bstore) IsCompacted(ctx context.Context, k cid.Cid) (bool, error) {
+	return bs.compacted.Has(ctx, dshelp.MultihashToDsKey(k.Hash()))
+}
+
 // AllKeysChan runs a query for keys from the blockstore.
 //
 // AllKeysChan respects context.
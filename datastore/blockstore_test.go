@@ -192,3 +192,31 @@ func TestPutManyWithStoreClosed(t *testing.T) {
 	err = bs.PutMany(ctx, []blocks.Block{b, b2})
 	require.ErrorIs(t, err, ErrClosed)
 }
+
+func TestBStoreMarkCompacted(t *testing.T) {
+	ctx := context.Background()
+	rootstore := memory.NewDatastore(ctx)
+	rootRW := AsDSReaderWriter(rootstore)
+
+	bs := newBlockstore(prefix(rootRW, blockStoreKey), prefix(rootRW, compactedKey))
+
+	cID, err := ccid.NewSHA256CidV1(data)
+	require.NoError(t, err)
+	b, err := blocks.NewBlockWithCid(data, cID)
+	require.NoError(t, err)
+	err = bs.Put(ctx, b)
+	require.NoError(t, err)
+
+	err = bs.DeleteBlock(ctx, cID)
+	require.NoError(t, err)
+	err = bs.MarkCompacted(ctx, cID)
+	require.NoError(t, err)
+
+	compacted, err := bs.IsCompacted(ctx, cID)
+	require.NoError(t, err)
+	require.True(t, compacted)
+
+	has, err := bs.Has(ctx, cID)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -96,7 +96,12 @@ type betweenFilter struct {
 }
 
 func (f betweenFilter) Filter(e dsq.Entry) bool {
-	return e.Key >= f.start && e.Key <= f.end
+	// the start of a range iterated in descending order is its upper bound
+	low, high := f.start, f.end
+	if low > high {
+		low, high = high, low
+	}
+	return e.Key >= low && e.Key <= high
 }
 
 func (shim *iteratorShim) Close() error {
@@ -384,6 +384,110 @@ func (_c *DAGStore_HashOnRead_Call) RunAndReturn(run func(bool)) *DAGStore_HashO
 	return _c
 }
 
+// IsCompacted provides a mock function with given fields: ctx, c
+func (_m *DAGStore) IsCompacted(ctx context.Context, c cid.Cid) (bool, error) {
+	ret := _m.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCompacted")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, cid.Cid) (bool, error)); ok {
+		return rf(ctx, c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, cid.Cid) bool); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, cid.Cid) error); ok {
+		r1 = rf(ctx, c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DAGStore_IsCompacted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCompacted'
+type DAGStore_IsCompacted_Call struct {
+	*mock.Call
+}
+
+// IsCompacted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c cid.Cid
+func (_e *DAGStore_Expecter) IsCompacted(ctx interface{}, c interface{}) *DAGStore_IsCompacted_Call {
+	return &DAGStore_IsCompacted_Call{Call: _e.mock.On("IsCompacted", ctx, c)}
+}
+
+func (_c *DAGStore_IsCompacted_Call) Run(run func(ctx context.Context, c cid.Cid)) *DAGStore_IsCompacted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(cid.Cid))
+	})
+	return _c
+}
+
+func (_c *DAGStore_IsCompacted_Call) Return(_a0 bool, _a1 error) *DAGStore_IsCompacted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DAGStore_IsCompacted_Call) RunAndReturn(run func(context.Context, cid.Cid) (bool, error)) *DAGStore_IsCompacted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkCompacted provides a mock function with given fields: ctx, c
+func (_m *DAGStore) MarkCompacted(ctx context.Context, c cid.Cid) error {
+	ret := _m.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCompacted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, cid.Cid) error); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DAGStore_MarkCompacted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCompacted'
+type DAGStore_MarkCompacted_Call struct {
+	*mock.Call
+}
+
+// MarkCompacted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c cid.Cid
+func (_e *DAGStore_Expecter) MarkCompacted(ctx interface{}, c interface{}) *DAGStore_MarkCompacted_Call {
+	return &DAGStore_MarkCompacted_Call{Call: _e.mock.On("MarkCompacted", ctx, c)}
+}
+
+func (_c *DAGStore_MarkCompacted_Call) Run(run func(ctx context.Context, c cid.Cid)) *DAGStore_MarkCompacted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(cid.Cid))
+	})
+	return _c
+}
+
+func (_c *DAGStore_MarkCompacted_Call) Return(_a0 error) *DAGStore_MarkCompacted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DAGStore_MarkCompacted_Call) RunAndReturn(run func(context.Context, cid.Cid) error) *DAGStore_MarkCompacted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Put provides a mock function with given fields: _a0, _a1
 func (_m *DAGStore) Put(_a0 context.Context, _a1 blocks.Block) error {
 	ret := _m.Called(_a0, _a1)
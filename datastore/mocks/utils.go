@@ -62,6 +62,7 @@ func prepareDAGStore(t *testing.T) *DAGStore {
 	dagStore := NewDAGStore(t)
 	dagStore.EXPECT().Put(mock.Anything, mock.Anything).Return(nil).Maybe()
 	dagStore.EXPECT().Has(mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	dagStore.EXPECT().IsCompacted(mock.Anything, mock.Anything).Return(false, nil).Maybe()
 	return dagStore
 }
 
@@ -24,6 +24,7 @@ var (
 	blockStoreKey  = rootStoreKey.ChildString("blocks")
 	peerStoreKey   = rootStoreKey.ChildString("ps")
 	encStoreKey    = rootStoreKey.ChildString("enc")
+	compactedKey   = rootStoreKey.ChildString("compacted")
 )
 
 type multistore struct {
@@ -49,7 +50,7 @@ func MultiStoreFrom(rootstore ds.Datastore) MultiStore {
 		head:   prefix(rootRW, headStoreKey),
 		peer:   namespace.Wrap(rootstore, peerStoreKey),
 		system: prefix(rootRW, systemStoreKey),
-		dag:    newBlockstore(prefix(rootRW, blockStoreKey)),
+		dag:    newBlockstore(prefix(rootRW, blockStoreKey), prefix(rootRW, compactedKey)),
 	}
 
 	return ms
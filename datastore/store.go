@@ -11,7 +11,10 @@
 package datastore
 
 import (
+	"context"
+
 	"github.com/ipfs/boxo/blockstore"
+	"github.com/ipfs/go-cid"
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipld/go-ipld-prime/storage"
 
@@ -74,6 +77,14 @@ type DSReaderWriter interface {
 type Blockstore interface {
 	blockstore.Blockstore
 	AsIPLDStorage() IPLDStorage
+
+	// MarkCompacted marks the block with the given CID as removed by a compaction.
+	//
+	// DAG walks stop at compacted blocks instead of failing to find them.
+	MarkCompacted(ctx context.Context, c cid.Cid) error
+
+	// IsCompacted returns whether the block with the given CID was removed by a compaction.
+	IsCompacted(ctx context.Context, c cid.Cid) (bool, error)
 }
 
 // IPLDStorage provides the methods needed for an IPLD LinkSystem.
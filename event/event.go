@@ -39,6 +39,14 @@ const (
 	P2PTopicCompletedName = Name("p2p-topic-completed")
 	// ReplicatorCompletedName is the name of the replicator completed event.
 	ReplicatorCompletedName = Name("replicator-completed")
+	// P2PSyncName is the name of the network p2p sync request event.
+	P2PSyncName = Name("p2p-sync")
+	// ReplicatorFailureName is the name of the replicator failure event.
+	ReplicatorFailureName = Name("replicator-failure")
+	// ReplicatorRetryName is the name of the replicator retry event.
+	ReplicatorRetryName = Name("replicator-retry")
+	// ReplicatorConnectedName is the name of the replicator connected event.
+	ReplicatorConnectedName = Name("replicator-connected")
 )
 
 // PubSub is an event that is published when
@@ -135,3 +143,52 @@ type Replicator struct {
 	// and those collections have documents to be replicated.
 	Docs <-chan Update
 }
+
+// P2PSync is an event that is published when documents need to be pulled from a remote peer.
+type P2PSync struct {
+	// Info is the peer info of the remote peer to pull the documents from.
+	Info peer.AddrInfo
+	// SchemaRoots are the root identifiers of the schemas of the collections to pull.
+	SchemaRoots []string
+	// Result will receive the outcome of the sync once the document graphs
+	// of the remote heads have been fetched.
+	Result chan<- P2PSyncResult
+}
+
+// P2PSyncResult is the outcome of a [P2PSync] request.
+type P2PSyncResult struct {
+	// Merges are the merges that need to be performed to catch up with the remote peer.
+	Merges []Merge
+	// Err is set if the sync failed.
+	Err error
+}
+
+// ReplicatorFailure is an event that is published when an update fails to be pushed to a replicator.
+type ReplicatorFailure struct {
+	// PeerID is the id of the replicator the update failed to be pushed to.
+	PeerID peer.ID
+	// DocID is the unique immutable identifier of the document that failed to be pushed.
+	DocID string
+	// SchemaRoot is the root identifier of the schema of the document.
+	SchemaRoot string
+	// Err is the error returned by the failed push.
+	Err error
+}
+
+// ReplicatorRetry is an event that is published when the updates that failed to be
+// pushed to a replicator need to be pushed again.
+type ReplicatorRetry struct {
+	// PeerID is the id of the replicator to push the updates to.
+	PeerID peer.ID
+	// Updates contains the current heads of the documents that need to be pushed.
+	Updates []Update
+	// Result will receive the error of the first failed push, or nil if all
+	// of the updates have been pushed.
+	Result chan<- error
+}
+
+// ReplicatorConnected is an event that is published when a replicator is connected to.
+type ReplicatorConnected struct {
+	// PeerID is the id of the connected replicator.
+	PeerID peer.ID
+}
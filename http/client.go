@@ -245,6 +245,28 @@ func (c *Client) RefreshViews(ctx context.Context, options client.CollectionFetc
 	return err
 }
 
+func (c *Client) CompactCollection(
+	ctx context.Context,
+	collectionName string,
+	policy immutable.Option[client.RetentionPolicy],
+) (client.CompactionResult, error) {
+	methodURL := c.http.baseURL.JoinPath("collections", collectionName, "compact")
+
+	body, err := json.Marshal(CollectionCompactRequest{Retention: policy})
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	var result client.CompactionResult
+	if err := c.http.requestJson(req, &result); err != nil {
+		return client.CompactionResult{}, err
+	}
+	return result, nil
+}
+
 func (c *Client) SetMigration(ctx context.Context, config client.LensConfig) error {
 	methodURL := c.http.baseURL.JoinPath("lens")
 
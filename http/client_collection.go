@@ -207,6 +207,28 @@ func (c *Collection) Delete(
 	return true, nil
 }
 
+func (c *Collection) Purge(
+	ctx context.Context,
+	docID client.DocID,
+) (bool, error) {
+	if !c.Description().Name.HasValue() {
+		return false, client.ErrOperationNotPermittedOnNamelessCols
+	}
+
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name.Value(), docID.String(), "purge")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), nil)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = c.http.request(req)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (c *Collection) Exists(
 	ctx context.Context,
 	docID client.DocID,
@@ -250,6 +272,48 @@ func (c *Collection) UpdateWithFilter(
 	return &result, nil
 }
 
+func (c *Collection) Upsert(
+	ctx context.Context,
+	filter any,
+	doc *client.Document,
+	updater string,
+) (*client.UpsertResult, error) {
+	if !c.Description().Name.HasValue() {
+		return nil, client.ErrOperationNotPermittedOnNamelessCols
+	}
+
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name.Value())
+
+	create, err := doc.String()
+	if err != nil {
+		return nil, err
+	}
+
+	request := CollectionUpsertRequest{
+		Filter:  filter,
+		Create:  create,
+		Updater: updater,
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var result client.UpsertResult
+	if err := c.http.requestJson(req, &result); err != nil {
+		return nil, err
+	}
+	if result.Created {
+		doc.Clean()
+	}
+	return &result, nil
+}
+
 func (c *Collection) DeleteWithFilter(
 	ctx context.Context,
 	filter any,
@@ -21,6 +21,11 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 )
 
+type p2pSyncRequest struct {
+	Info        peer.AddrInfo
+	Collections []string
+}
+
 func (c *Client) PeerInfo() peer.AddrInfo {
 	methodURL := c.http.baseURL.JoinPath("p2p", "info")
 
@@ -122,3 +127,21 @@ func (c *Client) GetAllP2PCollections(ctx context.Context) ([]string, error) {
 	}
 	return cols, nil
 }
+
+func (c *Client) SyncCollections(ctx context.Context, info peer.AddrInfo, collectionNames []string) error {
+	methodURL := c.http.baseURL.JoinPath("p2p", "sync")
+
+	body, err := json.Marshal(p2pSyncRequest{
+		Info:        info,
+		Collections: collectionNames,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
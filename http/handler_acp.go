@@ -11,6 +11,7 @@
 package http
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 
@@ -46,6 +47,64 @@ func (s *acpHandler) AddPolicy(rw http.ResponseWriter, req *http.Request) {
 	responseJSON(rw, http.StatusOK, addPolicyResult)
 }
 
+func (s *acpHandler) AddDocActorRelationship(rw http.ResponseWriter, req *http.Request) {
+	db, ok := req.Context().Value(dbContextKey).(client.DB)
+	if !ok {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{NewErrFailedToGetContext("db")})
+		return
+	}
+
+	var message addDocActorRelationshipRequest
+	err := json.NewDecoder(req.Body).Decode(&message)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	addDocActorRelResult, err := db.AddDocActorRelationship(
+		req.Context(),
+		message.CollectionName,
+		message.DocID,
+		message.Relation,
+		message.TargetActor,
+	)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	responseJSON(rw, http.StatusOK, addDocActorRelResult)
+}
+
+func (s *acpHandler) DeleteDocActorRelationship(rw http.ResponseWriter, req *http.Request) {
+	db, ok := req.Context().Value(dbContextKey).(client.DB)
+	if !ok {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{NewErrFailedToGetContext("db")})
+		return
+	}
+
+	var message deleteDocActorRelationshipRequest
+	err := json.NewDecoder(req.Body).Decode(&message)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	deleteDocActorRelResult, err := db.DeleteDocActorRelationship(
+		req.Context(),
+		message.CollectionName,
+		message.DocID,
+		message.Relation,
+		message.TargetActor,
+	)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	responseJSON(rw, http.StatusOK, deleteDocActorRelResult)
+}
+
 func (h *acpHandler) bindRoutes(router *Router) {
 	successResponse := &openapi3.ResponseRef{
 		Ref: "#/components/responses/success",
@@ -69,5 +128,53 @@ func (h *acpHandler) bindRoutes(router *Router) {
 		Value: acpAddPolicyRequest,
 	}
 
+	acpAddDocActorRelationshipSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/acp_add_doc_actor_relationship_request",
+	}
+	acpAddDocActorRelationshipRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithJSONSchemaRef(acpAddDocActorRelationshipSchema)
+
+	acpAddDocActorRelationship := openapi3.NewOperation()
+	acpAddDocActorRelationship.OperationID = "add relationship"
+	acpAddDocActorRelationship.Description = "Add an actor relationship using acp system"
+	acpAddDocActorRelationship.Tags = []string{"acp_relationship"}
+	acpAddDocActorRelationship.Responses = openapi3.NewResponses()
+	acpAddDocActorRelationship.Responses.Set("200", successResponse)
+	acpAddDocActorRelationship.Responses.Set("400", errorResponse)
+	acpAddDocActorRelationship.RequestBody = &openapi3.RequestBodyRef{
+		Value: acpAddDocActorRelationshipRequest,
+	}
+
+	acpDeleteDocActorRelationshipSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/acp_delete_doc_actor_relationship_request",
+	}
+	acpDeleteDocActorRelationshipRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithJSONSchemaRef(acpDeleteDocActorRelationshipSchema)
+
+	acpDeleteDocActorRelationship := openapi3.NewOperation()
+	acpDeleteDocActorRelationship.OperationID = "delete relationship"
+	acpDeleteDocActorRelationship.Description = "Delete an actor relationship using acp system"
+	acpDeleteDocActorRelationship.Tags = []string{"acp_relationship"}
+	acpDeleteDocActorRelationship.Responses = openapi3.NewResponses()
+	acpDeleteDocActorRelationship.Responses.Set("200", successResponse)
+	acpDeleteDocActorRelationship.Responses.Set("400", errorResponse)
+	acpDeleteDocActorRelationship.RequestBody = &openapi3.RequestBodyRef{
+		Value: acpDeleteDocActorRelationshipRequest,
+	}
+
 	router.AddRoute("/acp/policy", http.MethodPost, acpAddPolicy, h.AddPolicy)
+	router.AddRoute(
+		"/acp/relationship",
+		http.MethodPost,
+		acpAddDocActorRelationship,
+		h.AddDocActorRelationship,
+	)
+	router.AddRoute(
+		"/acp/relationship",
+		http.MethodDelete,
+		acpDeleteDocActorRelationship,
+		h.DeleteDocActorRelationship,
+	)
 }
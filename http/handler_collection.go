@@ -20,6 +20,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/internal/encryption"
@@ -39,6 +40,16 @@ type CollectionUpdateRequest struct {
 	Updater string `json:"updater"`
 }
 
+type CollectionUpsertRequest struct {
+	Filter  any    `json:"filter"`
+	Create  string `json:"create"`
+	Updater string `json:"updater"`
+}
+
+type CollectionCompactRequest struct {
+	Retention immutable.Option[client.RetentionPolicy] `json:"retention"`
+}
+
 func (s *collectionHandler) Create(rw http.ResponseWriter, req *http.Request) {
 	col := req.Context().Value(colContextKey).(client.Collection)
 
@@ -122,6 +133,29 @@ func (s *collectionHandler) UpdateWithFilter(rw http.ResponseWriter, req *http.R
 	responseJSON(rw, http.StatusOK, result)
 }
 
+func (s *collectionHandler) Upsert(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	var request CollectionUpsertRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	doc, err := client.NewDocFromJSON([]byte(request.Create), col.Definition())
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	result, err := col.Upsert(req.Context(), request.Filter, doc, request.Updater)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, result)
+}
+
 func (s *collectionHandler) Update(rw http.ResponseWriter, req *http.Request) {
 	col := req.Context().Value(colContextKey).(client.Collection)
 
@@ -176,6 +210,23 @@ func (s *collectionHandler) Delete(rw http.ResponseWriter, req *http.Request) {
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *collectionHandler) Purge(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	docID, err := client.NewDocIDFromString(chi.URLParam(req, "docID"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	_, err = col.Purge(req.Context(), docID)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
 func (s *collectionHandler) Get(rw http.ResponseWriter, req *http.Request) {
 	col := req.Context().Value(colContextKey).(client.Collection)
 	showDeleted, _ := strconv.ParseBool(req.URL.Query().Get("show_deleted"))
@@ -290,6 +341,26 @@ func (s *collectionHandler) DropIndex(rw http.ResponseWriter, req *http.Request)
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *collectionHandler) Compact(rw http.ResponseWriter, req *http.Request) {
+	db, ok := req.Context().Value(dbContextKey).(client.DB)
+	if !ok {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{NewErrFailedToGetContext("db")})
+		return
+	}
+
+	var request CollectionCompactRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	result, err := db.CompactCollection(req.Context(), chi.URLParam(req, "name"), request.Retention)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, result)
+}
+
 func (h *collectionHandler) bindRoutes(router *Router) {
 	errorResponse := &openapi3.ResponseRef{
 		Ref: "#/components/responses/error",
@@ -303,6 +374,12 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	updateResultSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/update_result",
 	}
+	collectionUpsertSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/collection_upsert",
+	}
+	upsertResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/upsert_result",
+	}
 	collectionDeleteSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/collection_delete",
 	}
@@ -315,6 +392,12 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	indexSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/index",
 	}
+	collectionCompactSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/collection_compact",
+	}
+	compactionResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/compaction_result",
+	}
 
 	collectionNamePathParam := openapi3.NewPathParameter("name").
 		WithDescription("Collection name").
@@ -365,6 +448,25 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionUpdateWith.AddResponse(200, collectionUpdateWithResponse)
 	collectionUpdateWith.Responses.Set("400", errorResponse)
 
+	collectionUpsertRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(collectionUpsertSchema))
+
+	collectionUpsertResponse := openapi3.NewResponse().
+		WithDescription("Upsert result").
+		WithJSONSchemaRef(upsertResultSchema)
+
+	collectionUpsert := openapi3.NewOperation()
+	collectionUpsert.OperationID = "collection_upsert"
+	collectionUpsert.Description = "Update the document matching a filter, or create it if none match"
+	collectionUpsert.Tags = []string{"collection"}
+	collectionUpsert.AddParameter(collectionNamePathParam)
+	collectionUpsert.RequestBody = &openapi3.RequestBodyRef{
+		Value: collectionUpsertRequest,
+	}
+	collectionUpsert.AddResponse(200, collectionUpsertResponse)
+	collectionUpsert.Responses.Set("400", errorResponse)
+
 	collectionDeleteWithRequest := openapi3.NewRequestBody().
 		WithRequired(true).
 		WithContent(openapi3.NewContentWithJSONSchemaRef(collectionDeleteSchema))
@@ -468,6 +570,16 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionDelete.Responses.Set("200", successResponse)
 	collectionDelete.Responses.Set("400", errorResponse)
 
+	collectionPurge := openapi3.NewOperation()
+	collectionPurge.Description = "Purge a document and its history by docID"
+	collectionPurge.OperationID = "collection_purge"
+	collectionPurge.Tags = []string{"collection"}
+	collectionPurge.AddParameter(collectionNamePathParam)
+	collectionPurge.AddParameter(documentIDPathParam)
+	collectionPurge.Responses = openapi3.NewResponses()
+	collectionPurge.Responses.Set("200", successResponse)
+	collectionPurge.Responses.Set("400", errorResponse)
+
 	collectionKeys := openapi3.NewOperation()
 	collectionKeys.AddParameter(collectionNamePathParam)
 	collectionKeys.Description = "Get all document IDs"
@@ -477,14 +589,35 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionKeys.Responses.Set("200", successResponse)
 	collectionKeys.Responses.Set("400", errorResponse)
 
+	collectionCompactRequest := openapi3.NewRequestBody().
+		WithContent(openapi3.NewContentWithJSONSchemaRef(collectionCompactSchema))
+
+	collectionCompactResponse := openapi3.NewResponse().
+		WithDescription("Compaction result").
+		WithJSONSchemaRef(compactionResultSchema)
+
+	collectionCompact := openapi3.NewOperation()
+	collectionCompact.OperationID = "collection_compact"
+	collectionCompact.Description = "Compact the history of the documents in a collection"
+	collectionCompact.Tags = []string{"collection"}
+	collectionCompact.AddParameter(collectionNamePathParam)
+	collectionCompact.RequestBody = &openapi3.RequestBodyRef{
+		Value: collectionCompactRequest,
+	}
+	collectionCompact.AddResponse(200, collectionCompactResponse)
+	collectionCompact.Responses.Set("400", errorResponse)
+
 	router.AddRoute("/collections/{name}", http.MethodGet, collectionKeys, h.GetAllDocIDs)
 	router.AddRoute("/collections/{name}", http.MethodPost, collectionCreate, h.Create)
 	router.AddRoute("/collections/{name}", http.MethodPatch, collectionUpdateWith, h.UpdateWithFilter)
+	router.AddRoute("/collections/{name}", http.MethodPut, collectionUpsert, h.Upsert)
 	router.AddRoute("/collections/{name}", http.MethodDelete, collectionDeleteWith, h.DeleteWithFilter)
+	router.AddRoute("/collections/{name}/compact", http.MethodPost, collectionCompact, h.Compact)
 	router.AddRoute("/collections/{name}/indexes", http.MethodPost, createIndex, h.CreateIndex)
 	router.AddRoute("/collections/{name}/indexes", http.MethodGet, getIndexes, h.GetIndexes)
 	router.AddRoute("/collections/{name}/indexes/{index}", http.MethodDelete, dropIndex, h.DropIndex)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodGet, collectionGet, h.Get)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodPatch, collectionUpdate, h.Update)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodDelete, collectionDelete, h.Delete)
+	router.AddRoute("/collections/{name}/{docID}/purge", http.MethodPost, collectionPurge, h.Purge)
 }
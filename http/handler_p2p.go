@@ -139,6 +139,26 @@ func (s *p2pHandler) GetAllP2PCollections(rw http.ResponseWriter, req *http.Requ
 	responseJSON(rw, http.StatusOK, cols)
 }
 
+func (s *p2pHandler) SyncCollections(rw http.ResponseWriter, req *http.Request) {
+	p2p, ok := req.Context().Value(dbContextKey).(client.P2P)
+	if !ok {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{ErrP2PDisabled})
+		return
+	}
+
+	var syncReq p2pSyncRequest
+	if err := requestJSON(req, &syncReq); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	err := p2p.SyncCollections(req.Context(), syncReq.Info, syncReq.Collections)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
 func (h *p2pHandler) bindRoutes(router *Router) {
 	successResponse := &openapi3.ResponseRef{
 		Ref: "#/components/responses/success",
@@ -242,6 +262,24 @@ func (h *p2pHandler) bindRoutes(router *Router) {
 	removePeerCollections.Responses.Set("200", successResponse)
 	removePeerCollections.Responses.Set("400", errorResponse)
 
+	p2pSyncSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/p2p_sync_request",
+	}
+	p2pSyncRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(p2pSyncSchema))
+
+	syncCollections := openapi3.NewOperation()
+	syncCollections.Description = "Sync collections from a peer"
+	syncCollections.OperationID = "peer_sync"
+	syncCollections.Tags = []string{"p2p"}
+	syncCollections.RequestBody = &openapi3.RequestBodyRef{
+		Value: p2pSyncRequest,
+	}
+	syncCollections.Responses = openapi3.NewResponses()
+	syncCollections.Responses.Set("200", successResponse)
+	syncCollections.Responses.Set("400", errorResponse)
+
 	router.AddRoute("/p2p/info", http.MethodGet, peerInfo, h.PeerInfo)
 	router.AddRoute("/p2p/replicators", http.MethodGet, getReplicators, h.GetAllReplicators)
 	router.AddRoute("/p2p/replicators", http.MethodPost, setReplicator, h.SetReplicator)
@@ -249,4 +287,5 @@ func (h *p2pHandler) bindRoutes(router *Router) {
 	router.AddRoute("/p2p/collections", http.MethodGet, getPeerCollections, h.GetAllP2PCollections)
 	router.AddRoute("/p2p/collections", http.MethodPost, addPeerCollections, h.AddP2PCollection)
 	router.AddRoute("/p2p/collections", http.MethodDelete, removePeerCollections, h.RemoveP2PCollection)
+	router.AddRoute("/p2p/sync", http.MethodPost, syncCollections, h.SyncCollections)
 }
@@ -11,6 +11,8 @@
 package http
 
 import (
+	"reflect"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3gen"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -20,28 +22,49 @@ import (
 
 // openApiSchemas is a mapping of types to auto generate schemas for.
 var openApiSchemas = map[string]any{
-	"error":                 &errorResponse{},
-	"create_tx":             &CreateTxResponse{},
-	"collection_update":     &CollectionUpdateRequest{},
-	"collection_delete":     &CollectionDeleteRequest{},
-	"peer_info":             &peer.AddrInfo{},
-	"graphql_request":       &GraphQLRequest{},
-	"graphql_response":      &GraphQLResponse{},
-	"backup_config":         &client.BackupConfig{},
-	"collection":            &client.CollectionDescription{},
-	"schema":                &client.SchemaDescription{},
-	"collection_definition": &client.CollectionDefinition{},
-	"index":                 &client.IndexDescription{},
-	"delete_result":         &client.DeleteResult{},
-	"update_result":         &client.UpdateResult{},
-	"lens_config":           &client.LensConfig{},
-	"replicator":            &client.Replicator{},
-	"ccip_request":          &CCIPRequest{},
-	"ccip_response":         &CCIPResponse{},
-	"patch_schema_request":  &patchSchemaRequest{},
-	"add_view_request":      &addViewRequest{},
-	"migrate_request":       &migrateRequest{},
-	"set_migration_request": &setMigrationRequest{},
+	"error":                                  &errorResponse{},
+	"create_tx":                              &CreateTxResponse{},
+	"collection_update":                      &CollectionUpdateRequest{},
+	"collection_upsert":                      &CollectionUpsertRequest{},
+	"collection_delete":                      &CollectionDeleteRequest{},
+	"collection_compact":                     &CollectionCompactRequest{},
+	"peer_info":                              &peer.AddrInfo{},
+	"graphql_request":                        &GraphQLRequest{},
+	"graphql_response":                       &GraphQLResponse{},
+	"backup_config":                          &client.BackupConfig{},
+	"collection":                             &client.CollectionDescription{},
+	"schema":                                 &client.SchemaDescription{},
+	"collection_definition":                  &client.CollectionDefinition{},
+	"index":                                  &client.IndexDescription{},
+	"delete_result":                          &client.DeleteResult{},
+	"update_result":                          &client.UpdateResult{},
+	"upsert_result":                          &client.UpsertResult{},
+	"compaction_result":                      &client.CompactionResult{},
+	"lens_config":                            &client.LensConfig{},
+	"replicator":                             &client.Replicator{},
+	"p2p_sync_request":                       &p2pSyncRequest{},
+	"ccip_request":                           &CCIPRequest{},
+	"ccip_response":                          &CCIPResponse{},
+	"patch_schema_request":                   &patchSchemaRequest{},
+	"add_view_request":                       &addViewRequest{},
+	"migrate_request":                        &migrateRequest{},
+	"set_migration_request":                  &setMigrationRequest{},
+	"acp_add_doc_actor_relationship_request": &addDocActorRelationshipRequest{},
+	"acp_delete_doc_actor_relationship_request": &deleteDocActorRelationshipRequest{},
+	"add_doc_actor_relationship_result":         &client.AddDocActorRelationshipResult{},
+	"delete_doc_actor_relationship_result":      &client.DeleteDocActorRelationshipResult{},
+}
+
+// customizeSchema overrides the generated schemas of types that
+// are serialized differently from their underlying Go type.
+func customizeSchema(name string, t reflect.Type, tag reflect.StructTag, schema *openapi3.Schema) error {
+	if t == reflect.TypeOf(client.ReplicatorStatus(0)) {
+		*schema = *openapi3.NewStringSchema().WithEnum(
+			client.ReplicatorStatusActive.String(),
+			client.ReplicatorStatusInactive.String(),
+		)
+	}
+	return nil
 }
 
 func NewOpenAPISpec() (*openapi3.T, error) {
@@ -49,7 +72,10 @@ func NewOpenAPISpec() (*openapi3.T, error) {
 	responses := make(openapi3.ResponseBodies)
 	parameters := make(openapi3.ParametersMap)
 
-	generator := openapi3gen.NewGenerator(openapi3gen.UseAllExportedFields())
+	generator := openapi3gen.NewGenerator(
+		openapi3gen.UseAllExportedFields(),
+		openapi3gen.SchemaCustomizer(customizeSchema),
+	)
 	for key, val := range openApiSchemas {
 		ref, err := generator.NewSchemaRefForValue(val, schemas)
 		if err != nil {
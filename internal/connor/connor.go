@@ -46,6 +46,8 @@ func matchWith(op string, conditions, data any) (bool, error) {
 		return nilike(conditions, data)
 	case "_not":
 		return not(conditions, data)
+	case "_search":
+		return search(conditions, data)
 	default:
 		return false, NewErrUnknownOperator(op)
 	}
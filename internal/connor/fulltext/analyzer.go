@@ -0,0 +1,142 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+/*
+Package fulltext provides the text analysis shared by full-text indexes and the `_search`
+filter operator.
+
+Text is split into tokens on any character that is not a letter or a digit, the tokens are
+then passed through the filters of the analyzer.
+*/
+package fulltext
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// stopwords is the set of common English words dropped by the stopwords filter.
+var stopwords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "but": {}, "by": {},
+	"for": {}, "if": {}, "in": {}, "into": {}, "is": {}, "it": {}, "no": {}, "not": {}, "of": {},
+	"on": {}, "or": {}, "such": {}, "that": {}, "the": {}, "their": {}, "then": {}, "there": {},
+	"these": {}, "they": {}, "this": {}, "to": {}, "was": {}, "will": {}, "with": {},
+}
+
+// DefaultFilters returns the filters applied by the analyzer of a full-text index
+// if none are specified.
+func DefaultFilters() []client.IndexAnalyzerFilter {
+	return []client.IndexAnalyzerFilter{
+		client.IndexAnalyzerLowercase,
+		client.IndexAnalyzerStopwords,
+		client.IndexAnalyzerStemming,
+	}
+}
+
+// Analyzer splits text into the tokens used to index and search it.
+type Analyzer struct {
+	lowercase bool
+	stopwords bool
+	stemming  bool
+}
+
+// NewAnalyzer returns a new analyzer applying the given filters.
+//
+// The filters are always applied in the same order: lowercase, stopwords and then stemming.
+func NewAnalyzer(filters []client.IndexAnalyzerFilter) (Analyzer, error) {
+	var analyzer Analyzer
+	for _, filter := range filters {
+		switch filter {
+		case client.IndexAnalyzerLowercase:
+			analyzer.lowercase = true
+		case client.IndexAnalyzerStopwords:
+			analyzer.stopwords = true
+		case client.IndexAnalyzerStemming:
+			analyzer.stemming = true
+		default:
+			return Analyzer{}, NewErrUnknownAnalyzerFilter(filter)
+		}
+	}
+	return analyzer, nil
+}
+
+// DefaultAnalyzer returns the analyzer applying the default filters.
+func DefaultAnalyzer() Analyzer {
+	return Analyzer{
+		lowercase: true,
+		stopwords: true,
+		stemming:  true,
+	}
+}
+
+// Tokenize returns the tokens of the given text, in the order they appear in.
+func (a Analyzer) Tokenize(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		if a.lowercase {
+			word = strings.ToLower(word)
+		}
+		if a.stopwords {
+			if _, isStopword := stopwords[strings.ToLower(word)]; isStopword {
+				continue
+			}
+		}
+		if a.stemming {
+			word = stem(word)
+		}
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
+
+// ContainsAll returns true if the given tokens contain all of the given terms.
+//
+// No terms are never contained, as a search without any term can not match.
+func ContainsAll(tokens []string, terms []string) bool {
+	if len(terms) == 0 {
+		return false
+	}
+	set := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		set[token] = struct{}{}
+	}
+	for _, term := range terms {
+		if _, ok := set[term]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Score returns the relevance of the given tokens to the given terms.
+//
+// The score grows with the number of occurrences of each term and is normalized
+// by the number of tokens, so a term found in a short text scores higher than the
+// same term found in a long one.
+func Score(tokens []string, terms []string) float64 {
+	if len(tokens) == 0 {
+		return 0
+	}
+	frequencies := make(map[string]int, len(tokens))
+	for _, token := range tokens {
+		frequencies[token]++
+	}
+	var score float64
+	for _, term := range terms {
+		score += math.Sqrt(float64(frequencies[term]))
+	}
+	return score / math.Sqrt(float64(len(tokens)))
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestDefaultAnalyzerTokenize(t *testing.T) {
+	tokens := DefaultAnalyzer().Tokenize("The Ponies were running, and hopping-caresses!")
+	require.Equal(t, []string{"poni", "were", "run", "hop", "caress"}, tokens)
+}
+
+func TestAnalyzerTokenize_WithLowercaseOnly(t *testing.T) {
+	analyzer, err := NewAnalyzer([]client.IndexAnalyzerFilter{client.IndexAnalyzerLowercase})
+	require.NoError(t, err)
+
+	tokens := analyzer.Tokenize("The Ponies were running")
+	require.Equal(t, []string{"the", "ponies", "were", "running"}, tokens)
+}
+
+func TestNewAnalyzer_WithUnknownFilter_ShouldError(t *testing.T) {
+	_, err := NewAnalyzer([]client.IndexAnalyzerFilter{"synonyms"})
+	require.ErrorIs(t, err, ErrUnknownAnalyzerFilter)
+}
+
+func TestStem(t *testing.T) {
+	cases := map[string]string{
+		"caresses":  "caress",
+		"ponies":    "poni",
+		"cats":      "cat",
+		"agreed":    "agree",
+		"plastered": "plaster",
+		"motoring":  "motor",
+		"sing":      "sing",
+		"hopping":   "hop",
+		"falling":   "fall",
+		"filing":    "file",
+		"happy":     "happi",
+		"sky":       "sky",
+	}
+	for word, expected := range cases {
+		require.Equal(t, expected, stem(word), word)
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	tokens := []string{"blue", "shoe", "run"}
+
+	require.True(t, ContainsAll(tokens, []string{"run", "shoe"}))
+	require.False(t, ContainsAll(tokens, []string{"run", "boot"}))
+	require.False(t, ContainsAll(tokens, nil))
+}
+
+func TestScore(t *testing.T) {
+	short := Score([]string{"shoe", "run"}, []string{"shoe"})
+	long := Score([]string{"shoe", "run", "fast", "blue"}, []string{"shoe"})
+	repeated := Score([]string{"shoe", "run", "shoe", "blue"}, []string{"shoe"})
+
+	require.Greater(t, short, long)
+	require.Greater(t, repeated, long)
+	require.Equal(t, float64(0), Score(nil, []string{"shoe"}))
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fulltext
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errUnknownAnalyzerFilter string = "unknown full-text analyzer filter"
+)
+
+// Errors returnable from this package.
+//
+// This list is incomplete and undefined errors may also be returned.
+// Errors returned from this package may be tested against these errors with errors.Is.
+var (
+	ErrUnknownAnalyzerFilter = errors.New(errUnknownAnalyzerFilter)
+)
+
+func NewErrUnknownAnalyzerFilter(filter client.IndexAnalyzerFilter) error {
+	return errors.New(errUnknownAnalyzerFilter, errors.NewKV("Filter", filter))
+}
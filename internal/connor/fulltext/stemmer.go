@@ -0,0 +1,143 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fulltext
+
+import "strings"
+
+// stem returns the stem of the given word.
+//
+// It implements the first step of the Porter stemming algorithm, which removes plurals
+// and the -ed and -ing suffixes. This is enough for a search for "running" to match
+// "runs", without the cost and surprises of the full algorithm.
+func stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	word = stemPlural(word)
+	word = stemPastAndProgressive(word)
+	return stemTerminalY(word)
+}
+
+// stemPlural implements step 1a of the Porter stemming algorithm.
+func stemPlural(word string) string {
+	switch {
+	case strings.HasSuffix(word, "sses"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "ies"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "ss"):
+		return word
+	case strings.HasSuffix(word, "s"):
+		return word[:len(word)-1]
+	default:
+		return word
+	}
+}
+
+// stemPastAndProgressive implements step 1b of the Porter stemming algorithm.
+func stemPastAndProgressive(word string) string {
+	if strings.HasSuffix(word, "eed") {
+		if measure(word[:len(word)-3]) > 0 {
+			return word[:len(word)-1]
+		}
+		return word
+	}
+
+	var stem string
+	switch {
+	case strings.HasSuffix(word, "ed"):
+		stem = word[:len(word)-2]
+	case strings.HasSuffix(word, "ing"):
+		stem = word[:len(word)-3]
+	default:
+		return word
+	}
+	if !containsVowel(stem) {
+		return word
+	}
+
+	switch {
+	case strings.HasSuffix(stem, "at"), strings.HasSuffix(stem, "bl"), strings.HasSuffix(stem, "iz"):
+		return stem + "e"
+	case endsWithDoubleConsonant(stem):
+		last := stem[len(stem)-1]
+		if last != 'l' && last != 's' && last != 'z' {
+			return stem[:len(stem)-1]
+		}
+		return stem
+	case measure(stem) == 1 && endsWithCVC(stem):
+		return stem + "e"
+	default:
+		return stem
+	}
+}
+
+// stemTerminalY implements step 1c of the Porter stemming algorithm.
+func stemTerminalY(word string) string {
+	if strings.HasSuffix(word, "y") && containsVowel(word[:len(word)-1]) {
+		return word[:len(word)-1] + "i"
+	}
+	return word
+}
+
+// isConsonant returns true if the letter at the given position is a consonant.
+//
+// A 'y' is a consonant unless it follows a consonant.
+func isConsonant(word string, i int) bool {
+	switch word[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !isConsonant(word, i-1)
+	default:
+		return true
+	}
+}
+
+// measure returns the number of vowel-consonant sequences in the given word.
+func measure(word string) int {
+	m := 0
+	previousIsVowel := false
+	for i := range word {
+		isVowel := !isConsonant(word, i)
+		if previousIsVowel && !isVowel {
+			m++
+		}
+		previousIsVowel = isVowel
+	}
+	return m
+}
+
+func containsVowel(word string) bool {
+	for i := range word {
+		if !isConsonant(word, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func endsWithDoubleConsonant(word string) bool {
+	n := len(word)
+	return n >= 2 && word[n-1] == word[n-2] && isConsonant(word, n-1)
+}
+
+// endsWithCVC returns true if the word ends with a consonant-vowel-consonant sequence,
+// where the last consonant is not a 'w', 'x' or 'y'.
+func endsWithCVC(word string) bool {
+	n := len(word)
+	if n < 3 {
+		return false
+	}
+	last := word[n-1]
+	return isConsonant(word, n-3) && !isConsonant(word, n-2) && isConsonant(word, n-1) &&
+		last != 'w' && last != 'x' && last != 'y'
+}
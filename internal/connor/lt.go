@@ -31,6 +31,11 @@ func lt(condition, data any) (bool, error) {
 		default:
 			return false, client.NewErrUnhandledType("data", d)
 		}
+	case string:
+		if d, ok := data.(string); ok {
+			return d < c, nil
+		}
+		return false, nil
 	default:
 		switch cn := numbers.TryUpcast(condition).(type) {
 		case float64:
@@ -0,0 +1,33 @@
+package connor
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/connor/fulltext"
+)
+
+// search is an operator which performs full-text search tests.
+//
+// The data matches if it contains all the terms of the condition once both have been
+// analyzed by the default full-text analyzer.
+func search(condition, data any) (bool, error) {
+	switch d := data.(type) {
+	case immutable.Option[string]:
+		if !d.HasValue() {
+			return false, nil
+		}
+		data = d.Value()
+	}
+
+	switch cn := condition.(type) {
+	case string:
+		if d, ok := data.(string); ok {
+			analyzer := fulltext.DefaultAnalyzer()
+			return fulltext.ContainsAll(analyzer.Tokenize(d), analyzer.Tokenize(cn)), nil
+		}
+		return false, nil
+	default:
+		return false, client.NewErrUnhandledType("condition", cn)
+	}
+}
@@ -0,0 +1,37 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	const testString = "Source Is The Glue of Web3, running everywhere"
+
+	// match all words in any order
+	result, err := search("web3 source", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// match by stem
+	result, err = search("runs", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// no match if a word is missing
+	result, err = search("source tape", testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// no match for stopwords only
+	result, err = search("the of", testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// no match for nil value
+	result, err = search("source", immutable.None[string]())
+	require.NoError(t, err)
+	require.False(t, result)
+}
@@ -15,6 +15,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
 	"github.com/ipld/go-ipld-prime/codec/dagcbor"
@@ -23,6 +24,7 @@ import (
 	"github.com/ipld/go-ipld-prime/schema"
 	"github.com/multiformats/go-multicodec"
 
+	"github.com/sourcenetwork/defradb/crypto"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/core/crdt"
 )
@@ -37,10 +39,14 @@ func init() {
 	Schema, SchemaPrototype = mustSetSchema(
 		&Block{},
 		&DAGLink{},
+		&Signature{},
 		&crdt.CRDT{},
 		&crdt.LWWRegDelta{},
 		&crdt.CompositeDAGDelta{},
 		&crdt.CounterDelta{},
+		&crdt.SetDelta{},
+		&crdt.ListDelta{},
+		&crdt.BoundedCounterDelta{},
 	)
 }
 
@@ -97,6 +103,38 @@ func NewDAGLink(name string, link cidlink.Link) DAGLink {
 	}
 }
 
+// SignatureHeader contains the information needed to verify a block signature.
+type SignatureHeader struct {
+	// Type is the algorithm used to produce the signature.
+	Type string
+	// Identity is the serialized public key of the signer.
+	Identity []byte
+}
+
+// Signature is the signature of a block by a given identity.
+type Signature struct {
+	// Header contains the signature type and the identity of the signer.
+	Header SignatureHeader
+	// Value is the signature of the block's encoded bytes, without the signature.
+	Value []byte
+}
+
+// IPLDSchemaBytes returns the IPLD schema representation for the Signature.
+//
+// This needs to match the [Signature] struct or [mustSetSchema] will panic on init.
+func (s Signature) IPLDSchemaBytes() []byte {
+	return []byte(`
+	type SignatureHeader struct {
+		type     String
+		identity Bytes
+	}
+
+	type Signature struct {
+		header SignatureHeader
+		value  Bytes
+	}`)
+}
+
 // Block is a block that contains a CRDT delta and links to other blocks.
 type Block struct {
 	// Delta is the CRDT delta that is stored in the block.
@@ -106,6 +144,8 @@ type Block struct {
 	// IsEncrypted is a flag that indicates if the block's delta is encrypted.
 	// It needs to be a pointer so that it can be translated from and to `optional Bool` in the IPLD schema.
 	IsEncrypted *bool
+	// Signature is the optional signature of the block by the identity that created it.
+	Signature *Signature
 }
 
 // IPLDSchemaBytes returns the IPLD schema representation for the block.
@@ -117,6 +157,7 @@ func (b Block) IPLDSchemaBytes() []byte {
 		delta				 CRDT
 		links				 [ DAGLink ]
 		isEncrypted optional Bool
+		signature   optional Signature
 	}`)
 }
 
@@ -200,6 +241,74 @@ func (block *Block) GenerateNode() (node ipld.Node) {
 	return bindnode.Wrap(block, Schema).Representation()
 }
 
+// Sign signs the block with the given private key and sets its signature.
+//
+// Only secp256k1 (*secp256k1.PrivateKey) keys, as held by ACP identities, are supported.
+// Any existing signature is replaced.
+func (block *Block) Sign(privKey any) error {
+	data, err := block.signedBytes()
+	if err != nil {
+		return err
+	}
+
+	var sig *Signature
+	switch key := privKey.(type) {
+	case *secp256k1.PrivateKey:
+		value, err := crypto.SignSecp256k1(key, data)
+		if err != nil {
+			return NewErrSigningBlock(err)
+		}
+		sig = &Signature{
+			Header: SignatureHeader{
+				Type:     string(crypto.SignatureTypeSecp256k1),
+				Identity: key.PubKey().SerializeCompressed(),
+			},
+			Value: value,
+		}
+
+	default:
+		return NewErrUnsupportedSigningKey(privKey)
+	}
+
+	block.Signature = sig
+	return nil
+}
+
+// VerifySignature checks that the block signature is valid.
+//
+// It returns [ErrMissingSignature] if the block is not signed.
+func (block *Block) VerifySignature() error {
+	if block.Signature == nil {
+		return ErrMissingSignature
+	}
+	data, err := block.signedBytes()
+	if err != nil {
+		return err
+	}
+	err = crypto.Verify(
+		crypto.SignatureType(block.Signature.Header.Type),
+		block.Signature.Header.Identity,
+		data,
+		block.Signature.Value,
+	)
+	if err != nil {
+		return NewErrInvalidBlockSignature(err)
+	}
+	return nil
+}
+
+// signedBytes returns the encoded bytes of the block without its signature.
+//
+// These are the bytes that are signed when a block is signed.
+func (block *Block) signedBytes() ([]byte, error) {
+	unsigned := &Block{
+		Delta:       block.Delta,
+		Links:       block.Links,
+		IsEncrypted: block.IsEncrypted,
+	}
+	return unsigned.Marshal()
+}
+
 // GetLinkByName returns the link by name. It will return false if the link does not exist.
 func (block *Block) GetLinkByName(name string) (cidlink.Link, bool) {
 	for _, link := range block.Links {
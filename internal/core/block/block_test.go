@@ -21,6 +21,7 @@ import (
 	"github.com/ipld/go-ipld-prime/storage/memstore"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcenetwork/defradb/crypto"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/core/crdt"
 )
@@ -228,3 +229,77 @@ func TestBlockMarshal_IsEncryptedNotSetWithLinkSystem_ShouldLoadWithNoError(t *t
 	_, err = GetFromNode(nd)
 	require.NoError(t, err)
 }
+
+func newTestCompositeBlock() *Block {
+	return &Block{
+		Delta: crdt.CRDT{
+			CompositeDAGDelta: &crdt.CompositeDAGDelta{
+				DocID:           []byte("docID"),
+				FieldName:       "C",
+				Priority:        1,
+				SchemaVersionID: "schemaVersionID",
+				Status:          1,
+			},
+		},
+	}
+}
+
+func TestBlockMarshal_SignatureNotSet_ShouldNotContainSignatureField(t *testing.T) {
+	b, err := newTestCompositeBlock().Marshal()
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "signature")
+}
+
+func TestBlockSign_WithSecp256k1Key_ShouldVerify(t *testing.T) {
+	privKey, err := crypto.GenerateSecp256k1()
+	require.NoError(t, err)
+
+	block := newTestCompositeBlock()
+	err = block.Sign(privKey)
+	require.NoError(t, err)
+
+	require.Equal(t, string(crypto.SignatureTypeSecp256k1), block.Signature.Header.Type)
+	require.Equal(t, privKey.PubKey().SerializeCompressed(), block.Signature.Header.Identity)
+
+	b, err := block.Marshal()
+	require.NoError(t, err)
+	decoded, err := GetFromBytes(b)
+	require.NoError(t, err)
+
+	err = decoded.VerifySignature()
+	require.NoError(t, err)
+}
+
+func TestBlockSign_WithEd25519Key_Error(t *testing.T) {
+	privKey, err := crypto.GenerateEd25519()
+	require.NoError(t, err)
+
+	block := newTestCompositeBlock()
+	err = block.Sign(privKey)
+	require.ErrorIs(t, err, ErrUnsupportedKey)
+}
+
+func TestBlockSign_WithUnsupportedKey_Error(t *testing.T) {
+	block := newTestCompositeBlock()
+	err := block.Sign("not a key")
+	require.ErrorIs(t, err, ErrUnsupportedKey)
+}
+
+func TestBlockVerifySignature_WithTamperedBlock_Error(t *testing.T) {
+	privKey, err := crypto.GenerateSecp256k1()
+	require.NoError(t, err)
+
+	block := newTestCompositeBlock()
+	err = block.Sign(privKey)
+	require.NoError(t, err)
+
+	block.Delta.CompositeDAGDelta.Status = 2
+
+	err = block.VerifySignature()
+	require.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestBlockVerifySignature_WithUnsignedBlock_Error(t *testing.T) {
+	err := newTestCompositeBlock().VerifySignature()
+	require.ErrorIs(t, err, ErrMissingSignature)
+}
@@ -21,6 +21,10 @@ const (
 	errEncodingBlock      string = "failed to encode block"
 	errUnmarshallingBlock string = "failed to unmarshal block"
 	errGeneratingLink     string = "failed to generate link"
+	errSigningBlock       string = "failed to sign block"
+	errUnsupportedKey     string = "unsupported signing key type"
+	errMissingSignature   string = "block is not signed"
+	errInvalidSignature   string = "invalid block signature"
 )
 
 // Errors returnable from this package.
@@ -32,6 +36,10 @@ var (
 	ErrEncodingBlock      = errors.New(errEncodingBlock)
 	ErrUnmarshallingBlock = errors.New(errUnmarshallingBlock)
 	ErrGeneratingLink     = errors.New(errGeneratingLink)
+	ErrSigningBlock       = errors.New(errSigningBlock)
+	ErrUnsupportedKey     = errors.New(errUnsupportedKey)
+	ErrMissingSignature   = errors.New(errMissingSignature)
+	ErrInvalidSignature   = errors.New(errInvalidSignature)
 )
 
 // NewErrFailedToGetPriority returns an error indicating that the priority could not be retrieved.
@@ -66,3 +74,27 @@ func NewErrGeneratingLink(err error) error {
 		err,
 	)
 }
+
+// NewErrSigningBlock returns an error indicating that the block could not be signed.
+func NewErrSigningBlock(err error) error {
+	return errors.Wrap(
+		errSigningBlock,
+		err,
+	)
+}
+
+// NewErrUnsupportedSigningKey returns an error indicating that the given key cannot be used to sign blocks.
+func NewErrUnsupportedSigningKey(key any) error {
+	return errors.New(
+		errUnsupportedKey,
+		errors.NewKV("Type", fmt.Sprintf("%T", key)),
+	)
+}
+
+// NewErrInvalidBlockSignature returns an error indicating that the block signature is not valid.
+func NewErrInvalidBlockSignature(err error) error {
+	return errors.Wrap(
+		errInvalidSignature,
+		err,
+	)
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// elementID uniquely identifies an element added to an array CRDT.
+//
+// It is made up of the priority and nonce of the delta that added the element,
+// and the position of the element within that delta.
+type elementID struct {
+	Priority uint64
+	Nonce    int64
+	Index    int
+}
+
+// compare returns -1 if the id is less than other, 0 if they are equal and 1 otherwise.
+func (id elementID) compare(other elementID) int {
+	if c := cmp.Compare(id.Priority, other.Priority); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(id.Nonce, other.Nonce); c != 0 {
+		return c
+	}
+	return cmp.Compare(id.Index, other.Index)
+}
+
+// decodeArray decodes the given CBOR array into its raw CBOR encoded elements.
+//
+// A nil value is treated as an empty array.
+func decodeArray(data []byte) ([]cbor.RawMessage, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var values []cbor.RawMessage
+	err := cbor.Unmarshal(data, &values)
+	if err != nil {
+		return nil, NewErrInvalidArrayValue(err)
+	}
+	return values, nil
+}
+
+// encodeArray encodes the given raw CBOR elements into a CBOR array.
+func encodeArray(values []cbor.RawMessage) ([]byte, error) {
+	if values == nil {
+		values = []cbor.RawMessage{}
+	}
+	return cbor.Marshal(values)
+}
+
+// getState reads the internal state of the array CRDT into the given target.
+//
+// The target is left untouched if no state has been stored yet.
+func (base baseCRDT) getState(ctx context.Context, target any) error {
+	buf, err := base.store.Get(ctx, base.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return cbor.Unmarshal(buf, target)
+}
+
+// setState stores the internal state of the array CRDT along with its materialized value.
+func (base baseCRDT) setState(ctx context.Context, state any, value []byte, priority uint64) error {
+	buf, err := cbor.Marshal(state)
+	if err != nil {
+		return err
+	}
+	err = base.store.Put(ctx, base.key.WithStateFlag().ToDS(), buf)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	valueKey, err := base.valueKey(ctx)
+	if err != nil {
+		return err
+	}
+	err = base.store.Put(ctx, valueKey.ToDS(), value)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	curPrio, err := base.getPriority(ctx, base.key)
+	if err != nil {
+		return NewErrFailedToGetPriority(err)
+	}
+	if priority < curPrio {
+		return nil
+	}
+	return base.setPriority(ctx, base.key, priority)
+}
@@ -11,14 +11,19 @@
 package crdt
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/binary"
+	"math"
+	"math/big"
 
 	ds "github.com/ipfs/go-datastore"
 
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
 )
 
 // baseCRDT is embedded as a base layer into all
@@ -83,3 +88,38 @@ func (base baseCRDT) getPriority(ctx context.Context, key core.DataStoreKey) (ui
 	}
 	return prio, nil
 }
+
+// newNonce returns a random number that can be added to a delta to ensure that
+// the dag block holding it is unique.
+//
+// This is done only on update (if the doc doesn't already exist zero is returned) to ensure
+// that the initial dag block of a document can be reproducible.
+func (base baseCRDT) newNonce(ctx context.Context) (int64, error) {
+	exists, err := base.store.Has(ctx, base.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, nil
+	}
+	r, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return 0, err
+	}
+	return r.Int64(), nil
+}
+
+// valueKey returns the key that the materialized value of the CRDT should be written to.
+//
+// This will be the deleted key if the document has been deleted.
+func (b baseCRDT) valueKey(ctx context.Context) (core.DataStoreKey, error) {
+	key := b.key.WithValueFlag()
+	marker, err := b.store.Get(ctx, b.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return core.DataStoreKey{}, err
+	}
+	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
+		key = key.WithDeletedFlag()
+	}
+	return key, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// BoundedCounterDelta is a single delta operation for a BoundedCounter
+type BoundedCounterDelta struct {
+	DocID     []byte
+	FieldName string
+	Priority  uint64
+	// Nonce is an added randomly generated number that ensures
+	// that each increment operation is unique.
+	Nonce int64
+	// SchemaVersionID is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at the time of commit.
+	SchemaVersionID string
+	// Replica is the ID of the replica that made the operation, the increments of
+	// a replica are the allowance it holds for its own decrements.
+	Replica string
+	Data    []byte
+}
+
+var _ core.Delta = (*BoundedCounterDelta)(nil)
+
+// IPLDSchemaBytes returns the IPLD schema representation for the type.
+//
+// This needs to match the [BoundedCounterDelta] struct or [coreblock.mustSetSchema] will panic on init.
+func (delta *BoundedCounterDelta) IPLDSchemaBytes() []byte {
+	return []byte(`
+	type BoundedCounterDelta struct {
+		docID     		Bytes
+		fieldName 		String
+		priority  		Int
+		nonce 			Int
+		schemaVersionID String
+		replica         String
+		data            Bytes
+	}`)
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *BoundedCounterDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *BoundedCounterDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// boundedCounterState is the internal state of a BoundedCounter.
+type boundedCounterState struct {
+	// Increments holds the sum of the increments made by each replica.
+	Increments map[string]int64
+	// Decrements holds the sum of the decrements made by each replica.
+	Decrements map[string]int64
+}
+
+// allowance returns the amount the given replica can still decrement by.
+func (s boundedCounterState) allowance(replica string) int64 {
+	return s.Increments[replica] - s.Decrements[replica]
+}
+
+// value returns the counter value, the sum of all increments minus the sum of all decrements.
+func (s boundedCounterState) value() int64 {
+	var value int64
+	for _, inc := range s.Increments {
+		value += inc
+	}
+	for _, dec := range s.Decrements {
+		value -= dec
+	}
+	return value
+}
+
+// BoundedCounter is an escrow based counter CRDT for Int data types whose value can never
+// go below zero, regardless of the concurrent operations made on other replicas.
+//
+// Each increment grants its replica the right to decrement by the same amount, and
+// a replica can only decrement by the rights it holds. As the rights of a replica
+// are only ever consumed by that replica, the sum of all rights, the counter value,
+// can not become negative. Rights are moved between replicas by decrementing on one
+// replica and incrementing on the other.
+type BoundedCounter struct {
+	baseCRDT
+}
+
+var _ core.ReplicatedData = (*BoundedCounter)(nil)
+
+// NewBoundedCounter returns a new instance of the BoundedCounter with the given ID.
+func NewBoundedCounter(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) BoundedCounter {
+	return BoundedCounter{newBaseCRDT(store, key, schemaVersionKey, fieldName)}
+}
+
+// Value gets the current counter value
+func (c BoundedCounter) Value(ctx context.Context) ([]byte, error) {
+	valueK := c.key.WithValueFlag()
+	buf, err := c.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Allowance returns the amount the replica of the given context can still decrement the counter by.
+func (c BoundedCounter) Allowance(ctx context.Context) (int64, error) {
+	var state boundedCounterState
+	err := c.getState(ctx, &state)
+	if err != nil {
+		return 0, err
+	}
+	return state.allowance(GetContextReplicaID(ctx)), nil
+}
+
+// Increment generates a new delta incrementing the counter by the given CBOR encoded
+// integer value, on behalf of the replica of the given context.
+//
+// An error is returned if the value is a decrement greater than the allowance of the replica.
+func (c BoundedCounter) Increment(ctx context.Context, value []byte) (*BoundedCounterDelta, error) {
+	amount, err := getNumericFromBytes[int64](value)
+	if err != nil {
+		return nil, err
+	}
+
+	replica := GetContextReplicaID(ctx)
+	if amount < 0 {
+		var state boundedCounterState
+		err = c.getState(ctx, &state)
+		if err != nil {
+			return nil, err
+		}
+		allowance := state.allowance(replica)
+		if -amount > allowance {
+			return nil, NewErrInsufficientAllowance(c.fieldName, -amount, allowance)
+		}
+	}
+
+	// To ensure that the dag block is unique, we add a random number to the delta.
+	nonce, err := c.newNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoundedCounterDelta{
+		DocID:           []byte(c.key.DocID),
+		FieldName:       c.fieldName,
+		Data:            value,
+		SchemaVersionID: c.schemaVersionKey.SchemaVersionID,
+		Replica:         replica,
+		Nonce:           nonce,
+	}, nil
+}
+
+// Merge implements ReplicatedData interface.
+//
+// The operation is credited to the replica that made it. Remote decrements are not
+// checked against the allowance, as they were checked by the replica that made them.
+func (c BoundedCounter) Merge(ctx context.Context, delta core.Delta) error {
+	d, ok := delta.(*BoundedCounterDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	amount, err := getNumericFromBytes[int64](d.Data)
+	if err != nil {
+		return err
+	}
+
+	var state boundedCounterState
+	err = c.getState(ctx, &state)
+	if err != nil {
+		return err
+	}
+	if amount >= 0 {
+		if state.Increments == nil {
+			state.Increments = make(map[string]int64)
+		}
+		state.Increments[d.Replica] += amount
+	} else {
+		if state.Decrements == nil {
+			state.Decrements = make(map[string]int64)
+		}
+		state.Decrements[d.Replica] -= amount
+	}
+
+	value, err := cbor.Marshal(state.value())
+	if err != nil {
+		return err
+	}
+	return c.setState(ctx, state, value, d.Priority)
+}
+
+func (c BoundedCounter) CType() client.CType {
+	return client.BOUNDED_COUNTER
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+func setupBoundedCounter() BoundedCounter {
+	store := newMockStore()
+	key := core.DataStoreKey{DocID: "AAAA-BBBB"}
+	return NewBoundedCounter(store, core.CollectionSchemaVersionKey{}, key, "stock")
+}
+
+func newBoundedCounterDelta(
+	t *testing.T,
+	ctx context.Context,
+	c BoundedCounter,
+	priority uint64,
+	value int64,
+) *BoundedCounterDelta {
+	b, err := cbor.Marshal(value)
+	require.NoError(t, err)
+	delta, err := c.Increment(ctx, b)
+	require.NoError(t, err)
+	delta.SetPriority(priority)
+	return delta
+}
+
+func getBoundedCounterValue(t *testing.T, ctx context.Context, c BoundedCounter) int64 {
+	b, err := c.Value(ctx)
+	require.NoError(t, err)
+	var value int64
+	err = cbor.Unmarshal(b, &value)
+	require.NoError(t, err)
+	return value
+}
+
+func TestBoundedCounterIncrement_WithDecrementWithinAllowance_ShouldDecrement(t *testing.T) {
+	ctx := SetContextReplicaID(context.Background(), "a")
+	c := setupBoundedCounter()
+
+	err := c.Merge(ctx, newBoundedCounterDelta(t, ctx, c, 1, 10))
+	require.NoError(t, err)
+	err = c.Merge(ctx, newBoundedCounterDelta(t, ctx, c, 2, -4))
+	require.NoError(t, err)
+
+	require.Equal(t, int64(6), getBoundedCounterValue(t, ctx, c))
+	allowance, err := c.Allowance(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), allowance)
+}
+
+func TestBoundedCounterIncrement_WithDecrementBeyondAllowance_ShouldError(t *testing.T) {
+	ctx := SetContextReplicaID(context.Background(), "a")
+	c := setupBoundedCounter()
+
+	err := c.Merge(ctx, newBoundedCounterDelta(t, ctx, c, 1, 10))
+	require.NoError(t, err)
+
+	b, err := cbor.Marshal(int64(-11))
+	require.NoError(t, err)
+	_, err = c.Increment(ctx, b)
+	require.ErrorIs(t, err, ErrInsufficientAllowance)
+}
+
+func TestBoundedCounterIncrement_WithRemoteIncrement_ShouldNotGrantAllowance(t *testing.T) {
+	ctxA := SetContextReplicaID(context.Background(), "a")
+	ctxB := SetContextReplicaID(context.Background(), "b")
+	c := setupBoundedCounter()
+
+	err := c.Merge(ctxA, newBoundedCounterDelta(t, ctxA, c, 1, 10))
+	require.NoError(t, err)
+
+	allowance, err := c.Allowance(ctxB)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), allowance)
+
+	b, err := cbor.Marshal(int64(-1))
+	require.NoError(t, err)
+	_, err = c.Increment(ctxB, b)
+	require.ErrorIs(t, err, ErrInsufficientAllowance)
+}
+
+func TestBoundedCounterMerge_WithConcurrentDecrements_ShouldNotGoBelowZero(t *testing.T) {
+	ctxA := SetContextReplicaID(context.Background(), "a")
+	ctxB := SetContextReplicaID(context.Background(), "b")
+	c := setupBoundedCounter()
+
+	err := c.Merge(ctxA, newBoundedCounterDelta(t, ctxA, c, 1, 5))
+	require.NoError(t, err)
+	err = c.Merge(ctxB, newBoundedCounterDelta(t, ctxB, c, 2, 3))
+	require.NoError(t, err)
+
+	// both replicas consume their whole allowance concurrently
+	decA := newBoundedCounterDelta(t, ctxA, c, 3, -5)
+	decB := newBoundedCounterDelta(t, ctxB, c, 3, -3)
+	err = c.Merge(ctxA, decA)
+	require.NoError(t, err)
+	err = c.Merge(ctxB, decB)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(0), getBoundedCounterValue(t, ctxA, c))
+	for _, ctx := range []context.Context{ctxA, ctxB} {
+		allowance, err := c.Allowance(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), allowance)
+	}
+}
+
+func TestBoundedCounterMerge_WithMismatchedDelta_ShouldError(t *testing.T) {
+	ctx := context.Background()
+	c := setupBoundedCounter()
+
+	err := c.Merge(ctx, &CounterDelta{})
+	require.ErrorIs(t, err, ErrMismatchedMergeType)
+}
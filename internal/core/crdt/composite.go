@@ -34,7 +34,7 @@ type CompositeDAGDelta struct {
 	// It can be used to identify the collection datastructure state at the time of commit.
 	SchemaVersionID string
 	// Status represents the status of the document. By default it is `Active`.
-	// Alternatively, if can be set to `Deleted`.
+	// Alternatively, if can be set to `Deleted` or `Purged`.
 	Status client.DocumentStatus
 }
 
@@ -101,6 +101,11 @@ func (c CompositeDAG) Set(status client.DocumentStatus) *CompositeDAGDelta {
 func (c CompositeDAG) Merge(ctx context.Context, delta core.Delta) error {
 	dagDelta, isDagDelta := delta.(*CompositeDAGDelta)
 
+	if isDagDelta && dagDelta.Status.IsPurged() {
+		// The state of a purged document has already been removed, only the marker remains.
+		return c.store.Put(ctx, c.key.ToPrimaryDataStoreKey().ToDS(), []byte{base.PurgedObjectMarker})
+	}
+
 	if isDagDelta && dagDelta.Status.IsDeleted() {
 		err := c.store.Put(ctx, c.key.ToPrimaryDataStoreKey().ToDS(), []byte{base.DeletedObjectMarker})
 		if err != nil {
@@ -0,0 +1,31 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import "context"
+
+// replicaIDContextKey is the key type for the replica ID context value.
+type replicaIDContextKey struct{}
+
+// GetContextReplicaID returns the ID of the local replica from the given context.
+//
+// An empty string is returned if the context has no replica ID.
+func GetContextReplicaID(ctx context.Context) string {
+	id, _ := ctx.Value(replicaIDContextKey{}).(string)
+	return id
+}
+
+// SetContextReplicaID returns a new context with the ID of the local replica set.
+//
+// The replica ID is used by CRDTs that track the operations made by each replica.
+func SetContextReplicaID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, replicaIDContextKey{}, id)
+}
@@ -11,11 +11,7 @@
 package crdt
 
 import (
-	"bytes"
 	"context"
-	"crypto/rand"
-	"math"
-	"math/big"
 
 	"github.com/fxamacker/cbor/v2"
 	ds "github.com/ipfs/go-datastore"
@@ -25,7 +21,6 @@ import (
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/core"
-	"github.com/sourcenetwork/defradb/internal/db/base"
 )
 
 type Incrementable interface {
@@ -113,20 +108,10 @@ func (c Counter) Value(ctx context.Context) ([]byte, error) {
 // causing it to overflow the float64 max value will act like a no-op.
 func (c Counter) Increment(ctx context.Context, value []byte) (*CounterDelta, error) {
 	// To ensure that the dag block is unique, we add a random number to the delta.
-	// This is done only on update (if the doc doesn't already exist) to ensure that the
-	// initial dag block of a document can be reproducible.
-	exists, err := c.store.Has(ctx, c.key.ToPrimaryDataStoreKey().ToDS())
+	nonce, err := c.newNonce(ctx)
 	if err != nil {
 		return nil, err
 	}
-	var nonce int64
-	if exists {
-		r, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
-		if err != nil {
-			return nil, err
-		}
-		nonce = r.Int64()
-	}
 
 	return &CounterDelta{
 		DocID:           []byte(c.key.DocID),
@@ -153,14 +138,10 @@ func (c Counter) incrementValue(
 	valueAsBytes []byte,
 	priority uint64,
 ) error {
-	key := c.key.WithValueFlag()
-	marker, err := c.store.Get(ctx, c.key.ToPrimaryDataStoreKey().ToDS())
-	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+	key, err := c.valueKey(ctx)
+	if err != nil {
 		return err
 	}
-	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
-		key = key.WithDeletedFlag()
-	}
 
 	var resultAsBytes []byte
 
@@ -20,6 +20,9 @@ const (
 	errFailedToStoreValue     string = "failed to store value"
 	errNegativeValue          string = "value cannot be negative"
 	errUnsupportedCounterType string = "unsupported counter type. Valid types are int64 and float64"
+	errInvalidArrayValue      string = "invalid array value"
+	errListElementNotFound    string = "list element not found"
+	errInsufficientAllowance  string = "decrement exceeds the allowance of the local replica"
 )
 
 // Errors returnable from this package.
@@ -35,6 +38,9 @@ var (
 	// ErrMismatchedMergeType - Tying to merge two ReplicatedData of different types
 	ErrMismatchedMergeType    = errors.New("given type to merge does not match source")
 	ErrUnsupportedCounterType = errors.New(errUnsupportedCounterType)
+	ErrInvalidArrayValue      = errors.New(errInvalidArrayValue)
+	ErrListElementNotFound    = errors.New(errListElementNotFound)
+	ErrInsufficientAllowance  = errors.New(errInsufficientAllowance)
 )
 
 // NewErrFailedToGetPriority returns an error indicating that the priority could not be retrieved.
@@ -54,3 +60,31 @@ func NewErrNegativeValue[T Incrementable](value T) error {
 func NewErrUnsupportedCounterType(valueType client.ScalarKind) error {
 	return errors.New(errUnsupportedCounterType, errors.NewKV("Type", valueType))
 }
+
+// NewErrInvalidArrayValue returns an error indicating that the value given to an array CRDT
+// could not be decoded as an array.
+func NewErrInvalidArrayValue(inner error) error {
+	return errors.Wrap(errInvalidArrayValue, inner)
+}
+
+// NewErrListElementNotFound returns an error indicating that a list delta referenced an
+// element that does not exist in the list.
+func NewErrListElementNotFound(priority uint64, nonce int64, index int) error {
+	return errors.New(
+		errListElementNotFound,
+		errors.NewKV("Priority", priority),
+		errors.NewKV("Nonce", nonce),
+		errors.NewKV("Index", index),
+	)
+}
+
+// NewErrInsufficientAllowance returns an error indicating that a bounded counter can not be
+// decremented by the given amount, as it exceeds the allowance held by the local replica.
+func NewErrInsufficientAllowance(fieldName string, amount int64, allowance int64) error {
+	return errors.New(
+		errInsufficientAllowance,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Decrement", amount),
+		errors.NewKV("Allowance", allowance),
+	)
+}
@@ -14,9 +14,12 @@ import "github.com/sourcenetwork/defradb/internal/core"
 
 // CRDT is a union type used for IPLD schemas that can hold any of the CRDT deltas.
 type CRDT struct {
-	LWWRegDelta       *LWWRegDelta
-	CompositeDAGDelta *CompositeDAGDelta
-	CounterDelta      *CounterDelta
+	LWWRegDelta         *LWWRegDelta
+	CompositeDAGDelta   *CompositeDAGDelta
+	CounterDelta        *CounterDelta
+	SetDelta            *SetDelta
+	ListDelta           *ListDelta
+	BoundedCounterDelta *BoundedCounterDelta
 }
 
 // NewCRDT returns a new CRDT.
@@ -28,6 +31,12 @@ func NewCRDT(delta core.Delta) CRDT {
 		return CRDT{CompositeDAGDelta: d}
 	case *CounterDelta:
 		return CRDT{CounterDelta: d}
+	case *SetDelta:
+		return CRDT{SetDelta: d}
+	case *ListDelta:
+		return CRDT{ListDelta: d}
+	case *BoundedCounterDelta:
+		return CRDT{BoundedCounterDelta: d}
 	}
 	return CRDT{}
 }
@@ -41,6 +50,9 @@ func (c CRDT) IPLDSchemaBytes() []byte {
 		| LWWRegDelta "lww"
 		| CompositeDAGDelta "composite"
 		| CounterDelta "counter"
+		| SetDelta "orset"
+		| ListDelta "list"
+		| BoundedCounterDelta "bcounter"
 	} representation keyed`)
 }
 
@@ -53,6 +65,12 @@ func (c CRDT) GetDelta() core.Delta {
 		return c.CompositeDAGDelta
 	case c.CounterDelta != nil:
 		return c.CounterDelta
+	case c.SetDelta != nil:
+		return c.SetDelta
+	case c.ListDelta != nil:
+		return c.ListDelta
+	case c.BoundedCounterDelta != nil:
+		return c.BoundedCounterDelta
 	}
 	return nil
 }
@@ -66,6 +84,12 @@ func (c CRDT) GetPriority() uint64 {
 		return c.CompositeDAGDelta.GetPriority()
 	case c.CounterDelta != nil:
 		return c.CounterDelta.GetPriority()
+	case c.SetDelta != nil:
+		return c.SetDelta.GetPriority()
+	case c.ListDelta != nil:
+		return c.ListDelta.GetPriority()
+	case c.BoundedCounterDelta != nil:
+		return c.BoundedCounterDelta.GetPriority()
 	}
 	return 0
 }
@@ -79,6 +103,12 @@ func (c CRDT) GetFieldName() string {
 		return c.CompositeDAGDelta.FieldName
 	case c.CounterDelta != nil:
 		return c.CounterDelta.FieldName
+	case c.SetDelta != nil:
+		return c.SetDelta.FieldName
+	case c.ListDelta != nil:
+		return c.ListDelta.FieldName
+	case c.BoundedCounterDelta != nil:
+		return c.BoundedCounterDelta.FieldName
 	}
 	return ""
 }
@@ -92,6 +122,12 @@ func (c CRDT) GetDocID() []byte {
 		return c.CompositeDAGDelta.DocID
 	case c.CounterDelta != nil:
 		return c.CounterDelta.DocID
+	case c.SetDelta != nil:
+		return c.SetDelta.DocID
+	case c.ListDelta != nil:
+		return c.ListDelta.DocID
+	case c.BoundedCounterDelta != nil:
+		return c.BoundedCounterDelta.DocID
 	}
 	return nil
 }
@@ -105,6 +141,12 @@ func (c CRDT) GetSchemaVersionID() string {
 		return c.CompositeDAGDelta.SchemaVersionID
 	case c.CounterDelta != nil:
 		return c.CounterDelta.SchemaVersionID
+	case c.SetDelta != nil:
+		return c.SetDelta.SchemaVersionID
+	case c.ListDelta != nil:
+		return c.ListDelta.SchemaVersionID
+	case c.BoundedCounterDelta != nil:
+		return c.BoundedCounterDelta.SchemaVersionID
 	}
 	return ""
 }
@@ -138,6 +180,34 @@ func (c CRDT) Clone() CRDT {
 			Nonce:           c.CounterDelta.Nonce,
 			Data:            c.CounterDelta.Data,
 		}
+	case c.SetDelta != nil:
+		cloned.SetDelta = &SetDelta{
+			DocID:           c.SetDelta.DocID,
+			FieldName:       c.SetDelta.FieldName,
+			Priority:        c.SetDelta.Priority,
+			SchemaVersionID: c.SetDelta.SchemaVersionID,
+			Nonce:           c.SetDelta.Nonce,
+			Data:            c.SetDelta.Data,
+		}
+	case c.ListDelta != nil:
+		cloned.ListDelta = &ListDelta{
+			DocID:           c.ListDelta.DocID,
+			FieldName:       c.ListDelta.FieldName,
+			Priority:        c.ListDelta.Priority,
+			SchemaVersionID: c.ListDelta.SchemaVersionID,
+			Nonce:           c.ListDelta.Nonce,
+			Data:            c.ListDelta.Data,
+		}
+	case c.BoundedCounterDelta != nil:
+		cloned.BoundedCounterDelta = &BoundedCounterDelta{
+			DocID:           c.BoundedCounterDelta.DocID,
+			FieldName:       c.BoundedCounterDelta.FieldName,
+			Priority:        c.BoundedCounterDelta.Priority,
+			SchemaVersionID: c.BoundedCounterDelta.SchemaVersionID,
+			Nonce:           c.BoundedCounterDelta.Nonce,
+			Replica:         c.BoundedCounterDelta.Replica,
+			Data:            c.BoundedCounterDelta.Data,
+		}
 	}
 	return cloned
 }
@@ -158,6 +228,12 @@ func (c CRDT) GetData() []byte {
 		return c.LWWRegDelta.Data
 	} else if c.CounterDelta != nil {
 		return c.CounterDelta.Data
+	} else if c.SetDelta != nil {
+		return c.SetDelta.Data
+	} else if c.ListDelta != nil {
+		return c.ListDelta.Data
+	} else if c.BoundedCounterDelta != nil {
+		return c.BoundedCounterDelta.Data
 	}
 	return nil
 }
@@ -168,6 +244,12 @@ func (c CRDT) SetData(data []byte) {
 		c.LWWRegDelta.Data = data
 	} else if c.CounterDelta != nil {
 		c.CounterDelta.Data = data
+	} else if c.SetDelta != nil {
+		c.SetDelta.Data = data
+	} else if c.ListDelta != nil {
+		c.ListDelta.Data = data
+	} else if c.BoundedCounterDelta != nil {
+		c.BoundedCounterDelta.Data = data
 	}
 }
 
@@ -0,0 +1,367 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"slices"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// ListDelta is a single delta operation for a List
+type ListDelta struct {
+	DocID     []byte
+	FieldName string
+	Priority  uint64
+	// Nonce is an added randomly generated number that ensures
+	// that the elements inserted by each operation are unique.
+	Nonce int64
+	// SchemaVersionID is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at the time of commit.
+	SchemaVersionID string
+	// Data is the CBOR encoded list operation, holding the inserted values
+	// and the identifiers of the removed elements.
+	Data []byte
+}
+
+var _ core.Delta = (*ListDelta)(nil)
+
+// IPLDSchemaBytes returns the IPLD schema representation for the type.
+//
+// This needs to match the [ListDelta] struct or [coreblock.mustSetSchema] will panic on init.
+func (delta *ListDelta) IPLDSchemaBytes() []byte {
+	return []byte(`
+	type ListDelta struct {
+		docID     		Bytes
+		fieldName 		String
+		priority  		Int
+		nonce 			Int
+		schemaVersionID String
+		data            Bytes
+	}`)
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *ListDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *ListDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// listInsert is a run of consecutive values inserted into a List.
+type listInsert struct {
+	// After is the id of the element the values are inserted after.
+	//
+	// If nil the values are inserted at the head of the list.
+	After  *elementID
+	Values []cbor.RawMessage
+}
+
+// listOperation is the operation held in the data of a [ListDelta].
+type listOperation struct {
+	Insert []listInsert
+	Remove []elementID
+}
+
+// listElement is a single value inserted into a List.
+type listElement struct {
+	ID      elementID
+	Value   cbor.RawMessage
+	Removed bool
+}
+
+// listState is the internal state of a List.
+type listState struct {
+	// Elements holds all the elements ever inserted into the list in list order.
+	//
+	// Removed elements are kept so that concurrent inserts can still reference them.
+	Elements []listElement
+}
+
+// visible returns the elements that have not been removed.
+func (s listState) visible() []listElement {
+	elements := make([]listElement, 0, len(s.Elements))
+	for _, element := range s.Elements {
+		if !element.Removed {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
+// values returns the values of the elements that have not been removed.
+func (s listState) values() []cbor.RawMessage {
+	visible := s.visible()
+	values := make([]cbor.RawMessage, len(visible))
+	for i, element := range visible {
+		values[i] = element.Value
+	}
+	return values
+}
+
+func (s listState) indexOf(id elementID) int {
+	return slices.IndexFunc(s.Elements, func(e listElement) bool {
+		return e.ID == id
+	})
+}
+
+// insert adds the value at or after the given position using the RGA (Replicated Growable
+// Array) ordering rules: elements inserted after the same element are ordered by descending id.
+//
+// It returns the position of the inserted value.
+func (s *listState) insert(pos int, id elementID, value cbor.RawMessage) int {
+	for pos < len(s.Elements) && s.Elements[pos].ID.compare(id) > 0 {
+		pos++
+	}
+	s.Elements = slices.Insert(s.Elements, pos, listElement{ID: id, Value: value})
+	return pos
+}
+
+// List is an ordered sequence CRDT type that allows concurrent insertions
+// and removals of values in an array field while ensuring convergence.
+//
+// Values inserted concurrently at the same position are ordered deterministically,
+// and the relative order of existing values is always preserved.
+type List struct {
+	baseCRDT
+}
+
+var _ core.ReplicatedData = (*List)(nil)
+
+// NewList returns a new instance of the List with the given ID.
+func NewList(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) List {
+	return List{newBaseCRDT(store, key, schemaVersionKey, fieldName)}
+}
+
+// Value gets the current list value.
+func (l List) Value(ctx context.Context) ([]byte, error) {
+	valueK := l.key.WithValueFlag()
+	buf, err := l.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Set generates a new delta that transforms the current list into the given CBOR encoded array.
+//
+// The delta only holds the difference between the current list and the given array, so
+// values kept in both retain their identity when merged with concurrent changes.
+func (l List) Set(ctx context.Context, value []byte) (*ListDelta, error) {
+	values, err := decodeArray(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var state listState
+	err = l.getState(ctx, &state)
+	if err != nil {
+		return nil, err
+	}
+	current := state.visible()
+	kept := longestCommonSubsequence(current, values)
+
+	op := listOperation{}
+	var after *elementID
+	var run []cbor.RawMessage
+	flush := func() {
+		if len(run) > 0 {
+			op.Insert = append(op.Insert, listInsert{After: after, Values: run})
+			run = nil
+		}
+	}
+
+	c, k := 0, 0
+	for i, v := range values {
+		if k < len(kept) && kept[k][1] == i {
+			for ; c < kept[k][0]; c++ {
+				op.Remove = append(op.Remove, current[c].ID)
+			}
+			flush()
+			id := current[c].ID
+			after = &id
+			c++
+			k++
+			continue
+		}
+		run = append(run, v)
+	}
+	flush()
+	for ; c < len(current); c++ {
+		op.Remove = append(op.Remove, current[c].ID)
+	}
+
+	data, err := cbor.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// To ensure that the inserted elements are unique, we add a random number to the delta.
+	nonce, err := l.newNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListDelta{
+		DocID:           []byte(l.key.DocID),
+		FieldName:       l.fieldName,
+		Data:            data,
+		SchemaVersionID: l.schemaVersionKey.SchemaVersionID,
+		Nonce:           nonce,
+	}, nil
+}
+
+// Merge implements ReplicatedData interface.
+//
+// Insertions and removals are idempotent and concurrent insertions at the same
+// position are ordered by element id, so the resulting list is the same regardless
+// of the order the deltas are merged in.
+func (l List) Merge(ctx context.Context, delta core.Delta) error {
+	d, ok := delta.(*ListDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	var op listOperation
+	err := cbor.Unmarshal(d.Data, &op)
+	if err != nil {
+		return err
+	}
+
+	var state listState
+	err = l.getState(ctx, &state)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[elementID]struct{}, len(state.Elements))
+	for _, element := range state.Elements {
+		existing[element.ID] = struct{}{}
+	}
+
+	index := 0
+	for _, insert := range op.Insert {
+		pos := 0
+		if insert.After != nil {
+			i := state.indexOf(*insert.After)
+			if i < 0 {
+				return NewErrListElementNotFound(insert.After.Priority, insert.After.Nonce, insert.After.Index)
+			}
+			pos = i + 1
+		}
+		for _, value := range insert.Values {
+			id := elementID{Priority: d.Priority, Nonce: d.Nonce, Index: index}
+			index++
+			if _, ok := existing[id]; ok {
+				// The delta has already been merged, continue after the existing element.
+				pos = state.indexOf(id) + 1
+				continue
+			}
+			existing[id] = struct{}{}
+			pos = state.insert(pos, id, value) + 1
+		}
+	}
+
+	removed := make(map[elementID]struct{}, len(op.Remove))
+	for _, id := range op.Remove {
+		removed[id] = struct{}{}
+	}
+	if len(removed) > 0 {
+		for i := range state.Elements {
+			if _, ok := removed[state.Elements[i].ID]; ok {
+				state.Elements[i].Removed = true
+			}
+		}
+	}
+
+	value, err := encodeArray(state.values())
+	if err != nil {
+		return err
+	}
+	return l.setState(ctx, state, value, d.Priority)
+}
+
+func (l List) CType() client.CType {
+	return client.LIST
+}
+
+// longestCommonSubsequence returns the index pairs of the elements that are kept
+// between the current elements and the new values, in ascending order.
+//
+// The common prefix and suffix are matched directly, so that appending to or removing
+// values from a large list does not require building the full comparison table.
+func longestCommonSubsequence(current []listElement, values []cbor.RawMessage) [][2]int {
+	var pairs [][2]int
+
+	prefix := 0
+	for prefix < len(current) && prefix < len(values) &&
+		string(current[prefix].Value) == string(values[prefix]) {
+		pairs = append(pairs, [2]int{prefix, prefix})
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(current)-prefix && suffix < len(values)-prefix &&
+		string(current[len(current)-1-suffix].Value) == string(values[len(values)-1-suffix]) {
+		suffix++
+	}
+
+	middleCurrent := current[prefix : len(current)-suffix]
+	middleValues := values[prefix : len(values)-suffix]
+	if len(middleCurrent) > 0 && len(middleValues) > 0 {
+		lengths := make([][]int, len(middleCurrent)+1)
+		for i := range lengths {
+			lengths[i] = make([]int, len(middleValues)+1)
+		}
+		for i := len(middleCurrent) - 1; i >= 0; i-- {
+			for j := len(middleValues) - 1; j >= 0; j-- {
+				if string(middleCurrent[i].Value) == string(middleValues[j]) {
+					lengths[i][j] = lengths[i+1][j+1] + 1
+				} else {
+					lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(middleCurrent) && j < len(middleValues) {
+			switch {
+			case string(middleCurrent[i].Value) == string(middleValues[j]):
+				pairs = append(pairs, [2]int{prefix + i, prefix + j})
+				i++
+				j++
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				i++
+			default:
+				j++
+			}
+		}
+	}
+
+	for k := suffix; k > 0; k-- {
+		pairs = append(pairs, [2]int{len(current) - k, len(values) - k})
+	}
+	return pairs
+}
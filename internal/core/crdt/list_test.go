@@ -0,0 +1,166 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+func setupList() List {
+	store := newMockStore()
+	key := core.DataStoreKey{DocID: "AAAA-BBBB"}
+	return NewList(store, core.CollectionSchemaVersionKey{}, key, "")
+}
+
+func newListDelta(t *testing.T, ctx context.Context, l List, priority uint64, nonce int64, values ...string) *ListDelta {
+	delta, err := l.Set(ctx, encodeStrings(t, values...))
+	require.NoError(t, err)
+	delta.SetPriority(priority)
+	delta.Nonce = nonce
+	return delta
+}
+
+func TestListMerge_WithInitialValues_ShouldKeepOrderAndDuplicates(t *testing.T) {
+	ctx := context.Background()
+	l := setupList()
+
+	err := l.Merge(ctx, newListDelta(t, ctx, l, 1, 0, "b", "a", "b"))
+	require.NoError(t, err)
+
+	val, err := l.Value(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "a", "b"}, decodeStrings(t, val))
+}
+
+func TestListMerge_WithReorder_ShouldSetValue(t *testing.T) {
+	ctx := context.Background()
+	l := setupList()
+
+	err := l.Merge(ctx, newListDelta(t, ctx, l, 1, 0, "a", "b", "c"))
+	require.NoError(t, err)
+	err = l.Merge(ctx, newListDelta(t, ctx, l, 2, 1, "c", "x", "a"))
+	require.NoError(t, err)
+
+	val, err := l.Value(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "x", "a"}, decodeStrings(t, val))
+}
+
+func TestListMerge_WithConcurrentChangesInDifferentOrder_ShouldConverge(t *testing.T) {
+	ctx := context.Background()
+	l1 := setupList()
+	l2 := setupList()
+
+	initial := newListDelta(t, ctx, l1, 1, 0, "a", "b", "c")
+	require.NoError(t, l1.Merge(ctx, initial))
+	require.NoError(t, l2.Merge(ctx, initial))
+
+	delta1 := newListDelta(t, ctx, l1, 2, 1, "a", "x", "b", "c", "z")
+	delta2 := newListDelta(t, ctx, l2, 2, 2, "a", "y", "b", "w")
+
+	require.NoError(t, l1.Merge(ctx, delta1))
+	require.NoError(t, l1.Merge(ctx, delta2))
+
+	require.NoError(t, l2.Merge(ctx, delta2))
+	require.NoError(t, l2.Merge(ctx, delta1))
+	// merging the same delta again must not change the value
+	require.NoError(t, l2.Merge(ctx, delta2))
+
+	val1, err := l1.Value(ctx)
+	require.NoError(t, err)
+	val2, err := l2.Value(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"a", "y", "x", "b", "w", "z"}, decodeStrings(t, val1))
+	require.Equal(t, val1, val2)
+}
+
+func TestListMerge_WithFollowUpInsertAfterConcurrentInsert_ShouldKeepRelativeOrder(t *testing.T) {
+	ctx := context.Background()
+	l1 := setupList()
+	l2 := setupList()
+
+	initial := newListDelta(t, ctx, l1, 1, 0, "a")
+	require.NoError(t, l1.Merge(ctx, initial))
+	require.NoError(t, l2.Merge(ctx, initial))
+
+	delta1 := newListDelta(t, ctx, l1, 2, 2, "a", "x")
+	require.NoError(t, l1.Merge(ctx, delta1))
+	delta2 := newListDelta(t, ctx, l1, 3, 1, "a", "x", "x2")
+	require.NoError(t, l1.Merge(ctx, delta2))
+
+	delta3 := newListDelta(t, ctx, l2, 2, 3, "a", "y")
+	require.NoError(t, l2.Merge(ctx, delta3))
+
+	require.NoError(t, l1.Merge(ctx, delta3))
+	require.NoError(t, l2.Merge(ctx, delta1))
+	require.NoError(t, l2.Merge(ctx, delta2))
+
+	val1, err := l1.Value(ctx)
+	require.NoError(t, err)
+	val2, err := l2.Value(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"a", "y", "x", "x2"}, decodeStrings(t, val1))
+	require.Equal(t, val1, val2)
+}
+
+func TestListSet_WithLargeListAndAppendOrRemove_ShouldOnlyHoldChanges(t *testing.T) {
+	ctx := context.Background()
+	l := setupList()
+
+	values := make([]string, 100_000)
+	for i := range values {
+		values[i] = fmt.Sprint(i)
+	}
+	require.NoError(t, l.Merge(ctx, newListDelta(t, ctx, l, 1, 0, values...)))
+
+	appended := append(slices.Clone(values), "x")
+	delta, err := l.Set(ctx, encodeStrings(t, appended...))
+	require.NoError(t, err)
+
+	var op listOperation
+	require.NoError(t, cbor.Unmarshal(delta.Data, &op))
+	require.Len(t, op.Insert, 1)
+	require.Equal(t, []cbor.RawMessage{cbor.RawMessage(encodeString(t, "x"))}, op.Insert[0].Values)
+	require.Empty(t, op.Remove)
+
+	removed := slices.Delete(slices.Clone(values), 500, 501)
+	delta, err = l.Set(ctx, encodeStrings(t, removed...))
+	require.NoError(t, err)
+
+	op = listOperation{}
+	require.NoError(t, cbor.Unmarshal(delta.Data, &op))
+	require.Empty(t, op.Insert)
+	require.Equal(t, []elementID{{Priority: 1, Index: 500}}, op.Remove)
+
+	delta.SetPriority(2)
+	delta.Nonce = 1
+	require.NoError(t, l.Merge(ctx, delta))
+
+	val, err := l.Value(ctx)
+	require.NoError(t, err)
+	require.Equal(t, removed, decodeStrings(t, val))
+}
+
+func encodeString(t *testing.T, value string) []byte {
+	b, err := cbor.Marshal(value)
+	require.NoError(t, err)
+	return b
+}
@@ -14,12 +14,8 @@ import (
 	"bytes"
 	"context"
 
-	ds "github.com/ipfs/go-datastore"
-
 	"github.com/sourcenetwork/defradb/datastore"
-	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/core"
-	"github.com/sourcenetwork/defradb/internal/db/base"
 )
 
 // LWWRegDelta is a single delta operation for an LWWRegister
@@ -123,14 +119,10 @@ func (reg LWWRegister) setValue(ctx context.Context, val []byte, priority uint64
 	// if the current priority is higher ignore put
 	// else if the current value is lexicographically
 	// greater than the new then ignore
-	key := reg.key.WithValueFlag()
-	marker, err := reg.store.Get(ctx, reg.key.ToPrimaryDataStoreKey().ToDS())
-	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+	key, err := reg.valueKey(ctx)
+	if err != nil {
 		return err
 	}
-	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
-		key = key.WithDeletedFlag()
-	}
 	if priority < curPrio {
 		return nil
 	} else if priority == curPrio {
@@ -0,0 +1,253 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"slices"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// SetDelta is a single delta operation for an ORSet
+type SetDelta struct {
+	DocID     []byte
+	FieldName string
+	Priority  uint64
+	// Nonce is an added randomly generated number that ensures
+	// that the elements added by each operation are unique.
+	Nonce int64
+	// SchemaVersionID is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at the time of commit.
+	SchemaVersionID string
+	// Data is the CBOR encoded set operation, holding the added values
+	// and the identifiers of the removed elements.
+	Data []byte
+}
+
+var _ core.Delta = (*SetDelta)(nil)
+
+// IPLDSchemaBytes returns the IPLD schema representation for the type.
+//
+// This needs to match the [SetDelta] struct or [coreblock.mustSetSchema] will panic on init.
+func (delta *SetDelta) IPLDSchemaBytes() []byte {
+	return []byte(`
+	type SetDelta struct {
+		docID     		Bytes
+		fieldName 		String
+		priority  		Int
+		nonce 			Int
+		schemaVersionID String
+		data            Bytes
+	}`)
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *SetDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *SetDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// setOperation is the operation held in the data of a [SetDelta].
+type setOperation struct {
+	Add    []cbor.RawMessage
+	Remove []elementID
+}
+
+// setElement is a single value added to an ORSet.
+type setElement struct {
+	ID    elementID
+	Value cbor.RawMessage
+}
+
+// setState is the internal state of an ORSet.
+type setState struct {
+	// Elements holds the added elements that have not been removed, ordered by id.
+	Elements []setElement
+	// Tombstones holds the ordered ids of all removed elements.
+	Tombstones []elementID
+}
+
+// values returns the distinct values of the set ordered by the time they were first added.
+func (s setState) values() []cbor.RawMessage {
+	seen := make(map[string]struct{}, len(s.Elements))
+	values := make([]cbor.RawMessage, 0, len(s.Elements))
+	for _, element := range s.Elements {
+		if _, ok := seen[string(element.Value)]; ok {
+			continue
+		}
+		seen[string(element.Value)] = struct{}{}
+		values = append(values, element.Value)
+	}
+	return values
+}
+
+// ORSet, Observed-Remove Set, is a CRDT type that allows concurrent additions
+// and removals of values in an array field while ensuring convergence.
+//
+// A removal only affects the values that were observed by the peer making it,
+// so a value concurrently added and removed will remain in the set.
+type ORSet struct {
+	baseCRDT
+}
+
+var _ core.ReplicatedData = (*ORSet)(nil)
+
+// NewORSet returns a new instance of the ORSet with the given ID.
+func NewORSet(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) ORSet {
+	return ORSet{newBaseCRDT(store, key, schemaVersionKey, fieldName)}
+}
+
+// Value gets the current set value.
+func (s ORSet) Value(ctx context.Context) ([]byte, error) {
+	valueK := s.key.WithValueFlag()
+	buf, err := s.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Set generates a new delta that transforms the current set into the given CBOR encoded array.
+//
+// Duplicate values within the given array are only added once.
+func (s ORSet) Set(ctx context.Context, value []byte) (*SetDelta, error) {
+	values, err := decodeArray(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var state setState
+	err = s.getState(ctx, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	newValues := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		newValues[string(v)] = struct{}{}
+	}
+
+	op := setOperation{}
+	for _, element := range state.Elements {
+		if _, ok := newValues[string(element.Value)]; !ok {
+			op.Remove = append(op.Remove, element.ID)
+		}
+	}
+
+	existing := make(map[string]struct{}, len(state.Elements))
+	for _, element := range state.Elements {
+		existing[string(element.Value)] = struct{}{}
+	}
+	for _, v := range values {
+		if _, ok := existing[string(v)]; ok {
+			continue
+		}
+		existing[string(v)] = struct{}{}
+		op.Add = append(op.Add, v)
+	}
+
+	data, err := cbor.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// To ensure that the added elements are unique, we add a random number to the delta.
+	nonce, err := s.newNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetDelta{
+		DocID:           []byte(s.key.DocID),
+		FieldName:       s.fieldName,
+		Data:            data,
+		SchemaVersionID: s.schemaVersionKey.SchemaVersionID,
+		Nonce:           nonce,
+	}, nil
+}
+
+// Merge implements ReplicatedData interface.
+//
+// Removals are applied before additions, and both are idempotent so that
+// the resulting set is the same regardless of the order deltas are merged in.
+func (s ORSet) Merge(ctx context.Context, delta core.Delta) error {
+	d, ok := delta.(*SetDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	var op setOperation
+	err := cbor.Unmarshal(d.Data, &op)
+	if err != nil {
+		return err
+	}
+
+	var state setState
+	err = s.getState(ctx, &state)
+	if err != nil {
+		return err
+	}
+
+	removed := make(map[elementID]struct{}, len(op.Remove))
+	for _, id := range op.Remove {
+		i, found := slices.BinarySearchFunc(state.Tombstones, id, elementID.compare)
+		if found {
+			continue
+		}
+		state.Tombstones = slices.Insert(state.Tombstones, i, id)
+		removed[id] = struct{}{}
+	}
+	if len(removed) > 0 {
+		state.Elements = slices.DeleteFunc(state.Elements, func(e setElement) bool {
+			_, ok := removed[e.ID]
+			return ok
+		})
+	}
+
+	for index, value := range op.Add {
+		id := elementID{Priority: d.Priority, Nonce: d.Nonce, Index: index}
+		if _, found := slices.BinarySearchFunc(state.Tombstones, id, elementID.compare); found {
+			continue
+		}
+		i, found := slices.BinarySearchFunc(state.Elements, id, func(e setElement, id elementID) int {
+			return e.ID.compare(id)
+		})
+		if found {
+			continue
+		}
+		state.Elements = slices.Insert(state.Elements, i, setElement{ID: id, Value: value})
+	}
+
+	value, err := encodeArray(state.values())
+	if err != nil {
+		return err
+	}
+	return s.setState(ctx, state, value, d.Priority)
+}
+
+func (s ORSet) CType() client.CType {
+	return client.OR_SET
+}
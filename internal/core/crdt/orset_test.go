@@ -0,0 +1,136 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+func setupORSet() ORSet {
+	store := newMockStore()
+	key := core.DataStoreKey{DocID: "AAAA-BBBB"}
+	return NewORSet(store, core.CollectionSchemaVersionKey{}, key, "")
+}
+
+func encodeStrings(t *testing.T, values ...string) []byte {
+	b, err := cbor.Marshal(values)
+	require.NoError(t, err)
+	return b
+}
+
+func decodeStrings(t *testing.T, b []byte) []string {
+	var values []string
+	err := cbor.Unmarshal(b, &values)
+	require.NoError(t, err)
+	return values
+}
+
+func newSetDelta(t *testing.T, ctx context.Context, s ORSet, priority uint64, nonce int64, values ...string) *SetDelta {
+	delta, err := s.Set(ctx, encodeStrings(t, values...))
+	require.NoError(t, err)
+	delta.SetPriority(priority)
+	delta.Nonce = nonce
+	return delta
+}
+
+func TestORSetMerge_WithInitialValues_ShouldDeduplicate(t *testing.T) {
+	ctx := context.Background()
+	s := setupORSet()
+
+	delta := newSetDelta(t, ctx, s, 1, 0, "a", "b", "a")
+	err := s.Merge(ctx, delta)
+	require.NoError(t, err)
+
+	val, err := s.Value(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, decodeStrings(t, val))
+}
+
+func TestORSetMerge_WithRemoval_ShouldRemoveValue(t *testing.T) {
+	ctx := context.Background()
+	s := setupORSet()
+
+	err := s.Merge(ctx, newSetDelta(t, ctx, s, 1, 0, "a", "b"))
+	require.NoError(t, err)
+	err = s.Merge(ctx, newSetDelta(t, ctx, s, 2, 1, "b", "c"))
+	require.NoError(t, err)
+
+	val, err := s.Value(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "c"}, decodeStrings(t, val))
+}
+
+func TestORSetMerge_WithConcurrentChangesInDifferentOrder_ShouldConverge(t *testing.T) {
+	ctx := context.Background()
+	s1 := setupORSet()
+	s2 := setupORSet()
+
+	initial := newSetDelta(t, ctx, s1, 1, 0, "a", "b")
+	require.NoError(t, s1.Merge(ctx, initial))
+	require.NoError(t, s2.Merge(ctx, initial))
+
+	delta1 := newSetDelta(t, ctx, s1, 2, 1, "b", "c")
+	delta2 := newSetDelta(t, ctx, s2, 2, 2, "a", "b", "d")
+
+	require.NoError(t, s1.Merge(ctx, delta1))
+	require.NoError(t, s1.Merge(ctx, delta2))
+
+	require.NoError(t, s2.Merge(ctx, delta2))
+	require.NoError(t, s2.Merge(ctx, delta1))
+	// merging the same delta again must not change the value
+	require.NoError(t, s2.Merge(ctx, delta1))
+
+	val1, err := s1.Value(ctx)
+	require.NoError(t, err)
+	val2, err := s2.Value(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"b", "c", "d"}, decodeStrings(t, val1))
+	require.Equal(t, val1, val2)
+}
+
+func TestORSetMerge_WithConcurrentAddOfRemovedValue_ShouldKeepValue(t *testing.T) {
+	ctx := context.Background()
+	s1 := setupORSet()
+	s2 := setupORSet()
+
+	initial := newSetDelta(t, ctx, s1, 1, 0, "a")
+	require.NoError(t, s1.Merge(ctx, initial))
+	require.NoError(t, s2.Merge(ctx, initial))
+
+	// s2 removes and re-adds "a", the re-added value was not observed by s1
+	// and must survive the concurrent removal.
+	remove2 := newSetDelta(t, ctx, s2, 2, 2)
+	require.NoError(t, s2.Merge(ctx, remove2))
+	add2 := newSetDelta(t, ctx, s2, 3, 3, "a")
+	require.NoError(t, s2.Merge(ctx, add2))
+
+	remove1 := newSetDelta(t, ctx, s1, 2, 1)
+	require.NoError(t, s1.Merge(ctx, remove1))
+
+	require.NoError(t, s1.Merge(ctx, remove2))
+	require.NoError(t, s1.Merge(ctx, add2))
+	require.NoError(t, s2.Merge(ctx, remove1))
+
+	val1, err := s1.Value(ctx)
+	require.NoError(t, err)
+	val2, err := s2.Value(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"a"}, decodeStrings(t, val1))
+	require.Equal(t, val1, val2)
+}
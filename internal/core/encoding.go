@@ -66,15 +66,15 @@ func NormalizeFieldValue(fieldDesc client.FieldDefinition, val any) (any, error)
 		case client.FieldKind_FLOAT_ARRAY:
 			floatArray := make([]float64, len(array))
 			for i, untypedValue := range array {
-				floatArray[i], ok = untypedValue.(float64)
-				if !ok {
-					return nil, client.NewErrUnexpectedType[float64](fieldDesc.Name, untypedValue)
+				floatArray[i], err = convertToFloat(fmt.Sprintf("%s[%v]", fieldDesc.Name, i), untypedValue)
+				if err != nil {
+					return nil, err
 				}
 			}
 			val = floatArray
 
 		case client.FieldKind_NILLABLE_FLOAT_ARRAY:
-			val, err = convertNillableArray[float64](fieldDesc.Name, array)
+			val, err = convertNillableArrayWithConverter(fieldDesc.Name, array, convertToFloat)
 			if err != nil {
 				return nil, err
 			}
@@ -191,6 +191,22 @@ func convertToInt(propertyName string, untypedValue any) (int64, error) {
 	}
 }
 
+// convertToFloat converts the given value to a float.
+//
+// Integer values are accepted as the field may have been widened from an integer kind.
+func convertToFloat(propertyName string, untypedValue any) (float64, error) {
+	switch value := untypedValue.(type) {
+	case float64:
+		return value, nil
+	case uint64:
+		return float64(value), nil
+	case int64:
+		return float64(value), nil
+	default:
+		return 0, client.NewErrUnexpectedType[float64](propertyName, untypedValue)
+	}
+}
+
 // DecodeIndexDataStoreKey decodes a IndexDataStoreKey from bytes.
 // It expects the input bytes is in the following format:
 //
@@ -41,6 +41,8 @@ const (
 	PriorityKey = InstanceType("p")
 	// DeletedKey is a type that represents a deleted document.
 	DeletedKey = InstanceType("d")
+	// StateKey is a type that represents the internal state of a CRDT instance.
+	StateKey = InstanceType("s")
 )
 
 const (
@@ -59,7 +61,13 @@ const (
 	PRIMARY_KEY                    = "/pk"
 	DATASTORE_DOC_VERSION_FIELD_ID = "v"
 	REPLICATOR                     = "/replicator/id"
+	REPLICATOR_RETRY_ID            = "/rep/retry/id"
+	REPLICATOR_RETRY_DOC           = "/rep/retry/doc"
 	P2P_COLLECTION                 = "/p2p/collection"
+	REPLICA_ID                     = "/replica/id"
+	COMPACTION_STATE               = "/compaction/state"
+	COMPACTION_SNAPSHOT            = "/compaction/snapshot"
+	INDEX_BUILD_PROGRESS           = "/index/progress"
 )
 
 // Key is an interface that represents a key in the database.
@@ -239,6 +247,22 @@ type ReplicatorKey struct {
 
 var _ Key = (*ReplicatorKey)(nil)
 
+// ReplicatorRetryIDKey is used to key the retry state of a replicator that
+// documents failed to be pushed to.
+type ReplicatorRetryIDKey struct {
+	PeerID string
+}
+
+var _ Key = (*ReplicatorRetryIDKey)(nil)
+
+// ReplicatorRetryDocIDKey is used to key a document that failed to be pushed to a replicator.
+type ReplicatorRetryDocIDKey struct {
+	PeerID string
+	DocID  string
+}
+
+var _ Key = (*ReplicatorRetryDocIDKey)(nil)
+
 // Creates a new DataStoreKey from a string as best as it can,
 // splitting the input using '/' as a field deliminator.  It assumes
 // that the input string is in the following format:
@@ -457,6 +481,12 @@ func (k DataStoreKey) WithDeletedFlag() DataStoreKey {
 	return newKey
 }
 
+func (k DataStoreKey) WithStateFlag() DataStoreKey {
+	newKey := k
+	newKey.InstanceType = StateKey
+	return newKey
+}
+
 func (k DataStoreKey) WithDocID(docID string) DataStoreKey {
 	newKey := k
 	newKey.DocID = docID
@@ -852,6 +882,78 @@ func (k ReplicatorKey) ToDS() ds.Key {
 	return ds.NewKey(k.ToString())
 }
 
+func NewReplicatorRetryIDKey(peerID string) ReplicatorRetryIDKey {
+	return ReplicatorRetryIDKey{PeerID: peerID}
+}
+
+// NewReplicatorRetryIDKeyFromString creates a new ReplicatorRetryIDKey from a string.
+//
+// It expects the input string to be in the format `/rep/retry/id/[PeerID]`.
+func NewReplicatorRetryIDKeyFromString(key string) (ReplicatorRetryIDKey, error) {
+	peerID := strings.TrimPrefix(key, REPLICATOR_RETRY_ID+"/")
+	if peerID == "" || peerID == key || strings.Contains(peerID, "/") {
+		return ReplicatorRetryIDKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	return NewReplicatorRetryIDKey(peerID), nil
+}
+
+func (k ReplicatorRetryIDKey) ToString() string {
+	result := REPLICATOR_RETRY_ID
+
+	if k.PeerID != "" {
+		result = result + "/" + k.PeerID
+	}
+
+	return result
+}
+
+func (k ReplicatorRetryIDKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k ReplicatorRetryIDKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewReplicatorRetryDocIDKey(peerID, docID string) ReplicatorRetryDocIDKey {
+	return ReplicatorRetryDocIDKey{
+		PeerID: peerID,
+		DocID:  docID,
+	}
+}
+
+// NewReplicatorRetryDocIDKeyFromString creates a new ReplicatorRetryDocIDKey from a string.
+//
+// It expects the input string to be in the format `/rep/retry/doc/[PeerID]/[DocID]`.
+func NewReplicatorRetryDocIDKeyFromString(key string) (ReplicatorRetryDocIDKey, error) {
+	keyArr := strings.Split(strings.TrimPrefix(key, REPLICATOR_RETRY_DOC+"/"), "/")
+	if len(keyArr) != 2 || !strings.HasPrefix(key, REPLICATOR_RETRY_DOC+"/") {
+		return ReplicatorRetryDocIDKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	return NewReplicatorRetryDocIDKey(keyArr[0], keyArr[1]), nil
+}
+
+func (k ReplicatorRetryDocIDKey) ToString() string {
+	result := REPLICATOR_RETRY_DOC
+
+	if k.PeerID != "" {
+		result = result + "/" + k.PeerID
+		if k.DocID != "" {
+			result = result + "/" + k.DocID
+		}
+	}
+
+	return result
+}
+
+func (k ReplicatorRetryDocIDKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k ReplicatorRetryDocIDKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
 func (k HeadStoreKey) ToString() string {
 	var result string
 
@@ -958,3 +1060,92 @@ func (k EncStoreDocKey) Bytes() []byte {
 func (k EncStoreDocKey) ToDS() ds.Key {
 	return ds.NewKey(k.ToString())
 }
+
+// CompactionStateKey is used to key the compaction state of a document.
+type CompactionStateKey struct {
+	DocID string
+}
+
+var _ Key = (*CompactionStateKey)(nil)
+
+// NewCompactionStateKey creates a new CompactionStateKey for the given docID.
+func NewCompactionStateKey(docID string) CompactionStateKey {
+	return CompactionStateKey{DocID: docID}
+}
+
+func (k CompactionStateKey) ToString() string {
+	result := COMPACTION_STATE
+
+	if k.DocID != "" {
+		result = result + "/" + k.DocID
+	}
+
+	return result
+}
+
+func (k CompactionStateKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k CompactionStateKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// CompactionSnapshotKey is used to key the CID of the snapshot block that the compacted
+// history of a document was collapsed into.
+type CompactionSnapshotKey struct {
+	DocID string
+}
+
+var _ Key = (*CompactionSnapshotKey)(nil)
+
+// NewCompactionSnapshotKey creates a new CompactionSnapshotKey for the given docID.
+func NewCompactionSnapshotKey(docID string) CompactionSnapshotKey {
+	return CompactionSnapshotKey{DocID: docID}
+}
+
+func (k CompactionSnapshotKey) ToString() string {
+	result := COMPACTION_SNAPSHOT
+
+	if k.DocID != "" {
+		result = result + "/" + k.DocID
+	}
+
+	return result
+}
+
+func (k CompactionSnapshotKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k CompactionSnapshotKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// IndexBuildProgressKey is used to key the progress of the background build of an index.
+//
+// The progress is stored apart from the index description so that writing it does not
+// conflict with the transactions reading the index descriptions.
+type IndexBuildProgressKey struct {
+	CollectionID uint32
+	IndexName    string
+}
+
+var _ Key = (*IndexBuildProgressKey)(nil)
+
+// NewIndexBuildProgressKey creates a new IndexBuildProgressKey for the given collection and index.
+func NewIndexBuildProgressKey(collectionID uint32, indexName string) IndexBuildProgressKey {
+	return IndexBuildProgressKey{CollectionID: collectionID, IndexName: indexName}
+}
+
+func (k IndexBuildProgressKey) ToString() string {
+	return fmt.Sprintf("%s/%v/%s", INDEX_BUILD_PROGRESS, k.CollectionID, k.IndexName)
+}
+
+func (k IndexBuildProgressKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k IndexBuildProgressKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
@@ -323,3 +323,43 @@ func TestDecodeIndexDataStoreKey_InvalidKey(t *testing.T) {
 		})
 	}
 }
+
+func TestNewReplicatorRetryIDKeyFromString_IfInvalidString_ReturnError(t *testing.T) {
+	for _, key := range []string{
+		"",
+		"/rep/retry/id",
+		"/rep/retry/id/",
+		"/rep/retry/id/peer/extra",
+		"/wrong/retry/id/peer",
+	} {
+		_, err := NewReplicatorRetryIDKeyFromString(key)
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	}
+}
+
+func TestNewReplicatorRetryIDKeyFromString_IfFullKeyString_ReturnKey(t *testing.T) {
+	key, err := NewReplicatorRetryIDKeyFromString("/rep/retry/id/peer")
+	assert.NoError(t, err)
+	assert.Equal(t, NewReplicatorRetryIDKey("peer"), key)
+	assert.Equal(t, "/rep/retry/id/peer", key.ToString())
+}
+
+func TestNewReplicatorRetryDocIDKeyFromString_IfInvalidString_ReturnError(t *testing.T) {
+	for _, key := range []string{
+		"",
+		"/rep/retry/doc",
+		"/rep/retry/doc/peer",
+		"/rep/retry/doc/peer/doc/extra",
+		"/wrong/retry/doc/peer/doc",
+	} {
+		_, err := NewReplicatorRetryDocIDKeyFromString(key)
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	}
+}
+
+func TestNewReplicatorRetryDocIDKeyFromString_IfFullKeyString_ReturnKey(t *testing.T) {
+	key, err := NewReplicatorRetryDocIDKeyFromString("/rep/retry/doc/peer/doc")
+	assert.NoError(t, err)
+	assert.Equal(t, NewReplicatorRetryDocIDKey("peer", "doc"), key)
+	assert.Equal(t, "/rep/retry/doc/peer/doc", key.ToString())
+}
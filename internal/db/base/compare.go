@@ -34,6 +34,9 @@ func Compare(a, b any) int {
 	case bool:
 		return compareBool(v, b.(bool))
 	case int:
+		if bInt, ok := b.(int); ok {
+			return compareInt(int64(v), int64(bInt))
+		}
 		return compareInt(int64(v), b.(int64))
 	case int64:
 		return compareInt(v, b.(int64))
@@ -13,4 +13,5 @@ package base
 const (
 	ObjectMarker        = byte(0xff) // @todo: Investigate object marker values
 	DeletedObjectMarker = byte(0xfe)
+	PurgedObjectMarker  = byte(0xfd)
 )
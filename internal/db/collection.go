@@ -30,6 +30,7 @@ import (
 	"github.com/sourcenetwork/defradb/event"
 	"github.com/sourcenetwork/defradb/internal/core"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
 	"github.com/sourcenetwork/defradb/internal/db/base"
 	"github.com/sourcenetwork/defradb/internal/db/description"
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
@@ -284,8 +285,7 @@ func (c *collection) getAllDocIDsChan(
 		CollectionRootID: c.Description().RootID,
 	}
 	q, err := txn.Datastore().Query(ctx, query.Query{
-		Prefix:   prefix.ToString(),
-		KeysOnly: true,
+		Prefix: prefix.ToString(),
 	})
 	if err != nil {
 		return nil, err
@@ -315,6 +315,10 @@ func (c *collection) getAllDocIDsChan(
 				}
 				return
 			}
+			if bytes.Equal(res.Value, []byte{base.PurgedObjectMarker}) {
+				// only the tombstone of a purged document remains
+				continue
+			}
 
 			rawDocID := ds.NewKey(res.Key).BaseNamespace()
 			docID, err := client.NewDocIDFromString(rawDocID)
@@ -443,6 +447,15 @@ func (c *collection) create(
 		return err
 	}
 
+	// a purged document can never be recreated
+	isPurged, err := c.isPurged(ctx, primaryKey)
+	if err != nil {
+		return err
+	}
+	if isPurged {
+		return NewErrDocumentPurged(primaryKey.DocID)
+	}
+
 	// check if doc already exists
 	exists, isDeleted, err := c.exists(ctx, primaryKey)
 	if err != nil {
@@ -561,6 +574,13 @@ func (c *collection) Save(
 	}
 
 	if isDeleted {
+		isPurged, err := c.isPurged(ctx, primaryKey)
+		if err != nil {
+			return err
+		}
+		if isPurged {
+			return NewErrDocumentPurged(doc.ID().String())
+		}
 		return NewErrDocumentDeleted(doc.ID().String())
 	}
 
@@ -616,6 +636,7 @@ func (c *collection) save(
 		}
 	}
 	txn := mustGetContextTxn(ctx)
+	ctx = crdt.SetContextReplicaID(ctx, c.db.replicaID)
 
 	// NOTE: We delay the final Clean() call until we know
 	// the commit on the transaction is successful. If we didn't
@@ -896,17 +917,33 @@ func (c *collection) exists(
 	} else if err != nil {
 		return false, false, err
 	}
-	if bytes.Equal(val, []byte{base.DeletedObjectMarker}) {
+	if bytes.Equal(val, []byte{base.DeletedObjectMarker}) || bytes.Equal(val, []byte{base.PurgedObjectMarker}) {
 		return true, true, nil
 	}
 
 	return true, false, nil
 }
 
+// isPurged returns true if the document with the given primary key has been purged.
+func (c *collection) isPurged(
+	ctx context.Context,
+	primaryKey core.PrimaryDataStoreKey,
+) (bool, error) {
+	txn := mustGetContextTxn(ctx)
+	val, err := txn.Datastore().Get(ctx, primaryKey.ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(val, []byte{base.PurgedObjectMarker}), nil
+}
+
 // saveCompositeToMerkleCRDT saves the composite to the merkle CRDT.
 // It returns the CID of the block and the encoded block.
-// saveCompositeToMerkleCRDT MUST not be called outside the `c.save`
-// and `c.applyDelete` methods as we wrap the acp logic around those methods.
+// saveCompositeToMerkleCRDT MUST not be called outside the `c.save`, `c.applyDelete`
+// and `c.applyPurge` methods as we wrap the acp logic around those methods.
 // Calling it elsewhere could cause the omission of acp checks.
 func (c *collection) saveCompositeToMerkleCRDT(
 	ctx context.Context,
@@ -923,6 +960,9 @@ func (c *collection) saveCompositeToMerkleCRDT(
 		"",
 	)
 
+	if status.IsPurged() {
+		return merkleCRDT.Purge(ctx)
+	}
 	if status.IsDeleted() {
 		return merkleCRDT.Delete(ctx, links)
 	}
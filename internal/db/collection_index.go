@@ -23,6 +23,7 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/internal/connor/fulltext"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/db/base"
 	"github.com/sourcenetwork/defradb/internal/db/description"
@@ -210,6 +211,13 @@ func (c *collection) CreateIndex(
 	if err != nil {
 		return client.IndexDescription{}, err
 	}
+	if index.Description().IsFullText() {
+		// full-text indexes add the `_score` field to the collection type
+		err = c.db.loadSchema(ctx)
+		if err != nil {
+			return client.IndexDescription{}, err
+		}
+	}
 	return index.Description(), txn.Commit(ctx)
 }
 
@@ -230,6 +238,16 @@ func (c *collection) createIndex(
 		return nil, err
 	}
 
+	if desc.IsFullText() {
+		err = c.checkFullTextIndexField(desc.Fields[0].Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(desc.Analyzer) == 0 {
+			desc.Analyzer = fulltext.DefaultFilters()
+		}
+	}
+
 	indexKey, err := c.generateIndexNameIfNeededAndCreateKey(ctx, &desc)
 	if err != nil {
 		return nil, err
@@ -371,12 +389,14 @@ func (c *collection) dropIndex(ctx context.Context, indexName string) error {
 	txn := mustGetContextTxn(ctx)
 
 	var didFind bool
+	var isFullText bool
 	for i := range c.indexes {
 		if c.indexes[i].Name() == indexName {
 			err = c.indexes[i].RemoveAll(ctx, txn)
 			if err != nil {
 				return err
 			}
+			isFullText = c.indexes[i].Description().IsFullText()
 			c.indexes = append(c.indexes[:i], c.indexes[i+1:]...)
 			didFind = true
 			break
@@ -398,6 +418,10 @@ func (c *collection) dropIndex(ctx context.Context, indexName string) error {
 		return err
 	}
 
+	if isFullText {
+		// the `_score` field must be removed from the collection type
+		return c.db.loadSchema(ctx)
+	}
 	return nil
 }
 
@@ -472,6 +496,16 @@ func (c *collection) checkExistingFieldsAndAdjustRelFieldNames(
 	return nil
 }
 
+// checkFullTextIndexField checks that the field with the given name can be indexed by
+// a full-text index.
+func (c *collection) checkFullTextIndexField(fieldName string) error {
+	field, _ := c.Schema().GetFieldByName(fieldName)
+	if field.Kind != client.FieldKind_NILLABLE_STRING {
+		return NewErrFullTextIndexFieldNotString(fieldName, field.Kind)
+	}
+	return nil
+}
+
 func (c *collection) generateIndexNameIfNeededAndCreateKey(
 	ctx context.Context,
 	desc *client.IndexDescription,
@@ -483,7 +517,7 @@ func (c *collection) generateIndexNameIfNeededAndCreateKey(
 	if desc.Name == "" {
 		nameIncrement := 1
 		for {
-			desc.Name = generateIndexName(c, desc.Fields, nameIncrement)
+			desc.Name = generateIndexName(c, *desc, nameIncrement)
 			indexKey = core.NewCollectionIndexKey(immutable.Some(c.ID()), desc.Name)
 			exists, err := txn.Systemstore().Has(ctx, indexKey.ToDS())
 			if err != nil {
@@ -519,14 +553,37 @@ func validateIndexDescription(desc client.IndexDescription) error {
 			return ErrIndexFieldMissingName
 		}
 	}
+	switch desc.Type {
+	case client.IndexTypeDefault:
+		if len(desc.Analyzer) > 0 {
+			return ErrIndexAnalyzerRequiresFullText
+		}
+	case client.IndexTypeFullText:
+		if len(desc.Fields) != 1 {
+			return ErrFullTextIndexMustHaveOneField
+		}
+		if desc.Unique {
+			return ErrFullTextIndexCanNotBeUnique
+		}
+		_, err := fulltext.NewAnalyzer(desc.Analyzer)
+		if err != nil {
+			return err
+		}
+	default:
+		return NewErrUnknownIndexType(desc.Type)
+	}
 	return nil
 }
 
-func generateIndexName(col client.Collection, fields []client.IndexedFieldDescription, inc int) string {
+func generateIndexName(col client.Collection, desc client.IndexDescription, inc int) string {
 	sb := strings.Builder{}
 	// at the moment we support only single field indexes that can be stored only in
 	// ascending order. This will change once we introduce composite indexes.
 	direction := "ASC"
+	if desc.IsFullText() {
+		// full-text indexes are ordered by token, not by field value
+		direction = "FULLTEXT"
+	}
 	if col.Name().HasValue() {
 		sb.WriteString(col.Name().Value())
 	} else {
@@ -535,7 +592,7 @@ func generateIndexName(col client.Collection, fields []client.IndexedFieldDescri
 	sb.WriteByte('_')
 	// we can safely assume that there is at least one field in the slice
 	// because we validate it before calling this function
-	sb.WriteString(fields[0].Name)
+	sb.WriteString(desc.Fields[0].Name)
 	sb.WriteByte('_')
 	sb.WriteString(direction)
 	if inc > 1 {
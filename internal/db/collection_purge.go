@@ -0,0 +1,285 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/acp"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// Purge permanently removes the document with the given DocID along with its history.
+//
+// The field values, index entries, encryption keys and blocks of the document are removed,
+// leaving only a tombstone block that prevents the document from being recreated. The tombstone
+// is propagated to the peers so that they purge the document too.
+func (c *collection) Purge(
+	ctx context.Context,
+	docID client.DocID,
+) (bool, error) {
+	ctx, txn, err := ensureContextTxn(ctx, c.db, false)
+	if err != nil {
+		return false, err
+	}
+	defer txn.Discard(ctx)
+
+	primaryKey := c.getPrimaryKeyFromDocID(docID)
+
+	err = c.applyPurge(ctx, primaryKey)
+	if err != nil {
+		return false, err
+	}
+	return true, txn.Commit(ctx)
+}
+
+func (c *collection) applyPurge(
+	ctx context.Context,
+	primaryKey core.PrimaryDataStoreKey,
+) error {
+	if len(c.Description().QuerySources()) > 0 {
+		return NewErrCanNotPurgeView(c.Name().Value())
+	}
+
+	isPurged, err := c.isPurged(ctx, primaryKey)
+	if err != nil {
+		return err
+	}
+	if isPurged {
+		return NewErrDocumentPurged(primaryKey.DocID)
+	}
+
+	// Must also have read permission to purge, inorder to check if document exists.
+	found, _, err := c.exists(ctx, primaryKey)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return client.ErrDocumentNotFoundOrNotAuthorized
+	}
+
+	// Stop the purge of the document if the correct permissions aren't there.
+	canPurge, err := c.checkAccessOfDocWithACP(
+		ctx,
+		acp.WritePermission,
+		primaryKey.DocID,
+	)
+	if err != nil {
+		return err
+	}
+	if !canPurge {
+		return client.ErrDocumentNotFoundOrNotAuthorized
+	}
+
+	err = c.purgeDoc(ctx, primaryKey)
+	if err != nil {
+		return err
+	}
+
+	link, b, err := c.saveCompositeToMerkleCRDT(
+		ctx,
+		primaryKey.ToDataStoreKey(),
+		nil,
+		client.Purged,
+	)
+	if err != nil {
+		return err
+	}
+
+	// publish an update event if the txn succeeds
+	txn := mustGetContextTxn(ctx)
+	updateEvent := event.Update{
+		DocID:      primaryKey.DocID,
+		Cid:        link.Cid,
+		SchemaRoot: c.Schema().Root,
+		Block:      b,
+	}
+	txn.OnSuccess(func() {
+		c.db.events.Publish(event.NewMessage(event.UpdateName, updateEvent))
+	})
+
+	return nil
+}
+
+// purgeDoc removes all the state of the given document: its index entries, field values,
+// encryption keys, heads, blocks and compaction state.
+func (c *collection) purgeDoc(
+	ctx context.Context,
+	primaryKey core.PrimaryDataStoreKey,
+) error {
+	err := c.purgeIndexedDoc(ctx, primaryKey)
+	if err != nil {
+		return err
+	}
+
+	txn := mustGetContextTxn(ctx)
+	for _, instanceType := range []core.InstanceType{core.ValueKey, core.DeletedKey, core.PriorityKey, core.StateKey} {
+		prefix := core.DataStoreKey{
+			CollectionRootID: primaryKey.CollectionRootID,
+			InstanceType:     instanceType,
+			DocID:            primaryKey.DocID,
+		}
+		err := deleteWithPrefix(ctx, txn.Datastore(), prefix.ToString())
+		if err != nil {
+			return err
+		}
+	}
+
+	err = deleteWithPrefix(ctx, txn.Encstore(), core.NewEncStoreDocKey(primaryKey.DocID, "").ToDS().String())
+	if err != nil {
+		return err
+	}
+
+	heads, err := getDocHeadsByField(ctx, txn, primaryKey.DocID)
+	if err != nil {
+		return err
+	}
+	var roots []cid.Cid
+	for _, fieldHeads := range heads {
+		for head := range fieldHeads {
+			roots = append(roots, head)
+		}
+	}
+	snapshot, hasSnapshot, err := getCompactionSnapshot(ctx, txn, primaryKey.DocID)
+	if err != nil {
+		return err
+	}
+	if hasSnapshot {
+		roots = append(roots, snapshot)
+	}
+	err = deleteBlocks(ctx, txn, roots, nil)
+	if err != nil {
+		return err
+	}
+
+	err = deleteWithPrefix(ctx, txn.Headstore(), core.HeadStoreKey{DocID: primaryKey.DocID}.ToString())
+	if err != nil {
+		return err
+	}
+	err = txn.Systemstore().Delete(ctx, core.NewCompactionStateKey(primaryKey.DocID).ToDS())
+	if err != nil {
+		return err
+	}
+	return txn.Systemstore().Delete(ctx, core.NewCompactionSnapshotKey(primaryKey.DocID).ToDS())
+}
+
+// purgeIndexedDoc removes the index entries of the given document.
+func (c *collection) purgeIndexedDoc(
+	ctx context.Context,
+	primaryKey core.PrimaryDataStoreKey,
+) error {
+	doc, err := c.get(ctx, primaryKey, c.Definition().CollectIndexedFields(), true)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return nil
+	}
+
+	err = c.loadIndexes(ctx)
+	if err != nil {
+		return err
+	}
+	txn := mustGetContextTxn(ctx)
+	for _, index := range c.indexes {
+		err = index.Delete(ctx, txn, doc)
+		// The index entries of a document deleted through a merge have already been removed.
+		if err != nil && !errors.Is(err, ErrCorruptedIndex) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteBlocks removes the given blocks and all the blocks reachable from them, except the
+// ones to keep, from the blockstore.
+func deleteBlocks(
+	ctx context.Context,
+	txn datastore.Txn,
+	roots []cid.Cid,
+	keep map[cid.Cid]struct{},
+) error {
+	toVisit := roots
+	visited := make(map[cid.Cid]struct{})
+	for len(toVisit) > 0 {
+		current := toVisit[len(toVisit)-1]
+		toVisit = toVisit[:len(toVisit)-1]
+		if _, ok := visited[current]; ok {
+			continue
+		}
+		visited[current] = struct{}{}
+		if _, ok := keep[current]; ok {
+			continue
+		}
+
+		has, err := txn.Blockstore().Has(ctx, current)
+		if err != nil {
+			return err
+		}
+		if !has {
+			// the block has already been removed, for example by a compaction
+			continue
+		}
+
+		block, err := getBlock(ctx, txn, current)
+		if err != nil {
+			return err
+		}
+		for _, link := range block.Links {
+			toVisit = append(toVisit, link.Cid)
+		}
+		err = txn.Blockstore().DeleteBlock(ctx, current)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteWithPrefix removes all the keys with the given prefix from the given store.
+func deleteWithPrefix(ctx context.Context, store datastore.DSReaderWriter, prefix string) error {
+	q, err := store.Query(ctx, query.Query{
+		Prefix:   prefix,
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	for res := range q.Next() {
+		if res.Error != nil {
+			_ = q.Close()
+			return res.Error
+		}
+		keys = append(keys, res.Key)
+	}
+	err = q.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err := store.Delete(ctx, ds.NewKey(key))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
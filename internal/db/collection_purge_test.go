@@ -0,0 +1,89 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/encryption"
+)
+
+func countKeysWithPrefix(t *testing.T, ctx context.Context, store datastore.DSReaderWriter, prefix string) int {
+	q, err := store.Query(ctx, query.Query{Prefix: prefix, KeysOnly: true})
+	require.NoError(t, err)
+	entries, err := q.Rest()
+	require.NoError(t, err)
+	return len(entries)
+}
+
+func TestPurge_WithEncryptedFieldAndIndex_RemovesAllState(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String @index
+		age: Int
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 21}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Create(encryption.SetContextConfigFromParams(ctx, false, []string{"age"}), doc)
+	require.NoError(t, err)
+
+	err = doc.Set("age", 22)
+	require.NoError(t, err)
+	err = col.Update(ctx, doc)
+	require.NoError(t, err)
+
+	docID := doc.ID().String()
+	rootID := col.Description().RootID
+	dataPrefix := core.DataStoreKey{CollectionRootID: rootID, InstanceType: core.ValueKey, DocID: docID}.ToString()
+	indexKey := core.IndexDataStoreKey{CollectionID: col.ID()}
+	indexPrefix := indexKey.ToString()
+	encPrefix := core.NewEncStoreDocKey(docID, "").ToDS().String()
+	headPrefix := core.HeadStoreKey{DocID: docID}.ToString()
+
+	require.NotZero(t, countKeysWithPrefix(t, ctx, db.multistore.Datastore(), dataPrefix))
+	require.NotZero(t, countKeysWithPrefix(t, ctx, db.multistore.Datastore(), indexPrefix))
+	require.NotZero(t, countKeysWithPrefix(t, ctx, db.multistore.Encstore(), encPrefix))
+
+	_, err = col.Purge(ctx, doc.ID())
+	require.NoError(t, err)
+
+	require.Zero(t, countKeysWithPrefix(t, ctx, db.multistore.Datastore(), dataPrefix))
+	require.Zero(t, countKeysWithPrefix(t, ctx, db.multistore.Datastore(), indexPrefix))
+	require.Zero(t, countKeysWithPrefix(t, ctx, db.multistore.Encstore(), encPrefix))
+
+	// only the tombstone remains
+	require.Equal(t, 1, countKeysWithPrefix(t, ctx, db.multistore.Headstore(), headPrefix))
+
+	blocks, err := db.multistore.Blockstore().AllKeysChan(ctx)
+	require.NoError(t, err)
+	var blockCount int
+	for range blocks {
+		blockCount++
+	}
+	require.Equal(t, 1, blockCount)
+}
@@ -238,6 +238,12 @@ func (c *collection) makeSelectionPlan(
 		if err != nil {
 			return nil, err
 		}
+	case map[string]any:
+		if len(fval) == 0 {
+			return nil, ErrInvalidFilter
+		}
+
+		f = immutable.Some(request.Filter{Conditions: fval})
 	case immutable.Option[request.Filter]:
 		f = fval
 	default:
@@ -257,6 +263,7 @@ func (c *collection) makeSelectionPlan(
 		c.db.acp,
 		c.db,
 		txn,
+		planner.WithSortMemoryBudget(c.db.sortMemoryBudget),
 	)
 
 	return planner.MakeSelectionPlan(slct)
@@ -0,0 +1,116 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// Upsert updates the document matching the given filter using the given updater,
+// or creates the given document if no document matches the filter.
+func (c *collection) Upsert(
+	ctx context.Context,
+	filter any,
+	doc *client.Document,
+	updater string,
+) (*client.UpsertResult, error) {
+	ctx, txn, err := ensureContextTxn(ctx, c.db, false)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	res, err := c.upsert(ctx, filter, doc, updater)
+	if err != nil {
+		return nil, err
+	}
+	return res, txn.Commit(ctx)
+}
+
+func (c *collection) upsert(
+	ctx context.Context,
+	filter any,
+	doc *client.Document,
+	updater string,
+) (*client.UpsertResult, error) {
+	// Make a selection plan that will scan through only the documents with matching filter.
+	selectionPlan, err := c.makeSelectionPlan(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = selectionPlan.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = selectionPlan.Start(); err != nil {
+		return nil, err
+	}
+
+	// If the plan isn't properly closed at any exit point log the error.
+	defer func() {
+		if err := selectionPlan.Close(); err != nil {
+			log.ErrorContextE(ctx, "Failed to close the selection plan, after filter upsert", err)
+		}
+	}()
+
+	next, err := selectionPlan.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	if !next {
+		err = c.create(ctx, doc)
+		if err != nil {
+			return nil, err
+		}
+		return &client.UpsertResult{
+			DocID:   doc.ID().String(),
+			Created: true,
+		}, nil
+	}
+
+	existingValue := selectionPlan.Value()
+	docID, err := client.NewDocIDFromString(existingValue.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure that multiple documents do not match the filter.
+	next, err = selectionPlan.Next()
+	if err != nil {
+		return nil, err
+	}
+	if next {
+		return nil, client.ErrUpsertMultipleDocuments
+	}
+
+	existing, err := c.Get(ctx, docID, false)
+	if err != nil {
+		return nil, err
+	}
+	err = existing.SetWithJSON([]byte(updater))
+	if err != nil {
+		return nil, err
+	}
+	err = c.update(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.UpsertResult{
+		DocID:   existing.ID().String(),
+		Created: false,
+	}, nil
+}
@@ -0,0 +1,552 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/ipld/go-ipld-prime/linking"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+)
+
+// compactionState is the compaction state of a single document.
+type compactionState struct {
+	// Height is the height up to which the history of the document has been collapsed.
+	Height uint64
+
+	// Checkpoints are the heights of the document seen by the compaction runs that have
+	// not been collapsed yet, used to measure the age of its versions.
+	Checkpoints []compactionCheckpoint
+}
+
+// compactionCheckpoint records the height of a document at the time of a compaction run.
+type compactionCheckpoint struct {
+	Time   time.Time
+	Height uint64
+}
+
+// compactCollection collapses the history of every document within the given collection that is
+// older than the given retention policy into a snapshot block, and removes the blocks that are no
+// longer reachable from the blockstore.
+func (db *db) compactCollection(
+	ctx context.Context,
+	col client.Collection,
+	policy client.RetentionPolicy,
+) (client.CompactionResult, error) {
+	if len(col.Description().QuerySources()) > 0 {
+		return client.CompactionResult{}, NewErrCanNotCompactView(col.Name().Value())
+	}
+
+	docIDs, err := getAllDocIDs(ctx, col.Description().RootID)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+
+	now := time.Now()
+	result := client.CompactionResult{}
+	for _, docID := range docIDs {
+		compacted, removed, err := compactDoc(ctx, docID, policy, now)
+		if err != nil {
+			return client.CompactionResult{}, err
+		}
+		if compacted {
+			result.CompactedDocs++
+		}
+		result.RemovedBlocks += removed
+	}
+
+	return result, nil
+}
+
+// getAllDocIDs returns the IDs of all the documents, including deleted ones, of the collection
+// with the given root ID.
+func getAllDocIDs(ctx context.Context, rootID uint32) ([]string, error) {
+	txn := mustGetContextTxn(ctx)
+	prefix := core.PrimaryDataStoreKey{
+		CollectionRootID: rootID,
+	}
+	q, err := txn.Datastore().Query(ctx, query.Query{
+		Prefix:   prefix.ToString(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := q.Close(); err != nil {
+			log.ErrorContextE(ctx, errFailedtoCloseQueryReqAllIDs, err)
+		}
+	}()
+
+	var docIDs []string
+	for res := range q.Next() {
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		docIDs = append(docIDs, ds.NewKey(res.Key).BaseNamespace())
+	}
+	return docIDs, nil
+}
+
+// compactDoc collapses the history of the given document that is older than the given retention
+// policy.
+//
+// It returns true if the history was collapsed, and the number of blocks that were removed.
+func compactDoc(
+	ctx context.Context,
+	docID string,
+	policy client.RetentionPolicy,
+	now time.Time,
+) (bool, uint64, error) {
+	txn := mustGetContextTxn(ctx)
+
+	state, err := getCompactionState(ctx, txn, docID)
+	if err != nil {
+		return false, 0, err
+	}
+	heads, err := getDocHeadsByField(ctx, txn, docID)
+	if err != nil {
+		return false, 0, err
+	}
+	var maxHeight uint64
+	for _, height := range heads[core.COMPOSITE_NAMESPACE] {
+		maxHeight = max(maxHeight, height)
+	}
+
+	cutoff := retentionCutoff(policy, state.Checkpoints, maxHeight, now)
+	if len(state.Checkpoints) == 0 || state.Checkpoints[len(state.Checkpoints)-1].Height < maxHeight {
+		state.Checkpoints = append(state.Checkpoints, compactionCheckpoint{Time: now, Height: maxHeight})
+	}
+	if cutoff <= state.Height {
+		return false, 0, setCompactionState(ctx, txn, docID, state)
+	}
+
+	c := &docCompaction{
+		txn:            txn,
+		docID:          docID,
+		cutoff:         cutoff,
+		pruned:         make(map[cid.Cid]*coreblock.Block),
+		retained:       make(map[cid.Cid]*coreblock.Block),
+		prunedFields:   make(map[cid.Cid]*coreblock.Block),
+		retainedFields: make(map[cid.Cid]*coreblock.Block),
+		kept:           make(map[cid.Cid]*coreblock.Block),
+	}
+	removed, err := c.run(ctx, heads)
+	if err != nil {
+		return false, 0, err
+	}
+
+	state.Height = cutoff
+	checkpoints := state.Checkpoints[:0]
+	for _, checkpoint := range state.Checkpoints {
+		if checkpoint.Height > cutoff {
+			checkpoints = append(checkpoints, checkpoint)
+		}
+	}
+	state.Checkpoints = checkpoints
+	err = setCompactionState(ctx, txn, docID, state)
+	if err != nil {
+		return false, 0, err
+	}
+	return removed > 0, removed, nil
+}
+
+// retentionCutoff returns the height up to which the history of a document is collapsed.
+//
+// History is retained as long as it is within any of the limits of the policy.
+func retentionCutoff(
+	policy client.RetentionPolicy,
+	checkpoints []compactionCheckpoint,
+	maxHeight uint64,
+	now time.Time,
+) uint64 {
+	cutoff := maxHeight
+	if policy.Depth.HasValue() {
+		var depthCutoff uint64
+		if maxHeight > policy.Depth.Value() {
+			depthCutoff = maxHeight - policy.Depth.Value()
+		}
+		cutoff = min(cutoff, depthCutoff)
+	}
+	if policy.Age.HasValue() {
+		var ageCutoff uint64
+		limit := now.Add(-policy.Age.Value())
+		for _, checkpoint := range checkpoints {
+			if !checkpoint.Time.After(limit) {
+				ageCutoff = max(ageCutoff, checkpoint.Height)
+			}
+		}
+		cutoff = min(cutoff, ageCutoff)
+	}
+	return cutoff
+}
+
+// docCompaction collapses the history of a single document up to the cutoff height.
+type docCompaction struct {
+	txn    datastore.Txn
+	docID  string
+	cutoff uint64
+
+	// pruned and retained are the composite blocks below and above the cutoff.
+	pruned   map[cid.Cid]*coreblock.Block
+	retained map[cid.Cid]*coreblock.Block
+
+	// prunedFields and retainedFields are the field blocks linked from the pruned
+	// and retained composite blocks.
+	prunedFields   map[cid.Cid]*coreblock.Block
+	retainedFields map[cid.Cid]*coreblock.Block
+
+	// kept are the pruned field blocks that are still needed by the retained history
+	// and are linked from the snapshot block.
+	kept map[cid.Cid]*coreblock.Block
+}
+
+func (c *docCompaction) run(ctx context.Context, heads map[string]map[cid.Cid]uint64) (uint64, error) {
+	err := c.loadComposites(ctx, heads[core.COMPOSITE_NAMESPACE])
+	if err != nil {
+		return 0, err
+	}
+	if len(c.pruned) == 0 {
+		return 0, nil
+	}
+
+	snapshotKey := core.NewCompactionSnapshotKey(c.docID)
+	previousSnapshot, hasPreviousSnapshot, err := getCompactionSnapshot(ctx, c.txn, c.docID)
+	if err != nil {
+		return 0, err
+	}
+	if hasPreviousSnapshot {
+		// the field blocks kept by the previous compaction are now part of the pruned history
+		block, err := getBlock(ctx, c.txn, previousSnapshot)
+		if err != nil {
+			return 0, err
+		}
+		err = c.loadFields(ctx, block, c.prunedFields)
+		if err != nil {
+			return 0, err
+		}
+	}
+	for _, block := range c.pruned {
+		err := c.loadFields(ctx, block, c.prunedFields)
+		if err != nil {
+			return 0, err
+		}
+	}
+	for _, block := range c.retained {
+		err := c.loadFields(ctx, block, c.retainedFields)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// The current heads of the fields and the blocks the retained field blocks link to
+	// are kept, so that the retained history remains complete.
+	for fieldID, fieldHeads := range heads {
+		if fieldID == core.COMPOSITE_NAMESPACE {
+			continue
+		}
+		for head := range fieldHeads {
+			if block, ok := c.prunedFields[head]; ok {
+				c.kept[head] = block
+			}
+		}
+	}
+	for _, block := range c.retainedFields {
+		for _, link := range block.Links {
+			if prunedBlock, ok := c.prunedFields[link.Cid]; ok && link.Name == core.HEAD {
+				c.kept[link.Cid] = prunedBlock
+			}
+		}
+	}
+
+	err = c.markFrontier(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	snapshot, err := c.snapshot()
+	if err != nil {
+		return 0, err
+	}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(c.txn.Blockstore().AsIPLDStorage())
+	link, err := lsys.Store(linking.LinkContext{Ctx: ctx}, coreblock.GetLinkPrototype(), snapshot.GenerateNode())
+	if err != nil {
+		return 0, err
+	}
+	err = c.txn.Systemstore().Put(ctx, snapshotKey.ToDS(), link.(cidlink.Link).Cid.Bytes())
+	if err != nil {
+		return 0, err
+	}
+
+	var removed uint64
+	for blockCid := range c.pruned {
+		err := c.txn.Blockstore().DeleteBlock(ctx, blockCid)
+		if err != nil {
+			return 0, err
+		}
+		removed++
+	}
+	for blockCid := range c.prunedFields {
+		if _, ok := c.kept[blockCid]; ok {
+			continue
+		}
+		err := c.txn.Blockstore().DeleteBlock(ctx, blockCid)
+		if err != nil {
+			return 0, err
+		}
+		removed++
+	}
+	if hasPreviousSnapshot {
+		err := c.txn.Blockstore().DeleteBlock(ctx, previousSnapshot)
+		if err != nil {
+			return 0, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// loadComposites loads the composite blocks of the document, walking back from its heads
+// until the blocks that have already been compacted, and splits them by the cutoff.
+//
+// The heads are always retained.
+func (c *docCompaction) loadComposites(ctx context.Context, heads map[cid.Cid]uint64) error {
+	toVisit := make([]cid.Cid, 0, len(heads))
+	for head := range heads {
+		toVisit = append(toVisit, head)
+	}
+	visited := make(map[cid.Cid]struct{})
+	for len(toVisit) > 0 {
+		current := toVisit[len(toVisit)-1]
+		toVisit = toVisit[:len(toVisit)-1]
+		if _, ok := visited[current]; ok {
+			continue
+		}
+		visited[current] = struct{}{}
+
+		compacted, err := c.txn.Blockstore().IsCompacted(ctx, current)
+		if err != nil {
+			return err
+		}
+		if compacted {
+			continue
+		}
+
+		block, err := getBlock(ctx, c.txn, current)
+		if err != nil {
+			return err
+		}
+		_, isHead := heads[current]
+		if block.Delta.GetPriority() <= c.cutoff && !isHead {
+			c.pruned[current] = block
+		} else {
+			c.retained[current] = block
+		}
+		for _, link := range block.Links {
+			if link.Name == core.HEAD {
+				toVisit = append(toVisit, link.Cid)
+			}
+		}
+	}
+	return nil
+}
+
+// loadFields loads the field blocks linked from the given composite block into the given set.
+func (c *docCompaction) loadFields(
+	ctx context.Context,
+	composite *coreblock.Block,
+	fields map[cid.Cid]*coreblock.Block,
+) error {
+	for _, link := range composite.Links {
+		if link.Name == core.HEAD {
+			continue
+		}
+		block, err := getBlock(ctx, c.txn, link.Cid)
+		if err != nil {
+			return err
+		}
+		fields[link.Cid] = block
+	}
+	return nil
+}
+
+// markFrontier marks the removed blocks that are linked from the remaining blocks as compacted,
+// so that the DAG walks stop there instead of failing on the missing blocks.
+func (c *docCompaction) markFrontier(ctx context.Context) error {
+	isRemoved := func(blockCid cid.Cid) bool {
+		if _, ok := c.pruned[blockCid]; ok {
+			return true
+		}
+		_, isPrunedField := c.prunedFields[blockCid]
+		_, isKept := c.kept[blockCid]
+		return isPrunedField && !isKept
+	}
+
+	for _, blocks := range []map[cid.Cid]*coreblock.Block{c.retained, c.retainedFields, c.kept} {
+		for _, block := range blocks {
+			for _, link := range block.Links {
+				if link.Name != core.HEAD || !isRemoved(link.Cid) {
+					continue
+				}
+				err := c.txn.Blockstore().MarkCompacted(ctx, link.Cid)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// snapshot returns the composite block that the pruned history is collapsed into.
+//
+// It has the height and status of the newest pruned composite block, and links to the
+// kept field blocks holding the state of the fields at that height.
+func (c *docCompaction) snapshot() (*coreblock.Block, error) {
+	var newest *coreblock.Block
+	for _, block := range c.pruned {
+		if newest == nil || block.Delta.GetPriority() > newest.Delta.GetPriority() {
+			newest = block
+		}
+	}
+
+	links := make([]coreblock.DAGLink, 0, len(c.kept))
+	for blockCid, block := range c.kept {
+		links = append(links, coreblock.NewDAGLink(block.Delta.GetFieldName(), cidlink.Link{Cid: blockCid}))
+	}
+
+	delta := &crdt.CompositeDAGDelta{
+		DocID:           []byte(c.docID),
+		Priority:        newest.Delta.GetPriority(),
+		SchemaVersionID: newest.Delta.GetSchemaVersionID(),
+		Status:          client.DocumentStatus(newest.Delta.GetStatus()),
+	}
+	return coreblock.New(delta, links), nil
+}
+
+// getDocHeadsByField returns the heads and their heights of every CRDT of the given document,
+// by field ID.
+func getDocHeadsByField(
+	ctx context.Context,
+	txn datastore.Txn,
+	docID string,
+) (map[string]map[cid.Cid]uint64, error) {
+	prefix := core.HeadStoreKey{DocID: docID}
+	q, err := txn.Headstore().Query(ctx, query.Query{
+		Prefix: prefix.ToString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := q.Close(); err != nil {
+			log.ErrorContextE(ctx, "Failed to close heads query", err)
+		}
+	}()
+
+	heads := make(map[string]map[cid.Cid]uint64)
+	for res := range q.Next() {
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		key, err := core.NewHeadStoreKey(res.Key)
+		if err != nil {
+			return nil, err
+		}
+		height, n := binary.Uvarint(res.Value)
+		if n <= 0 {
+			return nil, NewErrFailedToGetHeads(errors.New("invalid head height"))
+		}
+		if _, ok := heads[key.FieldId]; !ok {
+			heads[key.FieldId] = make(map[cid.Cid]uint64)
+		}
+		heads[key.FieldId][key.Cid] = height
+	}
+	return heads, nil
+}
+
+func getBlock(ctx context.Context, txn datastore.Txn, blockCid cid.Cid) (*coreblock.Block, error) {
+	block, err := txn.Blockstore().Get(ctx, blockCid)
+	if err != nil {
+		return nil, err
+	}
+	return coreblock.GetFromBytes(block.RawData())
+}
+
+func getCompactionState(ctx context.Context, txn datastore.Txn, docID string) (compactionState, error) {
+	data, err := txn.Systemstore().Get(ctx, core.NewCompactionStateKey(docID).ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return compactionState{}, nil
+	}
+	if err != nil {
+		return compactionState{}, err
+	}
+	var state compactionState
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return compactionState{}, err
+	}
+	return state, nil
+}
+
+func setCompactionState(ctx context.Context, txn datastore.Txn, docID string, state compactionState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return txn.Systemstore().Put(ctx, core.NewCompactionStateKey(docID).ToDS(), data)
+}
+
+// getCompactionSnapshot returns the CID of the snapshot block of the given document,
+// if its history has been compacted.
+func getCompactionSnapshot(ctx context.Context, txn datastore.Txn, docID string) (cid.Cid, bool, error) {
+	data, err := txn.Systemstore().Get(ctx, core.NewCompactionSnapshotKey(docID).ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return cid.Cid{}, false, nil
+	}
+	if err != nil {
+		return cid.Cid{}, false, err
+	}
+	snapshot, err := cid.Cast(data)
+	if err != nil {
+		return cid.Cid{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// getRetentionPolicy returns the given policy, or the retention policy of the collection if none is given.
+func getRetentionPolicy(
+	col client.Collection,
+	policy immutable.Option[client.RetentionPolicy],
+) (client.RetentionPolicy, error) {
+	if policy.HasValue() {
+		return policy.Value(), nil
+	}
+	if col.Description().Retention.HasValue() {
+		return col.Description().Retention.Value(), nil
+	}
+	return client.RetentionPolicy{}, NewErrNoRetentionPolicy(col.Name().Value())
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestRetentionCutoff_WithDepth(t *testing.T) {
+	policy := client.RetentionPolicy{
+		Depth: immutable.Some[uint64](3),
+	}
+	now := time.Now()
+
+	assert.Equal(t, uint64(7), retentionCutoff(policy, nil, 10, now))
+	assert.Equal(t, uint64(0), retentionCutoff(policy, nil, 3, now))
+	assert.Equal(t, uint64(0), retentionCutoff(policy, nil, 1, now))
+}
+
+func TestRetentionCutoff_WithAge(t *testing.T) {
+	policy := client.RetentionPolicy{
+		Age: immutable.Some(time.Hour),
+	}
+	now := time.Now()
+	checkpoints := []compactionCheckpoint{
+		{Time: now.Add(-3 * time.Hour), Height: 2},
+		{Time: now.Add(-2 * time.Hour), Height: 5},
+		{Time: now.Add(-30 * time.Minute), Height: 8},
+	}
+
+	assert.Equal(t, uint64(5), retentionCutoff(policy, checkpoints, 10, now))
+	assert.Equal(t, uint64(0), retentionCutoff(policy, nil, 10, now))
+}
+
+func TestRetentionCutoff_WithDepthAndAge_RetainsWithinEither(t *testing.T) {
+	policy := client.RetentionPolicy{
+		Depth: immutable.Some[uint64](3),
+		Age:   immutable.Some(time.Hour),
+	}
+	now := time.Now()
+	checkpoints := []compactionCheckpoint{
+		{Time: now.Add(-2 * time.Hour), Height: 5},
+		{Time: now.Add(-2 * time.Hour), Height: 9},
+	}
+
+	assert.Equal(t, uint64(7), retentionCutoff(policy, checkpoints, 10, now))
+	assert.Equal(t, uint64(5), retentionCutoff(policy, checkpoints[:1], 10, now))
+}
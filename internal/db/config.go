@@ -11,14 +11,30 @@
 package db
 
 import (
+	"time"
+
 	"github.com/sourcenetwork/immutable"
 )
 
 const (
 	defaultMaxTxnRetries  = 5
 	updateEventBufferSize = 100
+	// defaultSortMemoryBudget is the default number of bytes of documents sorted in memory.
+	defaultSortMemoryBudget = 64 << 20
 )
 
+// defaultReplicatorRetryIntervals are the default intervals to wait for between the retries
+// of a replicator, doubling on every failed retry.
+var defaultReplicatorRetryIntervals = []time.Duration{
+	time.Second * 30,
+	time.Minute,
+	time.Minute * 2,
+	time.Minute * 4,
+	time.Minute * 8,
+	time.Minute * 16,
+	time.Minute * 32,
+}
+
 // Option is a funtion that sets a config value on the db.
 type Option func(*db)
 
@@ -28,3 +44,36 @@ func WithMaxRetries(num int) Option {
 		db.maxTxnRetries = immutable.Some(num)
 	}
 }
+
+// WithRetryInterval sets the intervals to wait for between the retries of a replicator
+// that documents failed to be pushed to.
+//
+// The n-th retry is attempted after the n-th interval, the last interval being used for
+// every retry beyond the given intervals. Empty intervals are ignored.
+func WithRetryInterval(interval []time.Duration) Option {
+	return func(db *db) {
+		if len(interval) > 0 {
+			db.retryIntervals = interval
+		}
+	}
+}
+
+// WithRequireSignedBlocks sets whether blocks received from peers must be signed.
+//
+// Blocks with an invalid signature are always rejected. If enabled, unsigned blocks
+// are rejected as well.
+func WithRequireSignedBlocks(require bool) Option {
+	return func(db *db) {
+		db.requireSignedBlocks = require
+	}
+}
+
+// WithSortMemoryBudget sets the approximate number of bytes of documents a request may
+// sort in memory. Beyond it, sorted runs are spilled to temporary files and merged.
+//
+// A budget of zero sorts everything in memory.
+func WithSortMemoryBudget(budget int) Option {
+	return func(db *db) {
+		db.sortMemoryBudget = budget
+	}
+}
@@ -23,9 +23,6 @@ import (
 // txnContextKey is the key type for transaction context values.
 type txnContextKey struct{}
 
-// identityContextKey is the key type for ACP identity context values.
-type identityContextKey struct{}
-
 // explicitTxn is a transaction that is managed outside of a db operation.
 type explicitTxn struct {
 	datastore.Txn
@@ -93,19 +90,12 @@ func SetContextTxn(ctx context.Context, txn datastore.Txn) context.Context {
 //
 // If an identity does not exist `NoIdentity` is returned.
 func GetContextIdentity(ctx context.Context) immutable.Option[acpIdentity.Identity] {
-	identity, ok := ctx.Value(identityContextKey{}).(acpIdentity.Identity)
-	if ok {
-		return immutable.Some(identity)
-	}
-	return acpIdentity.None
+	return acpIdentity.FromContext(ctx)
 }
 
 // SetContextTxn returns a new context with the identity value set.
 //
 // This will overwrite any previously set identity value.
 func SetContextIdentity(ctx context.Context, identity immutable.Option[acpIdentity.Identity]) context.Context {
-	if identity.HasValue() {
-		return context.WithValue(ctx, identityContextKey{}, identity.Value())
-	}
-	return context.WithValue(ctx, identityContextKey{}, nil)
+	return acpIdentity.WithContext(ctx, identity)
 }
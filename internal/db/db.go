@@ -18,7 +18,9 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/gofrs/uuid/v5"
 	ds "github.com/ipfs/go-datastore"
 	dsq "github.com/ipfs/go-datastore/query"
 
@@ -79,6 +81,28 @@ type db struct {
 	// The peer ID and network address information for the current node
 	// if network is enabled. The `atomic.Value` should hold a `peer.AddrInfo` struct.
 	peerInfo atomic.Value
+
+	// Ensures that concurrent merges of the same document are executed one at a time.
+	mergeQueue *mergeQueue
+
+	// The intervals to wait for between the retries of a replicator that
+	// documents failed to be pushed to.
+	retryIntervals []time.Duration
+	// Serializes the changes to the replicator retry state.
+	retryMutex sync.Mutex
+	// Contains the IDs of the replicators that are currently being retried.
+	retrying sync.Map
+
+	// If true, unsigned blocks received from peers are rejected.
+	requireSignedBlocks bool
+
+	// The approximate number of bytes of documents a request may sort in memory
+	// before spilling sorted runs to temporary files.
+	sortMemoryBudget int
+
+	// The ID of this database replica, used by the CRDTs that track the operations
+	// made by each replica. It is generated on first start and persisted.
+	replicaID string
 }
 
 // NewDB creates a new instance of the DB using the given options.
@@ -107,13 +131,16 @@ func newDB(
 	}
 
 	db := &db{
-		rootstore:    rootstore,
-		multistore:   multistore,
-		acp:          acp,
-		lensRegistry: lens,
-		parser:       parser,
-		options:      options,
-		events:       event.NewBus(commandBufferSize, eventBufferSize),
+		rootstore:        rootstore,
+		multistore:       multistore,
+		acp:              acp,
+		lensRegistry:     lens,
+		parser:           parser,
+		options:          options,
+		events:           event.NewBus(commandBufferSize, eventBufferSize),
+		mergeQueue:       newMergeQueue(),
+		retryIntervals:   defaultReplicatorRetryIntervals,
+		sortMemoryBudget: defaultSortMemoryBudget,
 	}
 
 	// apply options
@@ -130,7 +157,12 @@ func newDB(
 		return nil, err
 	}
 
-	sub, err := db.events.Subscribe(event.MergeName, event.PeerInfoName)
+	sub, err := db.events.Subscribe(
+		event.MergeName,
+		event.PeerInfoName,
+		event.ReplicatorFailureName,
+		event.ReplicatorConnectedName,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +228,122 @@ func (db *db) AddPolicy(
 	return client.AddPolicyResult{PolicyID: policyID}, nil
 }
 
+// AddDocActorRelationship creates a relationship between document and the target actor.
+func (db *db) AddDocActorRelationship(
+	ctx context.Context,
+	collectionName string,
+	docID string,
+	relation string,
+	targetActor string,
+) (client.AddDocActorRelationshipResult, error) {
+	if !db.acp.HasValue() {
+		return client.AddDocActorRelationshipResult{}, client.ErrACPOperationButACPNotAvailable
+	}
+
+	collection, err := db.GetCollectionByName(ctx, collectionName)
+	if err != nil {
+		return client.AddDocActorRelationshipResult{}, err
+	}
+
+	policy := collection.Definition().Description.Policy
+	if !policy.HasValue() || policy.Value().ID == "" || policy.Value().ResourceName == "" {
+		return client.AddDocActorRelationshipResult{}, client.ErrACPOperationButCollectionHasNoPolicy
+	}
+
+	exists, err := db.acp.Value().AddDocActorRelationship(
+		ctx,
+		policy.Value().ID,
+		policy.Value().ResourceName,
+		docID,
+		relation,
+		GetContextIdentity(ctx).Value(),
+		targetActor,
+	)
+	if err != nil {
+		return client.AddDocActorRelationshipResult{}, err
+	}
+
+	return client.AddDocActorRelationshipResult{ExistedAlready: exists}, nil
+}
+
+// DeleteDocActorRelationship deletes a relationship between document and the target actor.
+func (db *db) DeleteDocActorRelationship(
+	ctx context.Context,
+	collectionName string,
+	docID string,
+	relation string,
+	targetActor string,
+) (client.DeleteDocActorRelationshipResult, error) {
+	if !db.acp.HasValue() {
+		return client.DeleteDocActorRelationshipResult{}, client.ErrACPOperationButACPNotAvailable
+	}
+
+	collection, err := db.GetCollectionByName(ctx, collectionName)
+	if err != nil {
+		return client.DeleteDocActorRelationshipResult{}, err
+	}
+
+	policy := collection.Definition().Description.Policy
+	if !policy.HasValue() || policy.Value().ID == "" || policy.Value().ResourceName == "" {
+		return client.DeleteDocActorRelationshipResult{}, client.ErrACPOperationButCollectionHasNoPolicy
+	}
+
+	recordFound, err := db.acp.Value().DeleteDocActorRelationship(
+		ctx,
+		policy.Value().ID,
+		policy.Value().ResourceName,
+		docID,
+		relation,
+		GetContextIdentity(ctx).Value(),
+		targetActor,
+	)
+	if err != nil {
+		return client.DeleteDocActorRelationshipResult{}, err
+	}
+
+	return client.DeleteDocActorRelationshipResult{RecordFound: recordFound}, nil
+}
+
+// CompactCollection collapses the history of the documents in the given collection that is older
+// than the retention policy into a snapshot block, and removes the unreachable blocks.
+func (db *db) CompactCollection(
+	ctx context.Context,
+	collectionName string,
+	policy immutable.Option[client.RetentionPolicy],
+) (client.CompactionResult, error) {
+	ctx, txn, err := ensureContextTxn(ctx, db, false)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	defer txn.Discard(ctx)
+
+	col, err := db.GetCollectionByName(ctx, collectionName)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+
+	retention, err := getRetentionPolicy(col, policy)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	err = validateRetentionPolicy(collectionName, retention)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+
+	result, err := db.compactCollection(ctx, col, retention)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+
+	err = txn.Commit(ctx)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+
+	return result, nil
+}
+
 // Initialize is called when a database is first run and creates all the db global meta data
 // like Collection ID counters.
 func (db *db) initialize(ctx context.Context) error {
@@ -216,6 +364,11 @@ func (db *db) initialize(ctx context.Context) error {
 		}
 	}
 
+	err = db.loadReplicaID(ctx)
+	if err != nil {
+		return err
+	}
+
 	exists, err := txn.Systemstore().Has(ctx, ds.NewKey("init"))
 	if err != nil && !errors.Is(err, ds.ErrNotFound) {
 		return err
@@ -254,6 +407,31 @@ func (db *db) initialize(ctx context.Context) error {
 	return txn.Commit(ctx)
 }
 
+// loadReplicaID loads the ID of this replica from the system store,
+// generating and storing a new one if it does not exist.
+func (db *db) loadReplicaID(ctx context.Context) error {
+	txn := mustGetContextTxn(ctx)
+	key := ds.NewKey(core.REPLICA_ID)
+	id, err := txn.Systemstore().Get(ctx, key)
+	if err == nil {
+		db.replicaID = string(id)
+		return nil
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	newID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	err = txn.Systemstore().Put(ctx, key, []byte(newID.String()))
+	if err != nil {
+		return err
+	}
+	db.replicaID = newID.String()
+	return nil
+}
+
 // Events returns the events Channel.
 func (db *db) Events() *event.Bus {
 	return db.events
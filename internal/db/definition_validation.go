@@ -13,6 +13,7 @@ package db
 import (
 	"context"
 	"reflect"
+	"strings"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
@@ -166,6 +167,7 @@ var globalValidators = []definitionValidator{
 	validateSelfReferences,
 	validateCollectionMaterialized,
 	validateMaterializedHasNoPolicy,
+	validateCollectionRetention,
 }
 
 var createValidators = append(
@@ -727,15 +729,14 @@ func validateSchemaFieldNotDeleted(
 		oldSchema := oldState.schemaByName[newSchema.Name]
 
 		for _, oldField := range oldSchema.Fields {
-			stillExists := false
-			for _, newField := range newSchema.Fields {
-				if newField.Name == oldField.Name {
-					stillExists = true
-					break
-				}
+			_, stillExists := newSchema.GetFieldByName(oldField.Name)
+			if stillExists {
+				continue
 			}
 
-			if !stillExists {
+			// Removing relation fields would leave the other side of the relation dangling, and
+			// the docID field is required by every schema.
+			if oldField.Name == request.DocIDFieldName || isRelationSchemaField(oldSchema, oldField) {
 				return NewErrCannotDeleteField(oldField.Name)
 			}
 		}
@@ -744,6 +745,21 @@ func validateSchemaFieldNotDeleted(
 	return nil
 }
 
+// isRelationSchemaField returns true if the given field is a relation object field, or
+// the id field of one.
+func isRelationSchemaField(schema client.SchemaDescription, field client.SchemaFieldDescription) bool {
+	if field.Kind.IsObject() {
+		return true
+	}
+
+	objectFieldName, isIDField := strings.CutSuffix(field.Name, request.RelatedObjectID)
+	if !isIDField {
+		return false
+	}
+	objectField, ok := schema.GetFieldByName(objectFieldName)
+	return ok && objectField.Kind.IsObject()
+}
+
 func validateTypeAndKindCompatible(
 	ctx context.Context,
 	db *db,
@@ -785,13 +801,16 @@ func validateFieldNotMoved(
 	oldState *definitionState,
 ) error {
 	for _, oldSchema := range oldState.schemaByName {
+		newSchema := newState.schemaByName[oldSchema.Name]
+
+		// Fields removed by the update do not count towards the index of the remaining ones.
 		oldFieldIndexesByName := map[string]int{}
-		for i, field := range oldSchema.Fields {
-			oldFieldIndexesByName[field.Name] = i
+		for _, field := range oldSchema.Fields {
+			if _, stillExists := newSchema.GetFieldByName(field.Name); stillExists {
+				oldFieldIndexesByName[field.Name] = len(oldFieldIndexesByName)
+			}
 		}
 
-		newSchema := newState.schemaByName[oldSchema.Name]
-
 		for newIndex, newField := range newSchema.Fields {
 			if existingIndex, exists := oldFieldIndexesByName[newField.Name]; exists && newIndex != existingIndex {
 				return NewErrCannotMoveField(newField.Name, newIndex, existingIndex)
@@ -818,15 +837,89 @@ func validateFieldNotMutated(
 
 		for _, newField := range newSchema.Fields {
 			oldField, exists := oldFieldsByName[newField.Name]
-			if exists && oldField != newField {
+			if !exists || oldField == newField {
+				continue
+			}
+
+			if oldField.Typ != newField.Typ || !isKindChangeSupported(oldField.Kind, newField.Kind) {
 				return NewErrCannotMutateField(newField.Name)
 			}
+
+			if !isLosslessKindConversion(oldField.Kind, newField.Kind) && !hasMigration(newState, newSchema) {
+				return NewErrFieldKindChangeRequiresMigration(newField.Name, oldField.Kind, newField.Kind)
+			}
 		}
 	}
 
 	return nil
 }
 
+// isKindChangeSupported returns true if a field of the given existing kind may be changed to the
+// proposed kind, either directly or through a migration.
+//
+// Only the kinds of scalar fields may be changed.
+func isKindChangeSupported(existingKind client.FieldKind, proposedKind client.FieldKind) bool {
+	if existingKind == nil || proposedKind == nil {
+		return false
+	}
+	return !existingKind.IsObject() &&
+		!proposedKind.IsObject() &&
+		existingKind != client.FieldKind_DocID &&
+		proposedKind != client.FieldKind_DocID &&
+		proposedKind != client.FieldKind_None
+}
+
+// isLosslessKindConversion returns true if values of the existing kind can be read as values of
+// the proposed kind without loss, and thus without the need for a migration.
+func isLosslessKindConversion(existingKind client.FieldKind, proposedKind client.FieldKind) bool {
+	switch existingKind {
+	case client.FieldKind_NILLABLE_INT:
+		return proposedKind == client.FieldKind_NILLABLE_FLOAT
+
+	case client.FieldKind_INT_ARRAY:
+		return proposedKind == client.FieldKind_NILLABLE_INT_ARRAY ||
+			proposedKind == client.FieldKind_FLOAT_ARRAY ||
+			proposedKind == client.FieldKind_NILLABLE_FLOAT_ARRAY
+
+	case client.FieldKind_NILLABLE_INT_ARRAY:
+		return proposedKind == client.FieldKind_NILLABLE_FLOAT_ARRAY
+
+	case client.FieldKind_BOOL_ARRAY:
+		return proposedKind == client.FieldKind_NILLABLE_BOOL_ARRAY
+
+	case client.FieldKind_FLOAT_ARRAY:
+		return proposedKind == client.FieldKind_NILLABLE_FLOAT_ARRAY
+
+	case client.FieldKind_STRING_ARRAY:
+		return proposedKind == client.FieldKind_NILLABLE_STRING_ARRAY
+
+	default:
+		return false
+	}
+}
+
+// hasMigration returns true if all the collections using the given schema version have a
+// migration from their source collection.
+func hasMigration(state *definitionState, schema client.SchemaDescription) bool {
+	for _, col := range state.collections {
+		if col.SchemaVersionID != schema.VersionID {
+			continue
+		}
+
+		sources := col.CollectionSources()
+		if len(sources) == 0 {
+			return false
+		}
+		for _, source := range sources {
+			if !source.Transform.HasValue() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func validateFieldNotDuplicated(
 	ctx context.Context,
 	db *db,
@@ -1016,3 +1109,41 @@ func validateMaterializedHasNoPolicy(
 
 	return nil
 }
+
+// validateCollectionRetention verifies that the retention policies of the collections are valid,
+// and that no view has one.
+func validateCollectionRetention(
+	ctx context.Context,
+	db *db,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	for _, col := range newState.collections {
+		if !col.Retention.HasValue() {
+			continue
+		}
+		if len(col.QuerySources()) != 0 {
+			return NewErrRetentionOnView(col.Name.Value())
+		}
+		err := validateRetentionPolicy(col.Name.Value(), col.Retention.Value())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateRetentionPolicy verifies that the given retention policy has a valid limit.
+func validateRetentionPolicy(collectionName string, policy client.RetentionPolicy) error {
+	if !policy.Depth.HasValue() && !policy.Age.HasValue() {
+		return NewErrInvalidRetentionPolicy(collectionName, "either a depth or an age must be set")
+	}
+	if policy.Depth.HasValue() && policy.Depth.Value() == 0 {
+		return NewErrInvalidRetentionPolicy(collectionName, "depth must be greater than zero")
+	}
+	if policy.Age.HasValue() && policy.Age.Value() < 0 {
+		return NewErrInvalidRetentionPolicy(collectionName, "age can not be negative")
+	}
+	return nil
+}
@@ -38,7 +38,8 @@ const (
 	errDuplicateField                           string = "duplicate field"
 	errCannotMutateField                        string = "mutating an existing field is not supported"
 	errCannotMoveField                          string = "moving fields is not currently supported"
-	errCannotDeleteField                        string = "deleting an existing field is not supported"
+	errCannotDeleteField                        string = "deleting a built-in or relation field is not supported"
+	errFieldKindChangeRequiresMigration         string = "changing the kind of a field requires a migration"
 	errFieldKindNotFound                        string = "no type found for given name"
 	errFieldKindDoesNotMatchFieldSchema         string = "field Kind does not match field Schema"
 	errDocumentAlreadyExists                    string = "a document with the given ID already exists"
@@ -63,6 +64,11 @@ const (
 	errInvalidFieldValue                        string = "invalid field value"
 	errUnsupportedIndexFieldType                string = "unsupported index field type"
 	errIndexDescriptionHasNoFields              string = "index description has no fields"
+	errUnknownIndexType                         string = "unknown index type"
+	errFullTextIndexMustHaveOneField            string = "full-text index must have exactly one field"
+	errFullTextIndexFieldNotString              string = "full-text index field must be a String"
+	errFullTextIndexCanNotBeUnique              string = "full-text index can not be unique"
+	errIndexAnalyzerRequiresFullText            string = "index analyzer can only be set on a full-text index"
 	errFieldOrAliasToFieldNotExist              string = "The given field or alias to field does not exist"
 	errCreateFile                               string = "failed to create file"
 	errRemoveFile                               string = "failed to remove file"
@@ -99,11 +105,19 @@ const (
 	errReplicatorExists                         string = "replicator already exists for %s with peerID %s"
 	errReplicatorDocID                          string = "failed to get docID for replicator"
 	errReplicatorCollections                    string = "failed to get collections for replicator"
+	errP2PSyncCollections                       string = "failed to get collections for p2p sync"
 	errReplicatorNotFound                       string = "replicator not found"
 	errCanNotEncryptBuiltinField                string = "can not encrypt build-in field"
 	errSelfReferenceWithoutSelf                 string = "must specify 'Self' kind for self referencing relations"
 	errColNotMaterialized                       string = "non-materialized collections are not supported"
 	errMaterializedViewAndACPNotSupported       string = "materialized views do not support ACP"
+	errMergeBlockSignature                      string = "failed to verify the signature of a merged block"
+	errCanNotCompactView                        string = "views do not have a history to compact"
+	errNoRetentionPolicy                        string = "no retention policy given and the collection has none"
+	errInvalidRetentionPolicy                   string = "invalid retention policy"
+	errRetentionOnView                          string = "views do not support retention policies"
+	errDocumentPurged                           string = "a document with the given ID has been purged"
+	errCanNotPurgeView                          string = "documents of a view can not be purged"
 )
 
 var (
@@ -120,6 +134,9 @@ var (
 	ErrIndexMissingFields                       = errors.New(errIndexMissingFields)
 	ErrIndexFieldMissingName                    = errors.New(errIndexFieldMissingName)
 	ErrCorruptedIndex                           = errors.New(errCorruptedIndex)
+	ErrFullTextIndexMustHaveOneField            = errors.New(errFullTextIndexMustHaveOneField)
+	ErrFullTextIndexCanNotBeUnique              = errors.New(errFullTextIndexCanNotBeUnique)
+	ErrIndexAnalyzerRequiresFullText            = errors.New(errIndexAnalyzerRequiresFullText)
 	ErrExpectedJSONObject                       = errors.New(errExpectedJSONObject)
 	ErrExpectedJSONArray                        = errors.New(errExpectedJSONArray)
 	ErrInvalidViewQuery                         = errors.New(errInvalidViewQuery)
@@ -141,12 +158,23 @@ var (
 	ErrNoTransactionInContext                   = errors.New(errNoTransactionInContext)
 	ErrReplicatorColHasPolicy                   = errors.New("replicator collection specified has a policy on it")
 	ErrSelfTargetForReplicator                  = errors.New("can't target ourselves as a replicator")
+	ErrSelfTargetForP2PSync                     = errors.New("can't sync collections from ourselves")
+	ErrP2PSyncColHasPolicy                      = errors.New("p2p sync collection specified has a policy on it")
+	ErrP2PNotEnabled                            = errors.New("p2p networking is not enabled")
+	ErrP2PSyncCollections                       = errors.New(errP2PSyncCollections)
 	ErrReplicatorCollections                    = errors.New(errReplicatorCollections)
 	ErrReplicatorNotFound                       = errors.New(errReplicatorNotFound)
+	ErrMergeBlockSignature                      = errors.New(errMergeBlockSignature)
 	ErrCanNotEncryptBuiltinField                = errors.New(errCanNotEncryptBuiltinField)
 	ErrSelfReferenceWithoutSelf                 = errors.New(errSelfReferenceWithoutSelf)
 	ErrColNotMaterialized                       = errors.New(errColNotMaterialized)
 	ErrMaterializedViewAndACPNotSupported       = errors.New(errMaterializedViewAndACPNotSupported)
+	ErrCanNotCompactView                        = errors.New(errCanNotCompactView)
+	ErrNoRetentionPolicy                        = errors.New(errNoRetentionPolicy)
+	ErrInvalidRetentionPolicy                   = errors.New(errInvalidRetentionPolicy)
+	ErrRetentionOnView                          = errors.New(errRetentionOnView)
+	ErrDocumentPurged                           = errors.New(errDocumentPurged)
+	ErrCanNotPurgeView                          = errors.New(errCanNotPurgeView)
 )
 
 // NewErrFailedToGetHeads returns a new error indicating that the heads of a document
@@ -348,6 +376,19 @@ func NewErrCannotDeleteField(name string) error {
 	)
 }
 
+func NewErrFieldKindChangeRequiresMigration(
+	name string,
+	existingKind client.FieldKind,
+	proposedKind client.FieldKind,
+) error {
+	return errors.New(
+		errFieldKindChangeRequiresMigration,
+		errors.NewKV("Name", name),
+		errors.NewKV("ExistingKind", existingKind),
+		errors.NewKV("ProposedKind", proposedKind),
+	)
+}
+
 func NewErrDocumentAlreadyExists(docID string) error {
 	return errors.New(
 		errDocumentAlreadyExists,
@@ -426,6 +467,24 @@ func NewErrUnsupportedIndexFieldType(kind client.FieldKind) error {
 	)
 }
 
+// NewErrUnknownIndexType returns a new error indicating that the given index type is not known.
+func NewErrUnknownIndexType(indexType client.IndexType) error {
+	return errors.New(
+		errUnknownIndexType,
+		errors.NewKV("Type", indexType),
+	)
+}
+
+// NewErrFullTextIndexFieldNotString returns a new error indicating that the field of a
+// full-text index is not a String.
+func NewErrFullTextIndexFieldNotString(fieldName string, kind client.FieldKind) error {
+	return errors.New(
+		errFullTextIndexFieldNotString,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Kind", kind),
+	)
+}
+
 // NewErrIndexDescHasNoFields returns a new error indicating that the given index
 // description has no fields.
 func NewErrIndexDescHasNoFields(desc client.IndexDescription) error {
@@ -657,6 +716,14 @@ func NewErrReplicatorCollections(inner error, kv ...errors.KV) error {
 	return errors.Wrap(errReplicatorCollections, inner, kv...)
 }
 
+func NewErrP2PSyncCollections(inner error, kv ...errors.KV) error {
+	return errors.Wrap(errP2PSyncCollections, inner, kv...)
+}
+
+func NewErrMergeBlockSignature(inner error, kv ...errors.KV) error {
+	return errors.Wrap(errMergeBlockSignature, inner, kv...)
+}
+
 func NewErrSelfReferenceWithoutSelf(fieldName string) error {
 	return errors.New(
 		errSelfReferenceWithoutSelf,
@@ -677,3 +744,42 @@ func NewErrMaterializedViewAndACPNotSupported(collection string) error {
 		errors.NewKV("Collection", collection),
 	)
 }
+
+// NewErrCanNotCompactView returns a new error indicating that the given collection can not
+// be compacted as it is a view.
+func NewErrCanNotCompactView(collectionName string) error {
+	return errors.New(errCanNotCompactView, errors.NewKV("Collection", collectionName))
+}
+
+// NewErrNoRetentionPolicy returns a new error indicating that the given collection was
+// compacted without a retention policy.
+func NewErrNoRetentionPolicy(collectionName string) error {
+	return errors.New(errNoRetentionPolicy, errors.NewKV("Collection", collectionName))
+}
+
+// NewErrInvalidRetentionPolicy returns a new error indicating that the retention policy of the
+// given collection is invalid.
+func NewErrInvalidRetentionPolicy(collectionName string, reason string) error {
+	return errors.New(
+		errInvalidRetentionPolicy,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Reason", reason),
+	)
+}
+
+// NewErrRetentionOnView returns a new error indicating that a retention policy was set on a view.
+func NewErrRetentionOnView(collectionName string) error {
+	return errors.New(errRetentionOnView, errors.NewKV("Collection", collectionName))
+}
+
+// NewErrDocumentPurged returns a new error indicating that the document with the given ID
+// has been purged.
+func NewErrDocumentPurged(docID string) error {
+	return errors.New(errDocumentPurged, errors.NewKV("DocID", docID))
+}
+
+// NewErrCanNotPurgeView returns a new error indicating that the documents of the given
+// collection can not be purged as it is a view.
+func NewErrCanNotPurgeView(collectionName string) error {
+	return errors.New(errCanNotPurgeView, errors.NewKV("Collection", collectionName))
+}
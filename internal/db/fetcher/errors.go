@@ -13,6 +13,8 @@ package fetcher
 import (
 	"fmt"
 
+	"github.com/ipfs/go-cid"
+
 	"github.com/sourcenetwork/defradb/errors"
 )
 
@@ -31,6 +33,8 @@ const (
 	errInvalidInOperatorValue       string = "invalid _in/_nin value"
 	errInvalidFilterOperator        string = "invalid filter operator is provided"
 	errUnexpectedTypeValue          string = "unexpected type value"
+	errVersionCompacted             string = "the requested version has been removed by a compaction"
+	errCompactedNonLWWField         string = "the compacted history of a non-LWW field can not be replayed"
 )
 
 var (
@@ -49,6 +53,8 @@ var (
 	ErrInvalidInOperatorValue       = errors.New(errInvalidInOperatorValue)
 	ErrInvalidFilterOperator        = errors.New(errInvalidFilterOperator)
 	ErrUnexpectedTypeValue          = errors.New(errUnexpectedTypeValue)
+	ErrVersionCompacted             = errors.New(errVersionCompacted)
+	ErrCompactedNonLWWField         = errors.New(errCompactedNonLWWField)
 )
 
 // NewErrFieldIdNotFound returns an error indicating that the given FieldId was not found.
@@ -112,3 +118,15 @@ func NewErrUnexpectedTypeValue[T any](value any) error {
 	var t T
 	return errors.New(errUnexpectedTypeValue, errors.NewKV("Value", value), errors.NewKV("Type", fmt.Sprintf("%T", t)))
 }
+
+// NewErrVersionCompacted returns an error indicating that the given version has been removed
+// by a compaction.
+func NewErrVersionCompacted(version cid.Cid) error {
+	return errors.New(errVersionCompacted, errors.NewKV("Version", version))
+}
+
+// NewErrCompactedNonLWWField returns an error indicating that the compacted history of the given
+// field can not be replayed, as its value depends on the whole history.
+func NewErrCompactedNonLWWField(fieldName string) error {
+	return errors.New(errCompactedNonLWWField, errors.NewKV("Field", fieldName))
+}
@@ -38,6 +38,8 @@ type IndexFetcher struct {
 	indexDesc     client.IndexDescription
 	indexIter     indexIterator
 	execInfo      ExecInfo
+	// If true, the index keys are iterated in reverse order.
+	reverse bool
 }
 
 var _ Fetcher = (*IndexFetcher)(nil)
@@ -73,6 +75,7 @@ func (f *IndexFetcher) Init(
 	f.doc = &encodedDocument{}
 	f.mapping = docMapper
 	f.txn = txn
+	f.reverse = reverse
 
 	for _, indexedField := range f.indexDesc.Fields {
 		field, ok := f.col.Definition().GetFieldByName(indexedField.Name)
@@ -98,8 +101,10 @@ outer:
 	}
 	f.indexIter = iter
 
-	// if it turns out that we can't use the index, we need to fall back to the document fetcher
-	if f.indexIter == nil {
+	// if it turns out that we can't use the index, we need to fall back to the document fetcher.
+	// Full-text indexes only hold the tokens of the indexed field, so its value must also be
+	// fetched from the document.
+	if f.indexIter == nil || f.indexDesc.IsFullText() {
 		f.docFields = fields
 	}
 
@@ -148,24 +153,27 @@ func (f *IndexFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo
 		}
 
 		hasNilField := false
-		for i, indexedField := range f.indexedFields {
-			property := &encProperty{Desc: indexedField}
-
-			field := res.key.Fields[i]
-			if field.Value.IsNil() {
-				hasNilField = true
+		// full-text index keys hold the tokens of the indexed field instead of its value
+		if !f.indexDesc.IsFullText() {
+			for i, indexedField := range f.indexedFields {
+				property := &encProperty{Desc: indexedField}
+
+				field := res.key.Fields[i]
+				if field.Value.IsNil() {
+					hasNilField = true
+				}
+
+				// We need to convert it to cbor bytes as this is what it will be encoded from on value retrieval.
+				// In the future we have to either get rid of CBOR or properly handle different encoding
+				// for properties in a single document.
+				fieldBytes, err := client.NewFieldValue(client.NONE_CRDT, field.Value).Bytes()
+				if err != nil {
+					return nil, ExecInfo{}, err
+				}
+				property.Raw = fieldBytes
+
+				f.doc.properties[indexedField] = property
 			}
-
-			// We need to convert it to cbor bytes as this is what it will be encoded from on value retrieval.
-			// In the future we have to either get rid of CBOR or properly handle different encoding
-			// for properties in a single document.
-			fieldBytes, err := client.NewFieldValue(client.NONE_CRDT, field.Value).Bytes()
-			if err != nil {
-				return nil, ExecInfo{}, err
-			}
-			property.Raw = fieldBytes
-
-			f.doc.properties[indexedField] = property
 		}
 
 		if f.indexDesc.Unique && !hasNilField {
@@ -11,17 +11,22 @@
 package fetcher
 
 import (
+	"bytes"
 	"cmp"
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
 	ds "github.com/ipfs/go-datastore"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/datastore/iterable"
 	"github.com/sourcenetwork/defradb/internal/connor"
+	"github.com/sourcenetwork/defradb/internal/connor/fulltext"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
 
@@ -41,6 +46,7 @@ const (
 	opNlike  = "_nlike"
 	opILike  = "_ilike"
 	opNILike = "_nilike"
+	opSearch = "_search"
 	// it's just there for composite indexes. We construct a slice of value matchers with
 	// every matcher being responsible for a corresponding field in the index to match.
 	// For some fields there might not be any criteria to match. For examples if you have
@@ -65,17 +71,30 @@ type indexIterResult struct {
 }
 
 // indexPrefixIterator is an iterator over index keys with a specific prefix.
+//
+// If a range is set, only the keys from its start key to its end key are iterated.
+// If reverse is set, the keys are iterated from the last to the first.
 type indexPrefixIterator struct {
 	indexDesc     client.IndexDescription
 	indexedFields []client.FieldDefinition
 	indexKey      core.IndexDataStoreKey
+	keyRange      immutable.Option[indexKeyRange]
+	reverse       bool
 	matchers      []valueMatcher
 	execInfo      *ExecInfo
+	kvIter        iterable.Iterator
 	resultIter    query.Results
 	ctx           context.Context
 	store         datastore.DSReaderWriter
 }
 
+// indexKeyRange is the range of index keys, from start to end inclusive, that can
+// match the range conditions on an indexed field.
+type indexKeyRange struct {
+	start []byte
+	end   []byte
+}
+
 var _ indexIterator = (*indexPrefixIterator)(nil)
 
 func (iter *indexPrefixIterator) Init(ctx context.Context, store datastore.DSReaderWriter) error {
@@ -87,11 +106,20 @@ func (iter *indexPrefixIterator) Init(ctx context.Context, store datastore.DSRea
 		}
 	}
 	iter.resultIter = nil
+	if iter.kvIter != nil {
+		if err := iter.kvIter.Close(); err != nil {
+			return err
+		}
+	}
+	iter.kvIter = nil
 	return nil
 }
 
 func (iter *indexPrefixIterator) checkResultIterator() error {
-	if iter.resultIter == nil {
+	if iter.resultIter != nil {
+		return nil
+	}
+	if !iter.keyRange.HasValue() && !iter.reverse {
 		resultIter, err := iter.store.Query(iter.ctx, query.Query{
 			Prefix: iter.indexKey.ToString(),
 		})
@@ -99,7 +127,36 @@ func (iter *indexPrefixIterator) checkResultIterator() error {
 			return err
 		}
 		iter.resultIter = resultIter
+		return nil
 	}
+	if iter.kvIter == nil {
+		var order query.Order = query.OrderByKey{}
+		if iter.reverse {
+			order = query.OrderByKeyDescending{}
+		}
+		kvIter, err := iter.store.GetIterator(query.Query{Orders: []query.Order{order}})
+		if err != nil {
+			return err
+		}
+		iter.kvIter = kvIter
+	}
+	var keyRange indexKeyRange
+	if iter.keyRange.HasValue() {
+		keyRange = iter.keyRange.Value()
+	} else {
+		prefix := iter.indexKey.Bytes()
+		keyRange = indexKeyRange{start: prefix, end: bytesPrefixEnd(prefix)}
+	}
+	start, end := newRangeStartKey(keyRange.start), newRangeEndKey(keyRange.end)
+	if iter.reverse {
+		// a reversed iteration starts from the last key of the range
+		start, end = end, start
+	}
+	resultIter, err := iter.kvIter.IteratePrefix(iter.ctx, start, end)
+	if err != nil {
+		return err
+	}
+	iter.resultIter = resultIter
 	return nil
 }
 
@@ -141,7 +198,54 @@ func (iter *indexPrefixIterator) Next() (indexIterResult, error) {
 }
 
 func (iter *indexPrefixIterator) Close() error {
-	return iter.resultIter.Close()
+	if iter.resultIter != nil {
+		if err := iter.resultIter.Close(); err != nil {
+			return err
+		}
+	}
+	if iter.kvIter != nil {
+		err := iter.kvIter.Close()
+		iter.kvIter = nil
+		return err
+	}
+	return nil
+}
+
+// newRangeStartKey returns the datastore key to start iterating a key range from.
+//
+// Datastore keys can not end with a separator, in which case the key is shortened,
+// which can only add keys to the range. The value matchers filter them out.
+func newRangeStartKey(key []byte) ds.Key {
+	for len(key) > 1 && key[len(key)-1] == '/' {
+		key = key[:len(key)-1]
+	}
+	return ds.RawKey(string(key))
+}
+
+// newRangeEndKey returns the datastore key to stop iterating a key range at.
+//
+// Datastore keys can not end with a separator, in which case the key is extended,
+// which can only add keys to the range. The value matchers filter them out.
+func newRangeEndKey(key []byte) ds.Key {
+	if len(key) > 1 && key[len(key)-1] == '/' {
+		key = append(slices.Clone(key), 0xff)
+	}
+	return ds.RawKey(string(key))
+}
+
+// bytesPrefixEnd returns the smallest byte string that sorts after all byte strings
+// with the given prefix.
+func bytesPrefixEnd(b []byte) []byte {
+	end := slices.Clone(b)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i] = end[i] + 1
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	// This statement will only be reached if the key is already a
+	// maximal byte string (i.e. already \xff...).
+	return b
 }
 
 type eqSingleIndexIterator struct {
@@ -243,6 +347,127 @@ func (iter *inIndexIterator) Close() error {
 	return nil
 }
 
+// fullTextIndexIterator is an iterator over the documents of a full-text index that
+// contain all the terms of a search.
+//
+// Documents are returned ordered by their ID.
+type fullTextIndexIterator struct {
+	indexKey core.IndexDataStoreKey
+	terms    []string
+	execInfo *ExecInfo
+
+	docIDs []string
+	loaded bool
+
+	ctx   context.Context
+	store datastore.DSReaderWriter
+}
+
+var _ indexIterator = (*fullTextIndexIterator)(nil)
+
+func (iter *fullTextIndexIterator) Init(ctx context.Context, store datastore.DSReaderWriter) error {
+	iter.ctx = ctx
+	iter.store = store
+	iter.docIDs = nil
+	iter.loaded = false
+	return nil
+}
+
+// fetchTermDocIDs returns the IDs of all documents containing the given term.
+func (iter *fullTextIndexIterator) fetchTermDocIDs(term string) (map[string]struct{}, error) {
+	key := iter.indexKey
+	key.Fields = []core.IndexedField{{Value: client.NewNormalString(term)}}
+	results, err := iter.store.Query(iter.ctx, query.Query{
+		Prefix:   key.ToString(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	docIDs := map[string]struct{}{}
+	for {
+		res, hasVal := results.NextSync()
+		if !hasVal {
+			break
+		}
+		if res.Error != nil {
+			return nil, errors.Join(res.Error, results.Close())
+		}
+		iter.execInfo.IndexesFetched++
+		docID, err := decodeFullTextIndexDocID(res.Key)
+		if err != nil {
+			return nil, errors.Join(err, results.Close())
+		}
+		docIDs[docID] = struct{}{}
+	}
+	return docIDs, results.Close()
+}
+
+func (iter *fullTextIndexIterator) load() error {
+	iter.loaded = true
+	var docIDs map[string]struct{}
+	for _, term := range iter.terms {
+		termDocIDs, err := iter.fetchTermDocIDs(term)
+		if err != nil {
+			return err
+		}
+		if docIDs == nil {
+			docIDs = termDocIDs
+			continue
+		}
+		for docID := range docIDs {
+			if _, ok := termDocIDs[docID]; !ok {
+				delete(docIDs, docID)
+			}
+		}
+	}
+	for docID := range docIDs {
+		iter.docIDs = append(iter.docIDs, docID)
+	}
+	slices.Sort(iter.docIDs)
+	return nil
+}
+
+func (iter *fullTextIndexIterator) Next() (indexIterResult, error) {
+	if !iter.loaded {
+		if err := iter.load(); err != nil {
+			return indexIterResult{}, err
+		}
+	}
+	if len(iter.docIDs) == 0 {
+		return indexIterResult{}, nil
+	}
+	key := iter.indexKey
+	key.Fields = []core.IndexedField{{Value: client.NewNormalString(iter.docIDs[0])}}
+	iter.docIDs = iter.docIDs[1:]
+	return indexIterResult{key: key, foundKey: true}, nil
+}
+
+func (iter *fullTextIndexIterator) Close() error {
+	return nil
+}
+
+// decodeFullTextIndexDocID returns the document ID held by the given full-text index key.
+func decodeFullTextIndexDocID(key string) (string, error) {
+	// the token and document ID are both strings, so the key can be decoded
+	// as if it was a key of a single string field index.
+	desc := client.IndexDescription{Fields: []client.IndexedFieldDescription{{}}}
+	fields := []client.FieldDefinition{{Kind: client.FieldKind_NILLABLE_STRING}}
+	indexKey, err := core.DecodeIndexDataStoreKey([]byte(key), &desc, fields)
+	if err != nil {
+		return "", err
+	}
+	if len(indexKey.Fields) != 2 {
+		return "", core.ErrInvalidKey
+	}
+	docID, ok := indexKey.Fields[1].Value.String()
+	if !ok {
+		return "", core.ErrInvalidKey
+	}
+	return docID, nil
+}
+
 func executeValueMatchers(matchers []valueMatcher, fields []core.IndexedField) (bool, error) {
 	for i := range matchers {
 		res, err := matchers[i].Match(fields[i].Value)
@@ -442,12 +667,30 @@ func (m *indexLikeMatcher) doesMatch(currentVal string) bool {
 	}
 }
 
+// allMatcher matches a value if all of its matchers match it.
+type allMatcher struct {
+	matchers []valueMatcher
+}
+
+func (m *allMatcher) Match(value client.NormalValue) (bool, error) {
+	for _, matcher := range m.matchers {
+		didMatch, err := matcher.Match(value)
+		if err != nil || !didMatch {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
 type anyMatcher struct{}
 
 func (m *anyMatcher) Match(client.NormalValue) (bool, error) { return true, nil }
 
 // newPrefixIndexIterator creates a new eqPrefixIndexIterator for fetching indexed data.
 // It can modify the input matchers slice.
+//
+// If the field following the prefix has range conditions, only the keys within the range
+// are iterated.
 func (f *IndexFetcher) newPrefixIndexIterator(
 	fieldConditions []fieldFilterCond,
 	matchers []valueMatcher,
@@ -465,13 +708,101 @@ func (f *IndexFetcher) newPrefixIndexIterator(
 
 	// iterators for _eq filter already iterate over keys with first field value
 	// matching the filter value, so we can skip the first matcher
-	if len(matchers) > 1 {
+	if len(keyFieldValues) > 0 && len(fieldConditions[0].andConds) == 0 && len(matchers) > 1 {
 		matchers[0] = &anyMatcher{}
 	}
 
 	key := f.newIndexDataStoreKeyWithValues(keyFieldValues)
 
-	return f.newQueryResultIterator(key, matchers, &f.execInfo), nil
+	iter := f.newQueryResultIterator(key, matchers, &f.execInfo)
+	if len(keyFieldValues) < len(fieldConditions) {
+		iter.keyRange = f.newIndexKeyRange(key, fieldConditions[len(keyFieldValues)])
+	}
+	return iter, nil
+}
+
+// newIndexKeyRange returns the range of keys with the given prefix that can match the range
+// conditions of the given field condition, which must be on the field following the prefix.
+//
+// It returns none if the condition does not narrow the range.
+// As the range is inclusive of nil values, the value matchers still have to be executed on
+// the iterated keys.
+func (f *IndexFetcher) newIndexKeyRange(
+	prefix core.IndexDataStoreKey,
+	condition fieldFilterCond,
+) immutable.Option[indexKeyRange] {
+	prefixBytes := prefix.Bytes()
+	keyRange := indexKeyRange{start: prefixBytes, end: bytesPrefixEnd(prefixBytes)}
+	isNarrowed := false
+
+	descending := f.indexDesc.Fields[len(prefix.Fields)].Descending
+	for _, cond := range append([]fieldFilterCond{condition}, condition.andConds...) {
+		if cond.val.IsNil() {
+			continue
+		}
+		valueKey := prefix
+		valueKey.Fields = append(slices.Clone(prefix.Fields), core.IndexedField{
+			Value:      cond.val,
+			Descending: descending,
+		})
+		// all keys with the value have valueKey as prefix, and keys with smaller values
+		// (larger, if the field is descending) sort before it
+		valueStart := valueKey.Bytes()
+		valueEnd := bytesPrefixEnd(valueStart)
+
+		op := cond.op
+		if descending {
+			op = invertRangeOp(op)
+		}
+		switch op {
+		case opGe:
+			keyRange.start = maxBytes(keyRange.start, valueStart)
+		case opGt:
+			keyRange.start = maxBytes(keyRange.start, valueEnd)
+		case opLe:
+			keyRange.end = minBytes(keyRange.end, valueEnd)
+		case opLt:
+			keyRange.end = minBytes(keyRange.end, valueStart)
+		default:
+			continue
+		}
+		isNarrowed = true
+	}
+
+	if !isNarrowed {
+		return immutable.None[indexKeyRange]()
+	}
+	return immutable.Some(keyRange)
+}
+
+// invertRangeOp returns the range operator that selects the same values from a
+// descending index as the given operator selects from an ascending one.
+func invertRangeOp(op string) string {
+	switch op {
+	case opGt:
+		return opLt
+	case opGe:
+		return opLe
+	case opLt:
+		return opGt
+	case opLe:
+		return opGe
+	}
+	return op
+}
+
+func maxBytes(a, b []byte) []byte {
+	if bytes.Compare(a, b) >= 0 {
+		return a
+	}
+	return b
+}
+
+func minBytes(a, b []byte) []byte {
+	if bytes.Compare(a, b) <= 0 {
+		return a
+	}
+	return b
 }
 
 func (f *IndexFetcher) newQueryResultIterator(
@@ -483,6 +814,7 @@ func (f *IndexFetcher) newQueryResultIterator(
 		indexDesc:     f.indexDesc,
 		indexedFields: f.indexedFields,
 		indexKey:      indexKey,
+		reverse:       f.reverse,
 		matchers:      matchers,
 		execInfo:      execInfo,
 	}
@@ -504,7 +836,7 @@ func (f *IndexFetcher) newInIndexIterator(
 
 	// iterators for _in filter already iterate over keys with first field value
 	// matching the filter value, so we can skip the first matcher
-	if len(matchers) > 1 {
+	if len(fieldConditions[0].andConds) == 0 && len(matchers) > 1 {
 		matchers[0] = &anyMatcher{}
 	}
 
@@ -533,6 +865,30 @@ func (f *IndexFetcher) newInIndexIterator(
 	}, nil
 }
 
+// newFullTextIndexIterator creates a new fullTextIndexIterator for the given search condition.
+func (f *IndexFetcher) newFullTextIndexIterator(condition fieldFilterCond) (*fullTextIndexIterator, error) {
+	if condition.op != opSearch {
+		return nil, NewErrInvalidFilterOperator(condition.op)
+	}
+	text, ok := condition.val.String()
+	if !ok {
+		optText, ok := condition.val.NillableString()
+		if !ok {
+			return nil, NewErrUnexpectedTypeValue[string](condition.val)
+		}
+		text = optText.Value()
+	}
+	analyzer, err := fulltext.NewAnalyzer(f.indexDesc.Analyzer)
+	if err != nil {
+		return nil, err
+	}
+	return &fullTextIndexIterator{
+		indexKey: f.newIndexDataStoreKey(),
+		terms:    analyzer.Tokenize(text),
+		execInfo: &f.execInfo,
+	}, nil
+}
+
 func (f *IndexFetcher) newIndexDataStoreKey() core.IndexDataStoreKey {
 	key := core.IndexDataStoreKey{CollectionID: f.col.ID(), IndexID: f.indexDesc.ID}
 	return key
@@ -558,6 +914,10 @@ func (f *IndexFetcher) createIndexIterator() (indexIterator, error) {
 		return nil, nil
 	}
 
+	if f.indexDesc.IsFullText() {
+		return f.newFullTextIndexIterator(fieldConditions[0])
+	}
+
 	matchers, err := createValueMatchers(fieldConditions)
 	if err != nil {
 		return nil, err
@@ -582,7 +942,9 @@ func (f *IndexFetcher) createIndexIterator() (indexIterator, error) {
 		}
 	case opIn:
 		return f.newInIndexIterator(fieldConditions, matchers)
-	case opGt, opGe, opLt, opLe, opNe, opNin, opLike, opNlike, opILike, opNILike:
+	case opGt, opGe, opLt, opLe:
+		return f.newPrefixIndexIterator(fieldConditions, matchers)
+	case opNe, opNin, opLike, opNlike, opILike, opNILike, opAny:
 		return f.newQueryResultIterator(f.newIndexDataStoreKey(), matchers, &f.execInfo), nil
 	}
 
@@ -594,6 +956,16 @@ func createValueMatcher(condition *fieldFilterCond) (valueMatcher, error) {
 		return &anyMatcher{}, nil
 	}
 
+	if len(condition.andConds) > 0 {
+		conditions := []fieldFilterCond{*condition}
+		conditions[0].andConds = nil
+		matchers, err := createValueMatchers(append(conditions, condition.andConds...))
+		if err != nil {
+			return nil, err
+		}
+		return &allMatcher{matchers: matchers}, nil
+	}
+
 	if condition.val.IsNil() {
 		return &nilMatcher{matchNil: condition.op == opEq}, nil
 	}
@@ -665,18 +1037,41 @@ type fieldFilterCond struct {
 	op   string
 	val  client.NormalValue
 	kind client.FieldKind
+	// andConds are the other conditions on the same field, which must also be met.
+	// For example `_lt` in `{age: {_gt: 20, _lt: 30}}`.
+	andConds []fieldFilterCond
+}
+
+// getOpPriority returns the priority of the given operator when choosing the condition
+// that determines how a field is iterated. The lower the value the higher the priority.
+func getOpPriority(op string) int {
+	switch op {
+	case opEq:
+		return 0
+	case opIn:
+		return 1
+	case opGt, opGe, opLt, opLe:
+		return 2
+	}
+	return 3
 }
 
 // determineFieldFilterConditions determines the conditions and their corresponding operation
 // for each indexed field.
 // It returns a slice of fieldFilterCond, where each element corresponds to a field in the index.
+//
+// If the fetcher has no index filter, all fields get an `_any` condition.
 func (f *IndexFetcher) determineFieldFilterConditions() ([]fieldFilterCond, error) {
 	result := make([]fieldFilterCond, 0, len(f.indexedFields))
 	for i := range f.indexedFields {
 		fieldInd := f.mapping.FirstIndexOfName(f.indexedFields[i].Name)
 		found := false
+		var conditions map[connor.FilterKey]any
+		if f.indexFilter != nil {
+			conditions = f.indexFilter.Conditions
+		}
 		// iterate through conditions and find the one that matches the current field
-		for filterKey, indexFilterCond := range f.indexFilter.Conditions {
+		for filterKey, indexFilterCond := range conditions {
 			propKey, ok := filterKey.(*mapper.PropertyIndex)
 			if !ok || fieldInd != propKey.Index {
 				continue
@@ -685,6 +1080,7 @@ func (f *IndexFetcher) determineFieldFilterConditions() ([]fieldFilterCond, erro
 			found = true
 
 			condMap := indexFilterCond.(map[connor.FilterKey]any)
+			fieldConds := make([]fieldFilterCond, 0, len(condMap))
 			for key, filterVal := range condMap {
 				opKey := key.(*mapper.Operator)
 				var normalVal client.NormalValue
@@ -697,12 +1093,19 @@ func (f *IndexFetcher) determineFieldFilterConditions() ([]fieldFilterCond, erro
 				if err != nil {
 					return nil, err
 				}
-				result = append(result, fieldFilterCond{
+				fieldConds = append(fieldConds, fieldFilterCond{
 					op:   opKey.Operation,
 					val:  normalVal,
 					kind: f.indexedFields[i].Kind,
 				})
-				break
+			}
+			if len(fieldConds) > 0 {
+				slices.SortFunc(fieldConds, func(a, b fieldFilterCond) int {
+					return cmp.Or(cmp.Compare(getOpPriority(a.op), getOpPriority(b.op)), strings.Compare(a.op, b.op))
+				})
+				cond := fieldConds[0]
+				cond.andConds = fieldConds[1:]
+				result = append(result, cond)
 			}
 			break
 		}
@@ -734,6 +1137,11 @@ func isUniqueFetchByFullKey(indexDesc *client.IndexDescription, conditions []fie
 	for i := 1; i < len(conditions); i++ {
 		res = res && (conditions[i].op == opEq && !conditions[i].val.IsNil())
 	}
+
+	// the fetched key is not matched against any further condition
+	for i := range conditions {
+		res = res && len(conditions[i].andConds) == 0
+	}
 	return res
 }
 
@@ -228,8 +228,16 @@ func (vf *VersionedFetcher) seekTo(c cid.Cid) error {
 	// reinit the queued cids list
 	vf.queuedCids = list.New()
 
+	compacted, err := vf.txn.Blockstore().IsCompacted(vf.ctx, c)
+	if err != nil {
+		return NewErrVFetcherFailedToFindBlock(err)
+	}
+	if compacted {
+		return NewErrVersionCompacted(c)
+	}
+
 	// recursive step through the graph
-	err := vf.seekNext(c, true)
+	err = vf.seekNext(c, true)
 	if err != nil {
 		return err
 	}
@@ -314,10 +322,16 @@ func (vf *VersionedFetcher) seekNext(c cid.Cid, topParent bool) error {
 	// only seekNext on parent if we have a HEAD link
 	l, ok := block.GetLinkByName(core.HEAD)
 	if ok {
-		err := vf.seekNext(l.Cid, true)
+		next, ok, err := vf.getHeadLink(block, l.Cid)
 		if err != nil {
 			return err
 		}
+		if ok {
+			err := vf.seekNext(next, true)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	// loop over links and ignore head links
@@ -335,6 +349,50 @@ func (vf *VersionedFetcher) seekNext(c cid.Cid, topParent bool) error {
 	return nil
 }
 
+// getHeadLink returns the block to continue the seek from when following the given `_head` link.
+//
+// If the link has been removed by a compaction, the seek continues from the snapshot block the
+// history of the document was collapsed into, or ends for the field blocks.
+func (vf *VersionedFetcher) getHeadLink(block *coreblock.Block, head cid.Cid) (cid.Cid, bool, error) {
+	compacted, err := vf.txn.Blockstore().IsCompacted(vf.ctx, head)
+	if err != nil {
+		return cid.Cid{}, false, NewErrVFetcherFailedToFindBlock(err)
+	}
+	if !compacted {
+		return head, true, nil
+	}
+	if !block.Delta.IsComposite() {
+		return cid.Cid{}, false, nil
+	}
+
+	snapshotKey := core.NewCompactionSnapshotKey(string(block.Delta.GetDocID()))
+	data, err := vf.txn.Systemstore().Get(vf.ctx, snapshotKey.ToDS())
+	if err != nil {
+		return cid.Cid{}, false, NewErrVFetcherFailedToFindBlock(err)
+	}
+	snapshot, err := cid.Cast(data)
+	if err != nil {
+		return cid.Cid{}, false, NewErrFailedToDecodeCIDForVFetcher(err)
+	}
+	blk, err := vf.txn.Blockstore().Get(vf.ctx, snapshot)
+	if err != nil {
+		return cid.Cid{}, false, NewErrVFetcherFailedToGetBlock(err)
+	}
+	snapshotBlock, err := coreblock.GetFromBytes(blk.RawData())
+	if err != nil {
+		return cid.Cid{}, false, NewErrVFetcherFailedToDecodeNode(err)
+	}
+	// The snapshot only holds the last block of each field, which is enough to
+	// rebuild the state of the LWW registers but not of the other CRDTs.
+	for _, l := range snapshotBlock.Links {
+		field, ok := vf.col.Definition().GetFieldByName(l.Name)
+		if ok && field.Typ != client.LWW_REGISTER {
+			return cid.Cid{}, false, NewErrCompactedNonLWWField(l.Name)
+		}
+	}
+	return snapshot, true, nil
+}
+
 // merge in the state of the IPLD Block identified by CID c into the VersionedFetcher state.
 // Requires the CID to already exist in the Blockstore.
 // This function only works for merging Composite MerkleCRDT objects.
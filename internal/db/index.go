@@ -17,13 +17,14 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/connor/fulltext"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/request/graphql/schema/types"
 )
 
 // CollectionIndex is an interface for collection indexes
 // It abstracts away common index functionality to be implemented
-// by different index types: non-unique, unique, and composite
+// by different index types: non-unique, unique, composite and full-text
 type CollectionIndex interface {
 	client.CollectionIndex
 	// RemoveAll removes all documents from the index
@@ -102,6 +103,13 @@ func NewCollectionIndex(
 		}
 		base.validateFieldFuncs[i] = validateFunc
 	}
+	if desc.IsFullText() {
+		analyzer, err := fulltext.NewAnalyzer(desc.Analyzer)
+		if err != nil {
+			return nil, err
+		}
+		return &collectionFullTextIndex{collectionBaseIndex: base, analyzer: analyzer}, nil
+	}
 	if desc.Unique {
 		return &collectionUniqueIndex{collectionBaseIndex: base}, nil
 	} else {
@@ -409,6 +417,106 @@ func (index *collectionUniqueIndex) deleteDocIndex(
 	return index.deleteIndexKey(ctx, txn, key)
 }
 
+// collectionFullTextIndex is a non-unique index that indexes documents by the tokens of
+// a single text field.
+//
+// A key made of the token and the document ID is stored for each distinct token of the
+// analyzed field value.
+type collectionFullTextIndex struct {
+	collectionBaseIndex
+	analyzer fulltext.Analyzer
+}
+
+var _ CollectionIndex = (*collectionFullTextIndex)(nil)
+
+func (index *collectionFullTextIndex) getDocumentsIndexKeys(
+	doc *client.Document,
+) ([]core.IndexDataStoreKey, error) {
+	fieldValues, err := index.getDocFieldValues(doc)
+	if err != nil {
+		return nil, err
+	}
+	text, ok := fieldValues[0].String()
+	if !ok {
+		optText, _ := fieldValues[0].NillableString()
+		text = optText.Value()
+	}
+
+	tokens := index.analyzer.Tokenize(text)
+	keys := make([]core.IndexDataStoreKey, 0, len(tokens))
+	seen := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+		keys = append(keys, core.NewIndexDataStoreKey(
+			index.collection.ID(),
+			index.desc.ID,
+			[]core.IndexedField{
+				{Value: client.NewNormalString(token)},
+				{Value: client.NewNormalString(doc.ID().String())},
+			},
+		))
+	}
+	return keys, nil
+}
+
+// Save indexes a document by storing the tokens of the indexed field value.
+func (index *collectionFullTextIndex) Save(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	keys, err := index.getDocumentsIndexKeys(doc)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err = txn.Datastore().Put(ctx, key.ToDS(), []byte{})
+		if err != nil {
+			return NewErrFailedToStoreIndexedField(key.ToString(), err)
+		}
+	}
+	return nil
+}
+
+func (index *collectionFullTextIndex) Update(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	// We only need to update the index if the indexed field
+	// on the document has been changed.
+	if !isUpdatingIndexedFields(index, oldDoc, newDoc) {
+		return nil
+	}
+	err := index.Delete(ctx, txn, oldDoc)
+	if err != nil {
+		return err
+	}
+	return index.Save(ctx, txn, newDoc)
+}
+
+func (index *collectionFullTextIndex) Delete(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	keys, err := index.getDocumentsIndexKeys(doc)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err = index.deleteIndexKey(ctx, txn, key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func isUpdatingIndexedFields(index CollectionIndex, oldDoc, newDoc *client.Document) bool {
 	for _, indexedFields := range index.Description().Fields {
 		oldVal, getOldValErr := oldDoc.GetValue(indexedFields.Name)
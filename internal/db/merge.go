@@ -63,11 +63,29 @@ func (db *db) executeMerge(ctx context.Context, dagMerge event.Merge) error {
 		return err
 	}
 
+	purged, err := mp.mergePurge(ctx, dagMerge.Cid, mt, db.requireSignedBlocks)
+	if err != nil {
+		return err
+	}
+	if purged {
+		err = txn.Commit(ctx)
+		if err != nil {
+			return err
+		}
+		db.events.Publish(event.NewMessage(event.MergeCompleteName, dagMerge))
+		return nil
+	}
+
 	err = mp.loadComposites(ctx, dagMerge.Cid, mt)
 	if err != nil {
 		return err
 	}
 
+	err = mp.verifySignatures(db.requireSignedBlocks)
+	if err != nil {
+		return err
+	}
+
 	err = mp.mergeComposites(ctx)
 	if err != nil {
 		return err
@@ -88,6 +106,28 @@ func (db *db) executeMerge(ctx context.Context, dagMerge event.Merge) error {
 	return nil
 }
 
+// executeMergeWithRetry executes the given merge once no other merge of the same document
+// is in progress, retrying it if a transaction conflict occurs.
+func (db *db) executeMergeWithRetry(ctx context.Context, dagMerge event.Merge) error {
+	// ensure only one merge per docID
+	db.mergeQueue.add(dagMerge.DocID)
+	defer db.mergeQueue.done(dagMerge.DocID)
+
+	// retry the merge process if a conflict occurs
+	//
+	// conficts occur when a user updates a document
+	// while a merge is in progress.
+	var err error
+	for i := 0; i < db.MaxTxnRetries(); i++ {
+		err = db.executeMerge(ctx, dagMerge)
+		if errors.Is(err, datastore.ErrTxnConflict) {
+			continue // retry merge
+		}
+		break // merge success or error
+	}
+	return err
+}
+
 // mergeQueue is synchronization source to ensure that concurrent
 // document merges do not cause transaction conflicts.
 type mergeQueue struct {
@@ -164,6 +204,54 @@ func newMergeTarget() mergeTarget {
 	}
 }
 
+// mergePurge handles the merge of the given block if the document has been purged, or if the
+// block is the tombstone of a purged document. It returns true if the merge has been handled.
+//
+// The history of a purged document is never restored, the blocks received for it are removed.
+// A tombstone purges the local state of the document and becomes its only head.
+func (mp *mergeProcessor) mergePurge(
+	ctx context.Context,
+	blockCid cid.Cid,
+	mt mergeTarget,
+	requireSigned bool,
+) (bool, error) {
+	primaryKey := mp.dsKey.ToPrimaryDataStoreKey()
+	isPurged, err := mp.col.isPurged(ctx, primaryKey)
+	if err != nil {
+		return false, err
+	}
+	if isPurged {
+		keep := make(map[cid.Cid]struct{}, len(mt.heads))
+		for head := range mt.heads {
+			keep[head] = struct{}{}
+		}
+		return true, deleteBlocks(ctx, mp.txn, []cid.Cid{blockCid}, keep)
+	}
+
+	nd, err := mp.lsys.Load(linking.LinkContext{Ctx: ctx}, cidlink.Link{Cid: blockCid}, coreblock.SchemaPrototype)
+	if err != nil {
+		return false, err
+	}
+	block, err := coreblock.GetFromNode(nd)
+	if err != nil {
+		return false, err
+	}
+	if !block.Delta.IsComposite() || !client.DocumentStatus(block.Delta.GetStatus()).IsPurged() {
+		return false, nil
+	}
+
+	mp.composites.PushBack(block)
+	err = mp.verifySignatures(requireSigned)
+	if err != nil {
+		return false, err
+	}
+	err = mp.col.purgeDoc(ctx, primaryKey)
+	if err != nil {
+		return false, err
+	}
+	return true, mp.processBlock(ctx, block, cidlink.Link{Cid: blockCid}, false)
+}
+
 // loadComposites retrieves and stores into the merge processor the composite blocks for the given
 // document until it reaches a block that has already been merged or until we reach the genesis block.
 func (mp *mergeProcessor) loadComposites(
@@ -176,6 +264,15 @@ func (mp *mergeProcessor) loadComposites(
 		return nil
 	}
 
+	compacted, err := mp.txn.Blockstore().IsCompacted(ctx, blockCid)
+	if err != nil {
+		return err
+	}
+	if compacted {
+		// The block has been merged and its history collapsed by a compaction.
+		return nil
+	}
+
 	nd, err := mp.lsys.Load(linking.LinkContext{Ctx: ctx}, cidlink.Link{Cid: blockCid}, coreblock.SchemaPrototype)
 	if err != nil {
 		return err
@@ -204,6 +301,14 @@ func (mp *mergeProcessor) loadComposites(
 		for _, b := range mt.heads {
 			for _, link := range b.Links {
 				if link.Name == core.HEAD {
+					compacted, err := mp.txn.Blockstore().IsCompacted(ctx, link.Cid)
+					if err != nil {
+						return err
+					}
+					if compacted {
+						continue
+					}
+
 					nd, err := mp.lsys.Load(linking.LinkContext{Ctx: ctx}, link.Link, coreblock.SchemaPrototype)
 					if err != nil {
 						return err
@@ -224,6 +329,23 @@ func (mp *mergeProcessor) loadComposites(
 	return nil
 }
 
+// verifySignatures verifies the signatures of the loaded composite blocks.
+//
+// Signed blocks must have a valid signature. Unsigned blocks are rejected only if requireSigned is true.
+func (mp *mergeProcessor) verifySignatures(requireSigned bool) error {
+	for e := mp.composites.Front(); e != nil; e = e.Next() {
+		block := e.Value.(*coreblock.Block)
+		if block.Signature == nil && !requireSigned {
+			continue
+		}
+		err := block.VerifySignature()
+		if err != nil {
+			return NewErrMergeBlockSignature(err, errors.NewKV("DocID", string(block.Delta.GetDocID())))
+		}
+	}
+	return nil
+}
+
 func (mp *mergeProcessor) mergeComposites(ctx context.Context) error {
 	for e := mp.composites.Front(); e != nil; e = e.Next() {
 		block := e.Value.(*coreblock.Block)
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/crypto"
 	"github.com/sourcenetwork/defradb/event"
 	"github.com/sourcenetwork/defradb/internal/core"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
@@ -201,10 +202,140 @@ func TestMerge_DualBranchWithOneIncomplete_CouldNotFindCID(t *testing.T) {
 	require.Equal(t, expectedDocMap, docMap)
 }
 
+func TestMerge_WithSignedBlocks_NoError(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+	db.requireSignedBlocks = true
+
+	_, err = db.AddSchema(ctx, userSchema)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(db.multistore.Blockstore().AsIPLDStorage())
+
+	privKey, err := crypto.GenerateSecp256k1()
+	require.NoError(t, err)
+
+	initialDocState := map[string]any{
+		"name": "John",
+	}
+	d, docID := newDagBuilder(col, initialDocState)
+	d.sign = func(block *coreblock.Block) error {
+		return block.Sign(privKey)
+	}
+	compInfo, err := d.generateCompositeUpdate(&lsys, initialDocState, compositeInfo{})
+	require.NoError(t, err)
+
+	err = db.executeMerge(ctx, event.Merge{
+		DocID:      docID.String(),
+		Cid:        compInfo.link.Cid,
+		SchemaRoot: col.SchemaRoot(),
+	})
+	require.NoError(t, err)
+
+	doc, err := col.Get(ctx, docID, false)
+	require.NoError(t, err)
+	docMap, err := doc.ToMap()
+	require.NoError(t, err)
+
+	expectedDocMap := map[string]any{
+		"_docID": docID.String(),
+		"name":   "John",
+	}
+
+	require.Equal(t, expectedDocMap, docMap)
+}
+
+func TestMerge_WithInvalidSignature_Error(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+
+	_, err = db.AddSchema(ctx, userSchema)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(db.multistore.Blockstore().AsIPLDStorage())
+
+	privKey, err := crypto.GenerateSecp256k1()
+	require.NoError(t, err)
+	otherPrivKey, err := crypto.GenerateSecp256k1()
+	require.NoError(t, err)
+
+	initialDocState := map[string]any{
+		"name": "John",
+	}
+	d, docID := newDagBuilder(col, initialDocState)
+	d.sign = func(block *coreblock.Block) error {
+		err := block.Sign(privKey)
+		if err != nil {
+			return err
+		}
+		// claim that the block was signed by another identity
+		block.Signature.Header.Identity = otherPrivKey.PubKey().SerializeCompressed()
+		return nil
+	}
+	compInfo, err := d.generateCompositeUpdate(&lsys, initialDocState, compositeInfo{})
+	require.NoError(t, err)
+
+	err = db.executeMerge(ctx, event.Merge{
+		DocID:      docID.String(),
+		Cid:        compInfo.link.Cid,
+		SchemaRoot: col.SchemaRoot(),
+	})
+	require.ErrorIs(t, err, ErrMergeBlockSignature)
+
+	_, err = col.Get(ctx, docID, false)
+	require.ErrorIs(t, err, client.ErrDocumentNotFoundOrNotAuthorized)
+}
+
+func TestMerge_WithUnsignedBlockAndRequireSignedBlocks_Error(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+	db.requireSignedBlocks = true
+
+	_, err = db.AddSchema(ctx, userSchema)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(db.multistore.Blockstore().AsIPLDStorage())
+
+	initialDocState := map[string]any{
+		"name": "John",
+	}
+	d, docID := newDagBuilder(col, initialDocState)
+	compInfo, err := d.generateCompositeUpdate(&lsys, initialDocState, compositeInfo{})
+	require.NoError(t, err)
+
+	err = db.executeMerge(ctx, event.Merge{
+		DocID:      docID.String(),
+		Cid:        compInfo.link.Cid,
+		SchemaRoot: col.SchemaRoot(),
+	})
+	require.ErrorIs(t, err, ErrMergeBlockSignature)
+	require.ErrorIs(t, err, coreblock.ErrMissingSignature)
+}
+
 type dagBuilder struct {
 	fieldsHeight map[string]uint64
 	docID        []byte
 	col          client.Collection
+	// sign is called on every generated composite block if set.
+	sign func(block *coreblock.Block) error
 }
 
 func newDagBuilder(col client.Collection, initalDocState map[string]any) (*dagBuilder, client.DocID) {
@@ -272,6 +403,12 @@ func (d *dagBuilder) generateCompositeUpdate(lsys *linking.LinkSystem, fields ma
 		},
 		Links: links,
 	}
+	if d.sign != nil {
+		err := d.sign(&compositeBlock)
+		if err != nil {
+			return compositeInfo{}, err
+		}
+	}
 
 	compositeBlockLink, err := lsys.Store(ipld.LinkContext{}, coreblock.GetLinkPrototype(), compositeBlock.GenerateNode())
 	if err != nil {
@@ -13,23 +13,26 @@ package db
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/sourcenetwork/corelog"
 
-	"github.com/sourcenetwork/defradb/datastore"
-	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/event"
 )
 
 func (db *db) handleMessages(ctx context.Context, sub *event.Subscription) {
-	queue := newMergeQueue()
 	// This is used to ensure we only trigger loadAndPublishP2PCollections and loadAndPublishReplicators
 	// once per db instanciation.
 	loadOnce := sync.Once{}
+	// The replicators due a retry are checked at the first retry interval.
+	retryTicker := time.NewTicker(db.retryIntervals[0])
+	defer retryTicker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-retryTicker.C:
+			go db.retryReplicators(ctx)
 		case msg, ok := <-sub.Message():
 			if !ok {
 				return
@@ -37,23 +40,7 @@ func (db *db) handleMessages(ctx context.Context, sub *event.Subscription) {
 			switch evt := msg.Data.(type) {
 			case event.Merge:
 				go func() {
-					// ensure only one merge per docID
-					queue.add(evt.DocID)
-					defer queue.done(evt.DocID)
-
-					// retry the merge process if a conflict occurs
-					//
-					// conficts occur when a user updates a document
-					// while a merge is in progress.
-					var err error
-					for i := 0; i < db.MaxTxnRetries(); i++ {
-						err = db.executeMerge(ctx, evt)
-						if errors.Is(err, datastore.ErrTxnConflict) {
-							continue // retry merge
-						}
-						break // merge success or error
-					}
-
+					err := db.executeMergeWithRetry(ctx, evt)
 					if err != nil {
 						log.ErrorContextE(
 							ctx,
@@ -62,6 +49,24 @@ func (db *db) handleMessages(ctx context.Context, sub *event.Subscription) {
 							corelog.Any("Event", evt))
 					}
 				}()
+			case event.ReplicatorFailure:
+				err := db.handleReplicatorFailure(ctx, evt)
+				if err != nil {
+					log.ErrorContextE(
+						ctx,
+						"Failed to handle replicator failure",
+						err,
+						corelog.Any("Event", evt))
+				}
+			case event.ReplicatorConnected:
+				err := db.triggerReplicatorRetry(ctx, evt.PeerID)
+				if err != nil {
+					log.ErrorContextE(
+						ctx,
+						"Failed to trigger replicator retry",
+						err,
+						corelog.Any("PeerID", evt.PeerID))
+				}
 			case event.PeerInfo:
 				db.peerInfo.Store(evt.Info)
 				// Load and publish P2P collections and replicators once per db instance start.
@@ -14,12 +14,14 @@ import (
 	"context"
 	"encoding/json"
 
+	ds "github.com/ipfs/go-datastore"
 	dsq "github.com/ipfs/go-datastore/query"
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/sourcenetwork/corelog"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/event"
 	"github.com/sourcenetwork/defradb/internal/core"
@@ -269,12 +271,16 @@ func (db *db) DeleteReplicator(ctx context.Context, rep client.Replicator) error
 		if err != nil {
 			return err
 		}
+		err = deleteReplicatorRetries(ctx, txn, rep.Info.ID)
+		if err != nil {
+			return err
+		}
 	} else {
-		repBytes, err := json.Marshal(rep)
+		err = putReplicator(ctx, txn, storedRep)
 		if err != nil {
 			return err
 		}
-		err = txn.Systemstore().Put(ctx, key.ToDS(), repBytes)
+		err = deleteReplicatorSchemaRetries(ctx, txn, rep.Info.ID, storedSchemas)
 		if err != nil {
 			return err
 		}
@@ -312,11 +318,44 @@ func (db *db) GetAllReplicators(ctx context.Context) ([]client.Replicator, error
 		if err = json.Unmarshal(result.Value, &rep); err != nil {
 			return nil, err
 		}
+		rep.PendingCount, err = getReplicatorPendingCount(ctx, txn, rep.Info.ID)
+		if err != nil {
+			return nil, err
+		}
 		reps = append(reps, rep)
 	}
 	return reps, nil
 }
 
+// getReplicator returns the stored replicator with the given peer ID.
+func getReplicator(ctx context.Context, txn datastore.Txn, peerID peer.ID) (client.Replicator, error) {
+	repKey := core.NewReplicatorKey(peerID.String())
+	repBytes, err := txn.Systemstore().Get(ctx, repKey.ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return client.Replicator{}, ErrReplicatorNotFound
+	}
+	if err != nil {
+		return client.Replicator{}, err
+	}
+	var rep client.Replicator
+	if err = json.Unmarshal(repBytes, &rep); err != nil {
+		return client.Replicator{}, err
+	}
+	return rep, nil
+}
+
+// putReplicator stores the given replicator.
+func putReplicator(ctx context.Context, txn datastore.Txn, rep client.Replicator) error {
+	// the pending count is derived from the retry queue and is never stored
+	rep.PendingCount = 0
+	repBytes, err := json.Marshal(rep)
+	if err != nil {
+		return err
+	}
+	repKey := core.NewReplicatorKey(rep.Info.ID.String())
+	return txn.Systemstore().Put(ctx, repKey.ToDS(), repBytes)
+}
+
 func (db *db) loadAndPublishReplicators(ctx context.Context) error {
 	replicators, err := db.GetAllReplicators(ctx)
 	if err != nil {
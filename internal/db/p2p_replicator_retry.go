@@ -0,0 +1,567 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/sourcenetwork/corelog"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
+)
+
+// replicatorRetryInfo is the persisted retry state of a replicator
+// that documents failed to be pushed to.
+type replicatorRetryInfo struct {
+	// NextRetry is the time from which the next retry can be attempted.
+	NextRetry time.Time
+	// NumRetries is the number of failed retries since the first failure.
+	NumRetries int
+}
+
+// retryDoc is a document that failed to be pushed to a replicator.
+type retryDoc struct {
+	docID      string
+	schemaRoot string
+}
+
+// handleReplicatorFailure persists the document that failed to be pushed to the replicator
+// so that its latest heads can be pushed again once the replicator is reachable.
+func (db *db) handleReplicatorFailure(ctx context.Context, evt event.ReplicatorFailure) error {
+	db.retryMutex.Lock()
+	defer db.retryMutex.Unlock()
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	rep, err := getReplicator(ctx, txn, evt.PeerID)
+	if err != nil {
+		if errors.Is(err, ErrReplicatorNotFound) {
+			// the replicator has been deleted since the push
+			return nil
+		}
+		return err
+	}
+
+	docKey := core.NewReplicatorRetryDocIDKey(evt.PeerID.String(), evt.DocID)
+	err = txn.Systemstore().Put(ctx, docKey.ToDS(), []byte(evt.SchemaRoot))
+	if err != nil {
+		return err
+	}
+
+	retryKey := core.NewReplicatorRetryIDKey(evt.PeerID.String())
+	hasRetry, err := txn.Systemstore().Has(ctx, retryKey.ToDS())
+	if err != nil {
+		return err
+	}
+	if !hasRetry {
+		err = putReplicatorRetryInfo(ctx, txn, evt.PeerID, replicatorRetryInfo{
+			NextRetry: time.Now().Add(db.getRetryInterval(0)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if rep.Status != client.ReplicatorStatusInactive {
+		rep.Status = client.ReplicatorStatusInactive
+		rep.LastStatusChange = time.Now()
+	}
+	if evt.Err != nil {
+		rep.LastError = evt.Err.Error()
+	}
+	err = putReplicator(ctx, txn, rep)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// triggerReplicatorRetry makes the next retry of the given replicator happen immediately.
+//
+// It is a no-op if there is nothing to retry for the replicator.
+func (db *db) triggerReplicatorRetry(ctx context.Context, peerID peer.ID) error {
+	db.retryMutex.Lock()
+	defer db.retryMutex.Unlock()
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	info, err := getReplicatorRetryInfo(ctx, txn, peerID)
+	if err != nil {
+		return err
+	}
+	if !info.HasValue() {
+		return nil
+	}
+	retryInfo := info.Value()
+	retryInfo.NextRetry = time.Now()
+	err = putReplicatorRetryInfo(ctx, txn, peerID, retryInfo)
+	if err != nil {
+		return err
+	}
+	err = txn.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	go db.retryReplicators(ctx)
+	return nil
+}
+
+// retryReplicators retries all the replicators that are due a retry.
+func (db *db) retryReplicators(ctx context.Context) {
+	// retries can only be pushed if the network is enabled
+	if db.PeerInfo().ID == "" {
+		return
+	}
+
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		log.ErrorContextE(ctx, "Failed to get transaction", err)
+		return
+	}
+	defer txn.Discard(ctx)
+
+	query := dsq.Query{
+		Prefix: core.NewReplicatorRetryIDKey("").ToString(),
+	}
+	results, err := txn.Systemstore().Query(ctx, query)
+	if err != nil {
+		log.ErrorContextE(ctx, "Failed to query replicator retries", err)
+		return
+	}
+	defer func() {
+		if err := results.Close(); err != nil {
+			log.ErrorContextE(ctx, "Failed to close replicator retry query", err)
+		}
+	}()
+
+	now := time.Now()
+	for result := range results.Next() {
+		if result.Error != nil {
+			log.ErrorContextE(ctx, "Failed to read replicator retry", result.Error)
+			continue
+		}
+		key, err := core.NewReplicatorRetryIDKeyFromString(result.Key)
+		if err != nil {
+			log.ErrorContextE(ctx, "Failed to parse replicator retry key", err)
+			continue
+		}
+		var info replicatorRetryInfo
+		if err := json.Unmarshal(result.Value, &info); err != nil {
+			log.ErrorContextE(ctx, "Failed to unmarshal replicator retry", err)
+			continue
+		}
+		if info.NextRetry.After(now) {
+			continue
+		}
+		peerID, err := peer.Decode(key.PeerID)
+		if err != nil {
+			log.ErrorContextE(ctx, "Failed to decode replicator peer ID", err)
+			continue
+		}
+		// only one retry per replicator can be in progress at any time
+		if _, loaded := db.retrying.LoadOrStore(peerID, struct{}{}); loaded {
+			continue
+		}
+		go func() {
+			defer db.retrying.Delete(peerID)
+			err := db.retryReplicator(ctx, peerID)
+			if err != nil {
+				log.ErrorContextE(
+					ctx,
+					"Failed to retry replicator",
+					err,
+					corelog.Any("PeerID", peerID))
+			}
+		}()
+	}
+}
+
+// retryReplicator pushes the latest heads of all the documents that failed to be pushed
+// to the given replicator and updates the replicator status with the outcome.
+func (db *db) retryReplicator(ctx context.Context, peerID peer.ID) error {
+	docs, err := db.getReplicatorRetryDocs(ctx, peerID)
+	if err != nil {
+		return err
+	}
+
+	updates, err := db.getRetryDocsHeads(ctx, docs)
+	if err != nil {
+		return err
+	}
+
+	result := make(chan error, 1)
+	db.events.Publish(event.NewMessage(event.ReplicatorRetryName, event.ReplicatorRetry{
+		PeerID:  peerID,
+		Updates: updates,
+		Result:  result,
+	}))
+
+	var pushErr error
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case pushErr = <-result:
+	}
+
+	if pushErr != nil {
+		log.InfoContext(ctx, "Failed to push to replicator, will retry later",
+			corelog.Any("PeerID", peerID),
+			corelog.String("Error", pushErr.Error()))
+		return db.failReplicatorRetry(ctx, peerID, pushErr)
+	}
+	return db.completeReplicatorRetry(ctx, peerID, updates)
+}
+
+// failReplicatorRetry schedules the next retry of the replicator using an exponential backoff.
+func (db *db) failReplicatorRetry(ctx context.Context, peerID peer.ID, pushErr error) error {
+	db.retryMutex.Lock()
+	defer db.retryMutex.Unlock()
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	info, err := getReplicatorRetryInfo(ctx, txn, peerID)
+	if err != nil {
+		return err
+	}
+	if !info.HasValue() {
+		// the replicator has been deleted since the retry started
+		return nil
+	}
+	retryInfo := info.Value()
+	retryInfo.NumRetries++
+	retryInfo.NextRetry = time.Now().Add(db.getRetryInterval(retryInfo.NumRetries))
+	err = putReplicatorRetryInfo(ctx, txn, peerID, retryInfo)
+	if err != nil {
+		return err
+	}
+
+	rep, err := getReplicator(ctx, txn, peerID)
+	if err != nil {
+		return err
+	}
+	rep.LastError = pushErr.Error()
+	err = putReplicator(ctx, txn, rep)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// completeReplicatorRetry removes the pushed documents from the retry queue and, if no
+// documents remain, marks the replicator as active again.
+//
+// Documents that have been updated since their heads were pushed are kept in the queue.
+func (db *db) completeReplicatorRetry(ctx context.Context, peerID peer.ID, pushed []event.Update) error {
+	db.retryMutex.Lock()
+	defer db.retryMutex.Unlock()
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	pushedHeads := make(map[string][]cid.Cid)
+	for _, update := range pushed {
+		pushedHeads[update.DocID] = append(pushedHeads[update.DocID], update.Cid)
+	}
+
+	docs, err := db.getReplicatorRetryDocs(SetContextTxn(ctx, txn), peerID)
+	if err != nil {
+		return err
+	}
+	remaining := 0
+	for _, doc := range docs {
+		heads, err := getDocCompositeHeads(ctx, txn, doc.docID)
+		if err != nil {
+			return err
+		}
+		if !sameHeads(heads, pushedHeads[doc.docID]) {
+			remaining++
+			continue
+		}
+		docKey := core.NewReplicatorRetryDocIDKey(peerID.String(), doc.docID)
+		err = txn.Systemstore().Delete(ctx, docKey.ToDS())
+		if err != nil {
+			return err
+		}
+	}
+
+	if remaining > 0 {
+		// documents have been updated during the retry, retry them straight away
+		info, err := getReplicatorRetryInfo(ctx, txn, peerID)
+		if err != nil {
+			return err
+		}
+		if info.HasValue() {
+			retryInfo := info.Value()
+			retryInfo.NextRetry = time.Now()
+			err = putReplicatorRetryInfo(ctx, txn, peerID, retryInfo)
+			if err != nil {
+				return err
+			}
+		}
+		return txn.Commit(ctx)
+	}
+
+	retryKey := core.NewReplicatorRetryIDKey(peerID.String())
+	err = txn.Systemstore().Delete(ctx, retryKey.ToDS())
+	if err != nil {
+		return err
+	}
+
+	rep, err := getReplicator(ctx, txn, peerID)
+	if err != nil {
+		if errors.Is(err, ErrReplicatorNotFound) {
+			return txn.Commit(ctx)
+		}
+		return err
+	}
+	rep.Status = client.ReplicatorStatusActive
+	rep.LastStatusChange = time.Now()
+	rep.LastError = ""
+	err = putReplicator(ctx, txn, rep)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// getReplicatorRetryDocs returns the documents that failed to be pushed to the given replicator.
+func (db *db) getReplicatorRetryDocs(ctx context.Context, peerID peer.ID) ([]retryDoc, error) {
+	ctx, txn, err := ensureContextTxn(ctx, db, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	query := dsq.Query{
+		Prefix: core.NewReplicatorRetryDocIDKey(peerID.String(), "").ToString(),
+	}
+	results, err := txn.Systemstore().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]retryDoc, 0, len(entries))
+	for _, entry := range entries {
+		key, err := core.NewReplicatorRetryDocIDKeyFromString(entry.Key)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, retryDoc{
+			docID:      key.DocID,
+			schemaRoot: string(entry.Value),
+		})
+	}
+	return docs, nil
+}
+
+// getRetryDocsHeads returns an update for each of the current heads of the given documents.
+func (db *db) getRetryDocsHeads(ctx context.Context, docs []retryDoc) ([]event.Update, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	var updates []event.Update
+	for _, doc := range docs {
+		heads, err := getDocCompositeHeads(ctx, txn, doc.docID)
+		if err != nil {
+			return nil, err
+		}
+		for _, head := range heads {
+			blk, err := txn.Blockstore().Get(ctx, head)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, event.Update{
+				DocID:      doc.docID,
+				Cid:        head,
+				SchemaRoot: doc.schemaRoot,
+				Block:      blk.RawData(),
+			})
+		}
+	}
+	return updates, nil
+}
+
+// getReplicatorPendingCount returns the number of documents waiting to be pushed to the given replicator.
+func getReplicatorPendingCount(ctx context.Context, txn datastore.Txn, peerID peer.ID) (int, error) {
+	query := dsq.Query{
+		Prefix:   core.NewReplicatorRetryDocIDKey(peerID.String(), "").ToString(),
+		KeysOnly: true,
+	}
+	results, err := txn.Systemstore().Query(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
+
+// deleteReplicatorRetries removes all of the retry state of the given replicator.
+func deleteReplicatorRetries(ctx context.Context, txn datastore.Txn, peerID peer.ID) error {
+	query := dsq.Query{
+		Prefix:   core.NewReplicatorRetryDocIDKey(peerID.String(), "").ToString(),
+		KeysOnly: true,
+	}
+	results, err := txn.Systemstore().Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		err = txn.Systemstore().Delete(ctx, ds.NewKey(entry.Key))
+		if err != nil {
+			return err
+		}
+	}
+	return txn.Systemstore().Delete(ctx, core.NewReplicatorRetryIDKey(peerID.String()).ToDS())
+}
+
+func getReplicatorRetryInfo(
+	ctx context.Context,
+	txn datastore.Txn,
+	peerID peer.ID,
+) (immutable.Option[replicatorRetryInfo], error) {
+	retryKey := core.NewReplicatorRetryIDKey(peerID.String())
+	value, err := txn.Systemstore().Get(ctx, retryKey.ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return immutable.None[replicatorRetryInfo](), nil
+	}
+	if err != nil {
+		return immutable.None[replicatorRetryInfo](), err
+	}
+	var info replicatorRetryInfo
+	if err := json.Unmarshal(value, &info); err != nil {
+		return immutable.None[replicatorRetryInfo](), err
+	}
+	return immutable.Some(info), nil
+}
+
+func putReplicatorRetryInfo(ctx context.Context, txn datastore.Txn, peerID peer.ID, info replicatorRetryInfo) error {
+	value, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	retryKey := core.NewReplicatorRetryIDKey(peerID.String())
+	return txn.Systemstore().Put(ctx, retryKey.ToDS(), value)
+}
+
+// getDocCompositeHeads returns the current composite heads of the given document.
+func getDocCompositeHeads(ctx context.Context, txn datastore.Txn, docID string) ([]cid.Cid, error) {
+	headset := clock.NewHeadSet(
+		txn.Headstore(),
+		core.DataStoreKey{DocID: docID}.WithFieldId(core.COMPOSITE_NAMESPACE).ToHeadStoreKey(),
+	)
+	heads, _, err := headset.List(ctx)
+	return heads, err
+}
+
+// sameHeads returns true if both sets of heads contain the same CIDs.
+func sameHeads(a, b []cid.Cid) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, c := range a {
+		if !slices.Contains(b, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// getRetryInterval returns the interval to wait for before the given retry.
+func (db *db) getRetryInterval(numRetries int) time.Duration {
+	if numRetries >= len(db.retryIntervals) {
+		return db.retryIntervals[len(db.retryIntervals)-1]
+	}
+	return db.retryIntervals[numRetries]
+}
+
+// deleteReplicatorSchemaRetries removes the retry state of the given replicator for the
+// documents whose schema is no longer replicated to it.
+//
+// The retry info of the replicator is removed if no documents remain to be retried.
+func deleteReplicatorSchemaRetries(
+	ctx context.Context,
+	txn datastore.Txn,
+	peerID peer.ID,
+	schemas map[string]struct{},
+) error {
+	query := dsq.Query{
+		Prefix: core.NewReplicatorRetryDocIDKey(peerID.String(), "").ToString(),
+	}
+	results, err := txn.Systemstore().Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return err
+	}
+	remaining := 0
+	for _, entry := range entries {
+		if _, ok := schemas[string(entry.Value)]; ok {
+			remaining++
+			continue
+		}
+		err = txn.Systemstore().Delete(ctx, ds.NewKey(entry.Key))
+		if err != nil {
+			return err
+		}
+	}
+	if remaining > 0 {
+		return nil
+	}
+	return txn.Systemstore().Delete(ctx, core.NewReplicatorRetryIDKey(peerID.String()).ToDS())
+}
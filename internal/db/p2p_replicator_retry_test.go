@@ -0,0 +1,262 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/acp"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore/memory"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+const retryTestPeerID = "12D3KooWB8Na2fKhdGtej5GjoVhmBBYFvqXiqFCSkR7fJFWHUbNr"
+
+// newRetryTestDB returns a db with networking enabled, a replicator and a document
+// that failed to be pushed to the replicator.
+func newRetryTestDB(ctx context.Context, t *testing.T, intervals ...time.Duration) (*db, peer.ID, client.DocID) {
+	rootstore := memory.NewDatastore(ctx)
+	db, err := newDB(ctx, rootstore, acp.NoACP, nil, WithRetryInterval(intervals))
+	require.NoError(t, err)
+
+	peerID, err := peer.Decode(retryTestPeerID)
+	require.NoError(t, err)
+
+	sub, err := db.events.Subscribe(event.PeerInfoName)
+	require.NoError(t, err)
+	db.events.Publish(event.NewMessage(event.PeerInfoName, event.PeerInfo{Info: peer.AddrInfo{ID: "self"}}))
+	waitForPeerInfo(db, sub)
+
+	_, err = db.AddSchema(ctx, `type User { name: String }`)
+	require.NoError(t, err)
+	err = db.SetReplicator(ctx, client.Replicator{
+		Info: peer.AddrInfo{ID: peerID},
+	})
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John"}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	err = db.handleReplicatorFailure(ctx, event.ReplicatorFailure{
+		PeerID:     peerID,
+		DocID:      doc.ID().String(),
+		SchemaRoot: col.SchemaRoot(),
+		Err:        errors.New("connection refused"),
+	})
+	require.NoError(t, err)
+
+	return db, peerID, doc.ID()
+}
+
+func getTestReplicator(ctx context.Context, t *testing.T, db *db) client.Replicator {
+	reps, err := db.GetAllReplicators(ctx)
+	require.NoError(t, err)
+	require.Len(t, reps, 1)
+	return reps[0]
+}
+
+func TestHandleReplicatorFailure_ShouldSetReplicatorInactive(t *testing.T) {
+	ctx := context.Background()
+	db, _, _ := newRetryTestDB(ctx, t, time.Hour)
+	defer db.Close()
+
+	rep := getTestReplicator(ctx, t, db)
+	require.Equal(t, client.ReplicatorStatusInactive, rep.Status)
+	require.Equal(t, "connection refused", rep.LastError)
+	require.Equal(t, 1, rep.PendingCount)
+	require.False(t, rep.LastStatusChange.IsZero())
+}
+
+func TestHandleReplicatorFailure_WithUnknownReplicator_ShouldDoNothing(t *testing.T) {
+	ctx := context.Background()
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	peerID, err := peer.Decode(retryTestPeerID)
+	require.NoError(t, err)
+
+	err = db.handleReplicatorFailure(ctx, event.ReplicatorFailure{
+		PeerID: peerID,
+		DocID:  "bae-d4303725-7db9-53d2-b324-f3ee44020e52",
+	})
+	require.NoError(t, err)
+
+	txn, err := db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+	info, err := getReplicatorRetryInfo(ctx, txn, peerID)
+	require.NoError(t, err)
+	require.False(t, info.HasValue())
+}
+
+func TestRetryReplicator_WithSuccessfulPush_ShouldSetReplicatorActive(t *testing.T) {
+	ctx := context.Background()
+	db, peerID, docID := newRetryTestDB(ctx, t, 10*time.Millisecond)
+	defer db.Close()
+
+	sub, err := db.events.Subscribe(event.ReplicatorRetryName)
+	require.NoError(t, err)
+
+	msg := <-sub.Message()
+	retry := msg.Data.(event.ReplicatorRetry)
+	require.Equal(t, peerID, retry.PeerID)
+	require.Len(t, retry.Updates, 1)
+	require.Equal(t, docID.String(), retry.Updates[0].DocID)
+	retry.Result <- nil
+
+	require.Eventually(t, func() bool {
+		return getTestReplicator(ctx, t, db).Status == client.ReplicatorStatusActive
+	}, time.Second, 10*time.Millisecond)
+
+	rep := getTestReplicator(ctx, t, db)
+	require.Equal(t, "", rep.LastError)
+	require.Equal(t, 0, rep.PendingCount)
+}
+
+func TestRetryReplicator_WithFailedPush_ShouldBackoff(t *testing.T) {
+	ctx := context.Background()
+	db, peerID, _ := newRetryTestDB(ctx, t, 10*time.Millisecond, time.Hour)
+	defer db.Close()
+
+	sub, err := db.events.Subscribe(event.ReplicatorRetryName)
+	require.NoError(t, err)
+
+	msg := <-sub.Message()
+	retry := msg.Data.(event.ReplicatorRetry)
+	retry.Result <- errors.New("still unreachable")
+
+	require.Eventually(t, func() bool {
+		return getTestReplicator(ctx, t, db).LastError == "still unreachable"
+	}, time.Second, 10*time.Millisecond)
+
+	rep := getTestReplicator(ctx, t, db)
+	require.Equal(t, client.ReplicatorStatusInactive, rep.Status)
+	require.Equal(t, 1, rep.PendingCount)
+
+	txn, err := db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+	info, err := getReplicatorRetryInfo(ctx, txn, peerID)
+	require.NoError(t, err)
+	require.Equal(t, 1, info.Value().NumRetries)
+	require.True(t, info.Value().NextRetry.After(time.Now().Add(time.Minute)))
+}
+
+func TestTriggerReplicatorRetry_ShouldRetryImmediately(t *testing.T) {
+	ctx := context.Background()
+	db, peerID, _ := newRetryTestDB(ctx, t, time.Hour)
+	defer db.Close()
+
+	sub, err := db.events.Subscribe(event.ReplicatorRetryName)
+	require.NoError(t, err)
+
+	db.events.Publish(event.NewMessage(event.ReplicatorConnectedName, event.ReplicatorConnected{
+		PeerID: peerID,
+	}))
+
+	select {
+	case msg := <-sub.Message():
+		retry := msg.Data.(event.ReplicatorRetry)
+		require.Equal(t, peerID, retry.PeerID)
+		retry.Result <- nil
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for replicator retry")
+	}
+
+	require.Eventually(t, func() bool {
+		return getTestReplicator(ctx, t, db).Status == client.ReplicatorStatusActive
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDeleteReplicator_WithPendingRetries_ShouldDeleteRetries(t *testing.T) {
+	ctx := context.Background()
+	db, peerID, _ := newRetryTestDB(ctx, t, time.Hour)
+	defer db.Close()
+
+	err := db.DeleteReplicator(ctx, client.Replicator{Info: peer.AddrInfo{ID: peerID}})
+	require.NoError(t, err)
+
+	txn, err := db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+	info, err := getReplicatorRetryInfo(ctx, txn, peerID)
+	require.NoError(t, err)
+	require.Equal(t, immutable.None[replicatorRetryInfo](), info)
+	count, err := getReplicatorPendingCount(ctx, txn, peerID)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	has, err := txn.Systemstore().Has(ctx, core.NewReplicatorRetryIDKey(peerID.String()).ToDS())
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestDeleteReplicator_PartialWithPendingRetries_ShouldDeleteRemovedSchemaRetries(t *testing.T) {
+	ctx := context.Background()
+	db, peerID, _ := newRetryTestDB(ctx, t, time.Hour)
+	defer db.Close()
+
+	_, err := db.AddSchema(ctx, `type Book { name: String }`)
+	require.NoError(t, err)
+	err = db.SetReplicator(ctx, client.Replicator{
+		Info:    peer.AddrInfo{ID: peerID},
+		Schemas: []string{"Book"},
+	})
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "Book")
+	require.NoError(t, err)
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "Dune"}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+	err = db.handleReplicatorFailure(ctx, event.ReplicatorFailure{
+		PeerID:     peerID,
+		DocID:      doc.ID().String(),
+		SchemaRoot: col.SchemaRoot(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, getTestReplicator(ctx, t, db).PendingCount)
+
+	err = db.DeleteReplicator(ctx, client.Replicator{Info: peer.AddrInfo{ID: peerID}, Schemas: []string{"User"}})
+	require.NoError(t, err)
+
+	docs, err := db.getReplicatorRetryDocs(ctx, peerID)
+	require.NoError(t, err)
+	require.Equal(t, []retryDoc{{docID: doc.ID().String(), schemaRoot: col.SchemaRoot()}}, docs)
+
+	err = db.DeleteReplicator(ctx, client.Replicator{Info: peer.AddrInfo{ID: peerID}, Schemas: []string{"Book"}})
+	require.NoError(t, err)
+
+	txn, err := db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+	count, err := getReplicatorPendingCount(ctx, txn, peerID)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	info, err := getReplicatorRetryInfo(ctx, txn, peerID)
+	require.NoError(t, err)
+	require.False(t, info.HasValue())
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/event"
+)
+
+func (db *db) SyncCollections(ctx context.Context, info peer.AddrInfo, collectionNames []string) error {
+	if err := info.ID.Validate(); err != nil {
+		return err
+	}
+
+	peerInfo := db.PeerInfo()
+	if peerInfo.ID == "" {
+		return ErrP2PNotEnabled
+	}
+	if info.ID == peerInfo.ID {
+		return ErrSelfTargetForP2PSync
+	}
+
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	txnCtx := SetContextTxn(ctx, txn)
+
+	var collections []client.Collection
+	switch {
+	case len(collectionNames) > 0:
+		// if specific collections are chosen get them by name
+		for _, name := range collectionNames {
+			col, err := db.GetCollectionByName(txnCtx, name)
+			if err != nil {
+				return NewErrP2PSyncCollections(err)
+			}
+			collections = append(collections, col)
+		}
+
+	default:
+		collections, err = db.GetCollections(txnCtx, client.CollectionFetchOptions{})
+		if err != nil {
+			return NewErrP2PSyncCollections(err)
+		}
+	}
+
+	if db.acp.HasValue() && !db.acp.Value().SupportsP2P() {
+		for _, col := range collections {
+			if col.Description().Policy.HasValue() {
+				return ErrP2PSyncColHasPolicy
+			}
+		}
+	}
+
+	schemaRoots := make([]string, 0, len(collections))
+	for _, col := range collections {
+		schemaRoots = append(schemaRoots, col.SchemaRoot())
+	}
+	txn.Discard(ctx)
+
+	result := make(chan event.P2PSyncResult, 1)
+	db.events.Publish(event.NewMessage(event.P2PSyncName, event.P2PSync{
+		Info:        info,
+		SchemaRoots: schemaRoots,
+		Result:      result,
+	}))
+
+	var syncResult event.P2PSyncResult
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case syncResult = <-result:
+	}
+	if syncResult.Err != nil {
+		return syncResult.Err
+	}
+
+	// Merges are executed here instead of being published so that
+	// the documents have caught up by the time the sync returns.
+	for _, merge := range syncResult.Merges {
+		err := db.executeMergeWithRetry(ctx, merge)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"context"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
 	"github.com/sourcenetwork/defradb/internal/planner"
 )
 
@@ -47,8 +48,16 @@ func (db *db) execRequest(ctx context.Context, request string, options *client.G
 	}
 
 	txn := mustGetContextTxn(ctx)
+	ctx = crdt.SetContextReplicaID(ctx, db.replicaID)
 	identity := GetContextIdentity(ctx)
-	planner := planner.New(ctx, identity, db.acp, db, txn)
+	planner := planner.New(
+		ctx,
+		identity,
+		db.acp,
+		db,
+		txn,
+		planner.WithSortMemoryBudget(db.sortMemoryBudget),
+	)
 
 	results, err := planner.RunRequest(ctx, parsedRequest)
 	if err != nil {
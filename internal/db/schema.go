@@ -14,6 +14,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -464,6 +465,19 @@ func (db *db) updateSchema(
 					},
 				}
 
+				// Fields removed from the schema must be removed from the collection too, local
+				// fields (such as the secondary side of relations) are not on the schema and are kept.
+				fields := make([]client.CollectionFieldDescription, 0, len(col.Fields))
+				for _, field := range col.Fields {
+					_, wasOnSchema := previousSchema.GetFieldByName(field.Name)
+					_, isOnSchema := schema.GetFieldByName(field.Name)
+					if wasOnSchema && !isOnSchema {
+						continue
+					}
+					fields = append(fields, field)
+				}
+				col.Fields = fields
+
 				for _, globalField := range schema.Fields {
 					_, exists := col.GetFieldByName(globalField.Name)
 					if !exists {
@@ -503,6 +517,13 @@ func (db *db) updateSchema(
 			return err
 		}
 
+		for _, col := range cols {
+			err = db.dropIndexesOnChangedFields(ctx, col.ID, previousSchema, schema)
+			if err != nil {
+				return err
+			}
+		}
+
 		for _, def := range definitions {
 			_, err = description.SaveCollection(ctx, txn, def.Description)
 			if err != nil {
@@ -530,6 +551,43 @@ func (db *db) updateSchema(
 	return nil
 }
 
+// dropIndexesOnChangedFields drops the indexes of the given collection that index fields
+// removed from, or with a kind changed by, the new schema version.
+func (db *db) dropIndexesOnChangedFields(
+	ctx context.Context,
+	collectionID uint32,
+	previousSchema client.SchemaDescription,
+	schema client.SchemaDescription,
+) error {
+	col, err := db.getCollectionByID(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+
+	// dropping an index removes it from the description, so we iterate over a copy
+	for _, index := range slices.Clone(col.Description().Indexes) {
+		for _, indexedField := range index.Fields {
+			previousField, wasOnSchema := previousSchema.GetFieldByName(indexedField.Name)
+			if !wasOnSchema {
+				continue
+			}
+
+			field, isOnSchema := schema.GetFieldByName(indexedField.Name)
+			if isOnSchema && field.Kind == previousField.Kind {
+				continue
+			}
+
+			err = col.(*collection).dropIndex(ctx, index.Name)
+			if err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
 func areSchemasEqual(this client.SchemaDescription, that client.SchemaDescription) bool {
 	if len(this.Fields) != len(that.Fields) {
 		return false
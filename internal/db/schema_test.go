@@ -0,0 +1,85 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lens-vm/lens/host-go/config/model"
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+func TestPatchSchema_WithRemovedAndChangedFields_DropsTheirIndexes(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String @index
+		age: Int @index
+		email: String @index
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 21, "email": "john@source.hub"}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	indexes, err := col.GetIndexes(ctx)
+	require.NoError(t, err)
+	indexIDsByField := map[string]uint32{}
+	for _, index := range indexes {
+		indexIDsByField[index.Fields[0].Name] = index.ID
+	}
+
+	// Fields are ordered by name: _docID, age, email, name
+	err = db.PatchSchema(
+		ctx,
+		`[
+			{ "op": "remove", "path": "/User/Fields/3" },
+			{ "op": "replace", "path": "/User/Fields/1/Kind", "value": "Float" }
+		]`,
+		immutable.None[model.Lens](),
+		true,
+	)
+	require.NoError(t, err)
+
+	previousCol, err := db.GetCollections(
+		ctx,
+		client.CollectionFetchOptions{
+			SchemaVersionID: immutable.Some(col.Schema().VersionID),
+			IncludeInactive: immutable.Some(true),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, previousCol, 1)
+
+	indexes, err = previousCol[0].GetIndexes(ctx)
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	require.Equal(t, "email", indexes[0].Fields[0].Name)
+
+	for _, field := range []string{"name", "age"} {
+		indexKey := core.IndexDataStoreKey{CollectionID: col.ID(), IndexID: indexIDsByField[field]}
+		require.Zero(t, countKeysWithPrefix(t, ctx, db.multistore.Datastore(), indexKey.ToString()))
+	}
+}
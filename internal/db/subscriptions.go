@@ -67,7 +67,14 @@ func (db *db) handleSubscription(ctx context.Context, r *request.Request) (<-cha
 			ctx := SetContextTxn(ctx, txn)
 			identity := GetContextIdentity(ctx)
 
-			p := planner.New(ctx, identity, db.acp, db, txn)
+			p := planner.New(
+				ctx,
+				identity,
+				db.acp,
+				db,
+				txn,
+				planner.WithSortMemoryBudget(db.sortMemoryBudget),
+			)
 			s := subRequest.ToSelect(evt.DocID, evt.Cid.String())
 
 			result, err := p.RunSelection(ctx, s)
@@ -146,7 +146,14 @@ func (db *db) buildViewCache(ctx context.Context, col client.CollectionDefinitio
 	txn := mustGetContextTxn(ctx)
 	identity := GetContextIdentity(ctx)
 
-	p := planner.New(ctx, identity, db.acp, db, txn)
+	p := planner.New(
+		ctx,
+		identity,
+		db.acp,
+		db,
+		txn,
+		planner.WithSortMemoryBudget(db.sortMemoryBudget),
+	)
 
 	// temporarily disable the cache in order to query without using it
 	col.Description.IsMaterialized = false
@@ -22,6 +22,7 @@ import (
 
 	"github.com/sourcenetwork/corelog"
 
+	"github.com/sourcenetwork/defradb/acp/identity"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/internal/core"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
@@ -103,6 +104,13 @@ func (mc *MerkleClock) AddDelta(
 		}
 	}
 
+	if dagBlock.Delta.IsComposite() {
+		err = signBlock(ctx, dagBlock)
+		if err != nil {
+			return cidlink.Link{}, nil, err
+		}
+	}
+
 	link, err := mc.putBlock(ctx, dagBlock)
 	if err != nil {
 		return cidlink.Link{}, nil, err
@@ -165,6 +173,18 @@ func encryptBlock(ctx context.Context, block *coreblock.Block) (*coreblock.Block
 	return &coreblock.Block{Delta: clonedCRDT, Links: block.Links, IsEncrypted: &isEncrypted}, nil
 }
 
+// signBlock signs the given block with the identity of the given context.
+//
+// The block is left unsigned if the context has no identity or if the identity
+// does not hold a private key, for example when it was created from a bearer token.
+func signBlock(ctx context.Context, block *coreblock.Block) error {
+	ident := identity.FromContext(ctx)
+	if !ident.HasValue() || ident.Value().PrivateKey == nil {
+		return nil
+	}
+	return block.Sign(ident.Value().PrivateKey)
+}
+
 // ProcessBlock merges the delta CRDT and updates the state accordingly.
 // If onlyHeads is true, it will skip merging and update only the heads.
 func (mc *MerkleClock) ProcessBlock(
@@ -227,6 +247,13 @@ func (mc *MerkleClock) updateHeads(
 		if err != nil {
 			return NewErrCouldNotFindBlock(linkCid, err)
 		}
+		if !known {
+			// blocks removed by a compaction are part of the known tree too
+			known, err = mc.blockstore.IsCompacted(ctx, linkCid)
+			if err != nil {
+				return NewErrCouldNotFindBlock(linkCid, err)
+			}
+		}
 		if known {
 			// we reached a non-head node in the known tree.
 			// This means our root block is a new head
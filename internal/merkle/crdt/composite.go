@@ -67,6 +67,14 @@ func (m *MerkleCompositeDAG) Delete(
 	return link, b, nil
 }
 
+// Purge adds the tombstone of a purged document to the DAG.
+//
+// The tombstone has no links as the history of the document is removed along with it.
+func (m *MerkleCompositeDAG) Purge(ctx context.Context) (cidlink.Link, []byte, error) {
+	delta := m.reg.Set(client.Purged)
+	return m.clock.AddDelta(ctx, delta)
+}
+
 // Save the value of the composite CRDT to DAG.
 func (m *MerkleCompositeDAG) Save(ctx context.Context, data any) (cidlink.Link, []byte, error) {
 	links, ok := data.([]coreblock.DAGLink)
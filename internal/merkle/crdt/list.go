@@ -0,0 +1,63 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package merklecrdt
+
+import (
+	"context"
+
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
+)
+
+// MerkleList is a MerkleCRDT implementation of the List using MerkleClocks.
+type MerkleList struct {
+	*baseMerkleCRDT
+
+	reg crdt.List
+}
+
+// NewMerkleList creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by a List CRDT.
+func NewMerkleList(
+	store Stores,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) *MerkleList {
+	register := crdt.NewList(store.Datastore(), schemaVersionKey, key, fieldName)
+	clk := clock.NewMerkleClock(store.Headstore(), store.Blockstore(), key.ToHeadStoreKey(), register)
+	base := &baseMerkleCRDT{clock: clk, crdt: register}
+	return &MerkleList{
+		baseMerkleCRDT: base,
+		reg:            register,
+	}
+}
+
+// Save the value of the List to the DAG.
+func (m *MerkleList) Save(ctx context.Context, data any) (cidlink.Link, []byte, error) {
+	value, ok := data.(*DocField)
+	if !ok {
+		return cidlink.Link{}, nil, NewErrUnexpectedValueType(m.reg.CType(), &client.FieldValue{}, data)
+	}
+	bytes, err := value.FieldValue.Bytes()
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	delta, err := m.reg.Set(ctx, bytes)
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	return m.clock.AddDelta(ctx, delta)
+}
@@ -99,6 +99,27 @@ func InstanceWithStore(
 			cType == client.PN_COUNTER,
 			kind.(client.ScalarKind),
 		), nil
+	case client.OR_SET:
+		return NewMerkleORSet(
+			store,
+			schemaVersionKey,
+			key,
+			fieldName,
+		), nil
+	case client.LIST:
+		return NewMerkleList(
+			store,
+			schemaVersionKey,
+			key,
+			fieldName,
+		), nil
+	case client.BOUNDED_COUNTER:
+		return NewMerkleBoundedCounter(
+			store,
+			schemaVersionKey,
+			key,
+			fieldName,
+		), nil
 	case client.COMPOSITE:
 		return NewMerkleCompositeDAG(
 			store,
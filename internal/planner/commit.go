@@ -11,11 +11,15 @@
 package planner
 
 import (
+	"encoding/hex"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	cid "github.com/ipfs/go-cid"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 
 	"github.com/sourcenetwork/immutable"
 
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/internal/core"
@@ -394,9 +398,63 @@ func (n *dagScanNode) dagBlockToNodeDoc(block *coreblock.Block) (core.Doc, []cid
 		commit.Fields[linksIndex] = links
 	}
 
+	// signature
+	signatureIndexes := n.commitSelect.DocumentMapping.IndexesByName[request.SignatureFieldName]
+
+	for _, signatureIndex := range signatureIndexes {
+		if block.Signature == nil {
+			commit.Fields[signatureIndex] = nil
+			continue
+		}
+		signatureMapping := n.commitSelect.DocumentMapping.ChildMappings[signatureIndex]
+
+		// the identity of the signer is exposed as its DID, in the same way as ACP identifies actors
+		pubKey, err := secp256k1.ParsePubKey(block.Signature.Header.Identity)
+		if err != nil {
+			return core.Doc{}, nil, err
+		}
+		did, err := acpIdentity.DIDFromPublicKey(pubKey)
+		if err != nil {
+			return core.Doc{}, nil, err
+		}
+
+		signature := signatureMapping.NewDoc()
+		signatureMapping.SetFirstOfName(&signature, request.SignatureTypeFieldName, block.Signature.Header.Type)
+		signatureMapping.SetFirstOfName(&signature, request.SignatureIdentityFieldName, did)
+		signatureMapping.SetFirstOfName(
+			&signature,
+			request.SignatureValueFieldName,
+			hex.EncodeToString(block.Signature.Value),
+		)
+
+		commit.Fields[signatureIndex] = signature
+	}
+
 	for _, l := range block.Links {
-		if l.Name == "_head" {
+		if l.Name != "_head" {
+			continue
+		}
+		compacted, err := n.planner.txn.Blockstore().IsCompacted(n.planner.ctx, l.Link.Cid)
+		if err != nil {
+			return core.Doc{}, nil, err
+		}
+		if !compacted {
 			heads = append(heads, l.Link)
+			continue
+		}
+		// The history of the composite DAG that was removed by a compaction is
+		// collapsed into the snapshot block of the document.
+		if block.Delta.CompositeDAGDelta != nil {
+			snapshotKey := core.NewCompactionSnapshotKey(string(docID))
+			data, err := n.planner.txn.Systemstore().Get(n.planner.ctx, snapshotKey.ToDS())
+			if err != nil {
+				return core.Doc{}, nil, err
+			}
+			snapshot, err := cid.Cast(data)
+			if err != nil {
+				return core.Doc{}, nil, err
+			}
+			heads = append(heads, cidlink.Link{Cid: snapshot})
 		}
 	}
 
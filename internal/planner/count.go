@@ -15,7 +15,9 @@ package planner
 // aggregates in.
 
 import (
+	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/sourcenetwork/immutable"
 	"github.com/sourcenetwork/immutable/enumerable"
@@ -138,38 +140,42 @@ func (n *countNode) Next() (bool, error) {
 		switch v.Kind() {
 		// v.Len will panic if v is not one of these types, we don't want it to panic
 		case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
-			if source.Filter == nil && source.Limit == nil {
+			if source.Filter == nil && source.Limit == nil && !source.Distinct && !source.ChildTarget.HasValue {
 				count = count + v.Len()
 			} else {
 				var arrayCount int
 				var err error
 				switch array := property.(type) {
 				case []core.Doc:
-					arrayCount = countDocs(array)
+					if source.ChildTarget.HasValue {
+						arrayCount = countDocValues(array, source.ChildTarget.Index, source.Distinct)
+					} else {
+						arrayCount = countDocs(array)
+					}
 
 				case []bool:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, &source)
 
 				case []immutable.Option[bool]:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, &source)
 
 				case []int64:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, &source)
 
 				case []immutable.Option[int64]:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, &source)
 
 				case []float64:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, &source)
 
 				case []immutable.Option[float64]:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, &source)
 
 				case []string:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, &source)
 
 				case []immutable.Option[string]:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, &source)
 				}
 				if err != nil {
 					return false, err
@@ -197,17 +203,55 @@ func countDocs(docs []core.Doc) int {
 	return count
 }
 
-func countItems[T any](source []T, filter *mapper.Filter, limit *mapper.Limit) (int, error) {
+// countDocValues counts the number of non-nil values of the given field in a slice of
+// documents, skipping over hidden items (a grouping mechanic).
+//
+// If distinct is true, each distinct value is only counted once.
+func countDocValues(docs []core.Doc, fieldIndex int, distinct bool) int {
+	values := map[any]struct{}{}
+	count := 0
+	for _, doc := range docs {
+		if doc.Hidden {
+			continue
+		}
+		value := distinctValue(doc.Fields[fieldIndex])
+		if value == nil {
+			continue
+		}
+		if distinct {
+			values[value] = struct{}{}
+		} else {
+			count += 1
+		}
+	}
+
+	if distinct {
+		return len(values)
+	}
+	return count
+}
+
+func countItems[T any](source []T, aggregateTarget *mapper.AggregateTarget) (int, error) {
 	items := enumerable.New(source)
-	if filter != nil {
+	if aggregateTarget.Filter != nil {
 		items = enumerable.Where(items, func(item T) (bool, error) {
-			return mapper.RunFilter(item, filter)
+			return mapper.RunFilter(item, aggregateTarget.Filter)
 		})
 	}
 
-	if limit != nil {
-		items = enumerable.Skip(items, limit.Offset)
-		items = enumerable.Take(items, limit.Limit)
+	if aggregateTarget.Limit != nil {
+		items = enumerable.Skip(items, aggregateTarget.Limit.Offset)
+		items = enumerable.Take(items, aggregateTarget.Limit.Limit)
+	}
+
+	if aggregateTarget.Distinct {
+		values := map[any]struct{}{}
+		err := enumerable.ForEach(items, func(item T) {
+			if value := distinctValue(item); value != nil {
+				values[value] = struct{}{}
+			}
+		})
+		return len(values), err
 	}
 
 	count := 0
@@ -218,4 +262,38 @@ func countItems[T any](source []T, filter *mapper.Filter, limit *mapper.Limit) (
 	return count, err
 }
 
+// distinctValue returns the value by which the given item is distinguished from others,
+// or nil if the item has no value.
+func distinctValue(item any) any {
+	switch v := item.(type) {
+	case immutable.Option[bool]:
+		return optionValue(v)
+	case immutable.Option[int64]:
+		return optionValue(v)
+	case immutable.Option[float64]:
+		return optionValue(v)
+	case immutable.Option[string]:
+		return optionValue(v)
+	case time.Time:
+		// Times are compared by their instant and not by their location.
+		return v.UTC()
+	case nil:
+		return nil
+	default:
+		if !reflect.TypeOf(item).Comparable() {
+			// Values that can not be compared, such as inline arrays, are
+			// distinguished by their formatted value.
+			return fmt.Sprint(item)
+		}
+		return item
+	}
+}
+
+func optionValue[T any](option immutable.Option[T]) any {
+	if !option.HasValue() {
+		return nil
+	}
+	return option.Value()
+}
+
 func (n *countNode) SetPlan(p planNode) { n.plan = p }
@@ -10,13 +10,18 @@
 
 package planner
 
-import "github.com/sourcenetwork/defradb/errors"
+import (
+	"fmt"
+
+	"github.com/sourcenetwork/defradb/errors"
+)
 
 const (
 	errUnknownDependency              string = "given field does not exist"
 	errFailedToClosePlan              string = "failed to close the plan"
 	errFailedToCollectExecExplainInfo string = "failed to collect execution explain information"
 	errSubTypeInit                    string = "sub-type initialization error at scan node reset"
+	errUnsupportedSortValue           string = "can not spill a value of this type while sorting"
 )
 
 var (
@@ -34,6 +39,8 @@ var (
 	ErrMissingChildValue                   = errors.New("expected child value, however none was yielded")
 	ErrUnknownRelationType                 = errors.New("failed sub selection, unknown relation type")
 	ErrUnknownExplainRequestType           = errors.New("can not explain request of unknown type")
+	ErrIncomparableAggregateValues         = errors.New("can not compare aggregate values of different types")
+	ErrInvalidSpilledSortValue             = errors.New("invalid value in a spilled sort run")
 )
 
 func NewErrUnknownDependency(name string) error {
@@ -51,3 +58,7 @@ func NewErrFailedToCollectExecExplainInfo(inner error) error {
 func NewErrSubTypeInit(inner error) error {
 	return errors.Wrap(errSubTypeInit, inner)
 }
+
+func NewErrUnsupportedSortValue(value any) error {
+	return errors.New(errUnsupportedSortValue, errors.NewKV("Type", fmt.Sprintf("%T", value)))
+}
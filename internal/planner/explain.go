@@ -39,7 +39,10 @@ var (
 	_ explainablePlanNode = (*deleteNode)(nil)
 	_ explainablePlanNode = (*groupNode)(nil)
 	_ explainablePlanNode = (*limitNode)(nil)
+	_ explainablePlanNode = (*maxNode)(nil)
+	_ explainablePlanNode = (*minNode)(nil)
 	_ explainablePlanNode = (*orderNode)(nil)
+	_ explainablePlanNode = (*purgeNode)(nil)
 	_ explainablePlanNode = (*scanNode)(nil)
 	_ explainablePlanNode = (*selectNode)(nil)
 	_ explainablePlanNode = (*selectTopNode)(nil)
@@ -47,6 +50,7 @@ var (
 	_ explainablePlanNode = (*topLevelNode)(nil)
 	_ explainablePlanNode = (*typeIndexJoin)(nil)
 	_ explainablePlanNode = (*updateNode)(nil)
+	_ explainablePlanNode = (*upsertNode)(nil)
 )
 
 const (
@@ -56,6 +60,7 @@ const (
 	inputLabel          = "input"
 	fieldNameLabel      = "fieldName"
 	filterLabel         = "filter"
+	indexLabel          = "index"
 	joinRootLabel       = "root"
 	joinSubTypeLabel    = "subType"
 	limitLabel          = "limit"
@@ -265,6 +265,7 @@ func (n *groupNode) simpleExplain() (map[string]any, error) {
 
 						orderFieldNames = append(orderFieldNames, fieldName)
 					}
+					orderFieldNames = append(orderFieldNames, condition.JSONPath...)
 					// Put it all together for this order element.
 					innerOrderings = append(innerOrderings,
 						map[string]any{
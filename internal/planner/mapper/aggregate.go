@@ -36,6 +36,9 @@ type AggregateTarget struct {
 	// This may be empty if the aggregate targets a whole collection (e.g. Count),
 	// or if `HostIndex` is an inline array.
 	ChildTarget OptionalChildTarget
+
+	// Distinct is true if only the distinct values of the target should be aggregated.
+	Distinct bool
 }
 
 // Aggregate represents an aggregate operation definition.
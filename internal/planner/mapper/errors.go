@@ -13,8 +13,9 @@ package mapper
 import "github.com/sourcenetwork/defradb/errors"
 
 const (
-	errInvalidFieldToGroupBy string = "invalid field value to groupBy"
-	errTypeNotFound          string = "type not found"
+	errInvalidFieldToGroupBy      string = "invalid field value to groupBy"
+	errTypeNotFound               string = "type not found"
+	errOrderByUnselectedAggregate string = "aggregates can only be ordered by when they are selected"
 )
 
 var (
@@ -33,3 +34,7 @@ func NewErrInvalidFieldToGroupBy(field string) error {
 func NewErrTypeNotFound(name string) error {
 	return errors.New(errTypeNotFound, errors.NewKV("Type", name))
 }
+
+func NewErrOrderByUnselectedAggregate(name string) error {
+	return errors.New(errOrderByUnselectedAggregate, errors.NewKV("Name", name))
+}
@@ -13,6 +13,7 @@ package mapper
 import (
 	"context"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/sourcenetwork/immutable"
@@ -238,8 +239,20 @@ func resolveOrderDependencies(
 outer:
 	for _, condition := range source.Value().Conditions {
 		fields := condition.Fields[:] // copy slice
+		collectionName := descName
 		for {
 			numFields := len(fields)
+			if numFields >= 2 && rootSelectType == ObjectSelection {
+				isJSON, err := isJSONField(ctx, store, collectionName, fields[0])
+				if err != nil {
+					return err
+				}
+				if isJSON {
+					// The remaining fields are a path within the JSON value, only the
+					// JSON field itself needs to be selected.
+					numFields = 1
+				}
+			}
 			// <2 fields: Direct field on the root type: {age: DESC}
 			// 2 fields: Single depth related type: {author: {age: DESC}}
 			// >2 fields: Multi depth related type: {author: {friends: {age: DESC}}}
@@ -279,10 +292,19 @@ outer:
 				}
 				mapping = innerSelect.DocumentMapping
 				currentExistingFields = &innerSelect.Fields
+				collectionName = innerSelect.CollectionName
 				fields = fields[1:] // chop off the front item, and loop again on inner
 			} else { // <= 1
 				targetFieldName := fields[0]
-				*existingFields = append(*existingFields, &Field{
+				if _, isAggregate := request.Aggregates[targetFieldName]; isAggregate {
+					// Aggregates can only be ordered by if they are selected, in which case
+					// they are already mapped and will be added to the fields when resolved.
+					if len(mapping.IndexesByName[targetFieldName]) == 0 {
+						return NewErrOrderByUnselectedAggregate(targetFieldName)
+					}
+					continue outer
+				}
+				*currentExistingFields = append(*currentExistingFields, &Field{
 					Index: mapping.FirstIndexOfName(targetFieldName),
 					Name:  targetFieldName,
 				})
@@ -295,6 +317,40 @@ outer:
 	return nil
 }
 
+// isJSONField returns true if the given field of the given collection is a JSON field.
+func isJSONField(
+	ctx context.Context,
+	store client.Store,
+	collectionName string,
+	fieldName string,
+) (bool, error) {
+	var definition client.CollectionDefinition
+	collection, err := store.GetCollectionByName(ctx, collectionName)
+	if err == nil {
+		definition = collection.Definition()
+	} else {
+		// If the collection is not found this must be an embedded object, which
+		// only has a schema.
+		schemas, err := store.GetSchemas(
+			ctx,
+			client.SchemaFetchOptions{
+				Name: immutable.Some(collectionName),
+			},
+		)
+		if err != nil {
+			return false, err
+		}
+		if len(schemas) == 0 {
+			return false, NewErrTypeNotFound(collectionName)
+		}
+		definition = client.CollectionDefinition{
+			Schema: schemas[0],
+		}
+	}
+	field, ok := definition.GetFieldByName(fieldName)
+	return ok && field.Kind == client.FieldKind_NILLABLE_JSON, nil
+}
+
 // given a type join field, ensure its mapping exists
 // and add a coorsponding select field(s)
 func resolveChildOrder(
@@ -358,6 +414,7 @@ func resolveAggregates(
 ) ([]Requestable, error) {
 	fields := inputFields
 	dependenciesByParentId := map[int][]int{}
+	isTopLevel := collectionName == topLevelCollectionName
 	for _, aggregate := range aggregates {
 		aggregateTargets := make([]AggregateTarget, len(aggregate.targets))
 
@@ -433,6 +490,12 @@ func resolveAggregates(
 				if err != nil {
 					return nil, err
 				}
+				if isTopLevel && rootSelectType == ObjectSelection {
+					err = targetIndexEndpoint(ctx, store, aggregate.field.Name, target, childCollectionName)
+					if err != nil {
+						return nil, err
+					}
+				}
 				mapAggregateNestedTargets(target, hostSelectRequest)
 
 				childMapping, _, err := getTopLevelInfo(ctx, store, rootSelectType, hostSelectRequest, childCollectionName)
@@ -546,6 +609,7 @@ func resolveAggregates(
 			aggregateTargets[i] = AggregateTarget{
 				Targetable:  *hostTarget,
 				ChildTarget: childTarget,
+				Distinct:    target.distinct,
 			}
 		}
 
@@ -902,6 +966,8 @@ func getTopLevelInfo(
 		mapping.SetTypeName(collectionName)
 
 		mapping.Add(mapping.GetNextIndex(), request.DeletedFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.AllowanceFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.ScoreFieldName)
 
 		return mapping, definition, nil
 	}
@@ -914,6 +980,14 @@ func getTopLevelInfo(
 		// Setting the type name must be done after adding the fields, as
 		// the typeName index is dynamic, but the field indexes are not
 		mapping.SetTypeName(request.LinksFieldName)
+	} else if selectRequest.Name == request.SignatureFieldName {
+		for i, f := range request.SignatureFields {
+			mapping.Add(i, f)
+		}
+
+		// Setting the type name must be done after adding the fields, as
+		// the typeName index is dynamic, but the field indexes are not
+		mapping.SetTypeName(request.SignatureFieldName)
 	} else {
 		for i, f := range request.VersionFields {
 			mapping.Add(i, f)
@@ -1247,6 +1321,8 @@ func toMutation(
 		Type:          MutationType(mutationRequest.Type),
 		Input:         mutationRequest.Input,
 		Inputs:        mutationRequest.Inputs,
+		CreateInput:   mutationRequest.CreateInput,
+		UpdateInput:   mutationRequest.UpdateInput,
 		Encrypt:       mutationRequest.Encrypt,
 		EncryptFields: mutationRequest.EncryptFields,
 	}, nil
@@ -1347,6 +1423,15 @@ func toFilterMap(
 		case map[string]any:
 			returnClause := map[connor.FilterKey]any{}
 			for innerSourceKey, innerSourceValue := range typedClause {
+				if !strings.HasPrefix(innerSourceKey, "_") {
+					if childMapping, _ := tryGetChildMapping(mapping, index); childMapping == nil {
+						// If the property has no child mapping it is not a join, and the key
+						// must refer to a property within the JSON value of the host property.
+						rKey, rValue := toJSONFilterMap(innerSourceKey, innerSourceValue)
+						returnClause[rKey] = rValue
+						continue
+					}
+				}
 				var innerMapping *core.DocumentMapping
 				switch innerSourceValue.(type) {
 				case map[string]any:
@@ -1367,6 +1452,31 @@ func toFilterMap(
 	}
 }
 
+// toJSONFilterMap converts a filter condition targeting a property within a JSON value
+// into a connor compatible filter.
+func toJSONFilterMap(sourceKey string, sourceClause any) (connor.FilterKey, any) {
+	var key connor.FilterKey
+	if strings.HasPrefix(sourceKey, "_") {
+		key = &Operator{
+			Operation: sourceKey,
+		}
+	} else {
+		key = &JSONProperty{
+			Name: sourceKey,
+		}
+	}
+	typedClause, isMap := sourceClause.(map[string]any)
+	if !isMap {
+		return key, sourceClause
+	}
+	returnClause := map[connor.FilterKey]any{}
+	for innerSourceKey, innerSourceValue := range typedClause {
+		rKey, rValue := toJSONFilterMap(innerSourceKey, innerSourceValue)
+		returnClause[rKey] = rValue
+	}
+	return key, returnClause
+}
+
 func toLimit(limit immutable.Option[uint64], offset immutable.Option[uint64]) *Limit {
 	var limitValue uint64
 	var offsetValue uint64
@@ -1419,7 +1529,8 @@ func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentM
 
 	conditions := make([]OrderCondition, len(source.Value().Conditions))
 	for conditionIndex, condition := range source.Value().Conditions {
-		fieldIndexes := make([]int, len(condition.Fields))
+		fieldIndexes := make([]int, 0, len(condition.Fields))
+		var jsonPath []string
 		currentMapping := mapping
 		for fieldIndex, field := range condition.Fields {
 			// If there are multiple properties of the same name we can just take the first as
@@ -1427,15 +1538,24 @@ func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentM
 			// consumer specified requestables are available.  Aggregate dependencies should not
 			// impact this as they are added after selects.
 			firstFieldIndex := currentMapping.FirstIndexOfName(field)
-			fieldIndexes[fieldIndex] = firstFieldIndex
-			if fieldIndex != len(condition.Fields)-1 {
+			fieldIndexes = append(fieldIndexes, firstFieldIndex)
+			if fieldIndex == len(condition.Fields)-1 {
 				// no need to do this for the last (and will panic)
-				currentMapping = currentMapping.ChildMappings[firstFieldIndex]
+				break
 			}
+			childMapping, _ := tryGetChildMapping(currentMapping, firstFieldIndex)
+			if childMapping == nil {
+				// If the property is not a join, the remaining fields are the
+				// path to the property to sort by within its JSON value.
+				jsonPath = condition.Fields[fieldIndex+1:]
+				break
+			}
+			currentMapping = childMapping
 		}
 
 		conditions[conditionIndex] = OrderCondition{
 			FieldIndexes: fieldIndexes,
+			JSONPath:     jsonPath,
 			Direction:    SortDirection(condition.Direction),
 		}
 	}
@@ -1571,6 +1691,10 @@ func (o *OrderBy) equal(other *OrderBy) bool {
 				return false
 			}
 		}
+
+		if !slices.Equal(conditionA.JSONPath, conditionB.JSONPath) {
+			return false
+		}
 	}
 
 	return true
@@ -1615,6 +1739,9 @@ type aggregateRequestTarget struct {
 	// The order in which items should be aggregated. Affects results when used with
 	// limit. Optional.
 	order immutable.Option[request.OrderBy]
+
+	// Whether only distinct values should be aggregated.
+	distinct bool
 }
 
 // Returns the source of the aggregate as requested by the consumer
@@ -1628,6 +1755,7 @@ func getAggregateSources(field *request.Aggregate) ([]*aggregateRequestTarget, e
 			filter:            target.Filter,
 			limit:             toLimit(target.Limit, target.Offset),
 			order:             target.OrderBy,
+			distinct:          target.Distinct,
 		}
 	}
 
@@ -1662,6 +1790,10 @@ collectionLoop:
 				continue collectionLoop
 			}
 
+			if target.distinct != potentialMatchingTarget.distinct {
+				continue collectionLoop
+			}
+
 			if !target.filter.HasValue() && potentialMatchingTarget.filter.HasValue() {
 				continue collectionLoop
 			}
@@ -1719,6 +1851,61 @@ func tryGetTarget(
 	return nil, false
 }
 
+// targetIndexEndpoint restricts the given target of a top-level `_min` or `_max`
+// aggregate to the first not nil document in the order of the target field, if the
+// target field is the first field of an index.
+//
+// The document is then fetched from the end of the index instead of aggregating all
+// the documents of the collection.
+func targetIndexEndpoint(
+	ctx context.Context,
+	store client.Store,
+	aggregateName string,
+	target *aggregateRequestTarget,
+	collectionName string,
+) error {
+	if aggregateName != request.MinFieldName && aggregateName != request.MaxFieldName {
+		return nil
+	}
+	if target.childExternalName == "" || target.limit != nil || target.order.HasValue() {
+		return nil
+	}
+	if _, isAggregate := request.Aggregates[target.childExternalName]; isAggregate {
+		return nil
+	}
+
+	collection, err := store.GetCollectionByName(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+	isIndexed := false
+	for _, index := range collection.Description().GetIndexesOnField(target.childExternalName) {
+		if !index.IsFullText() {
+			isIndexed = true
+			break
+		}
+	}
+	if !isIndexed {
+		return nil
+	}
+
+	direction := request.ASC
+	if aggregateName == request.MaxFieldName {
+		direction = request.DESC
+	}
+	target.order = immutable.Some(request.OrderBy{
+		Conditions: []request.OrderCondition{
+			{
+				Fields:    []string{target.childExternalName},
+				Direction: direction,
+			},
+		},
+	})
+	target.limit = &Limit{Limit: 1}
+	appendNotNilFilter(target, target.childExternalName)
+	return nil
+}
+
 // appendNotNilFilter appends a not nil filter for the given child field
 // to the given Select.
 func appendNotNilFilter(field *aggregateRequestTarget, childField string) {
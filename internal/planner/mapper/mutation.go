@@ -17,6 +17,8 @@ const (
 	CreateObjects
 	UpdateObjects
 	DeleteObjects
+	UpsertObjects
+	PurgeObjects
 )
 
 // Mutation represents a request to mutate data stored in Defra.
@@ -33,6 +35,14 @@ type Mutation struct {
 	// Inputs is the array of maps of fields and values used for the mutation.
 	Inputs []map[string]any
 
+	// CreateInput is the map of fields and values used to create a document if none
+	// match the filter of an upsert request.
+	CreateInput map[string]any
+
+	// UpdateInput is the map of fields and values used to update the document matching
+	// the filter of an upsert request.
+	UpdateInput map[string]any
+
 	// Encrypt is a flag to indicate if the input data should be encrypted.
 	Encrypt bool
 
@@ -11,6 +11,8 @@
 package mapper
 
 import (
+	"encoding/json"
+
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client/request"
@@ -21,6 +23,7 @@ import (
 var (
 	_ connor.FilterKey = (*PropertyIndex)(nil)
 	_ connor.FilterKey = (*Operator)(nil)
+	_ connor.FilterKey = (*JSONProperty)(nil)
 )
 
 // PropertyIndex is a FilterKey that represents a property in a document.
@@ -71,6 +74,62 @@ func (k *Operator) Equal(other connor.FilterKey) bool {
 	return false
 }
 
+// JSONProperty is a FilterKey that represents a property within a JSON value.
+type JSONProperty struct {
+	// The name of the target property within its parent JSON object.
+	Name string
+}
+
+func (k *JSONProperty) GetProp(data any) any {
+	return GetJSONPathValue(data, []string{k.Name})
+}
+
+func (k *JSONProperty) GetOperatorOrDefault(defaultOp string) string {
+	return defaultOp
+}
+
+func (k *JSONProperty) Equal(other connor.FilterKey) bool {
+	if otherKey, isOk := other.(*JSONProperty); isOk && *k == *otherKey {
+		return true
+	}
+	return false
+}
+
+// GetJSONPathValue returns the value found at the given path within a JSON value.
+//
+// The JSON value may be given either as a JSON string, as held by JSON fields, or as
+// a decoded JSON object. Nil is returned if the path does not exist.
+func GetJSONPathValue(data any, path []string) any {
+	for _, name := range path {
+		switch typedData := data.(type) {
+		case immutable.Option[string]:
+			if !typedData.HasValue() {
+				return nil
+			}
+			data = decodeJSON(typedData.Value())
+		case string:
+			data = decodeJSON(typedData)
+		}
+
+		obj, ok := data.(map[string]any)
+		if !ok {
+			return nil
+		}
+		data = obj[name]
+	}
+	return data
+}
+
+// decodeJSON decodes the given JSON string, returning nil if it is not valid JSON.
+func decodeJSON(value string) any {
+	var result any
+	err := json.Unmarshal([]byte(value), &result)
+	if err != nil {
+		return nil
+	}
+	return result
+}
+
 // Filter represents a series of conditions that may reduce the number of
 // records that a request returns.
 type Filter struct {
@@ -119,6 +178,14 @@ func filterObjectToMap(mapping *core.DocumentMapping, obj map[connor.FilterKey]a
 				outmap[outkey] = filterObjectToMap(mapping, subObj)
 			}
 
+		case *JSONProperty:
+			subObj, isObj := v.(map[connor.FilterKey]any)
+			if isObj {
+				outmap[keyType.Name] = filterObjectToMap(mapping, subObj)
+			} else {
+				outmap[keyType.Name] = v
+			}
+
 		case *Operator:
 			switch keyType.Operation {
 			case request.FilterOpAnd, request.FilterOpOr:
@@ -179,6 +246,10 @@ type OrderCondition struct {
 	// multiple object layers.
 	FieldIndexes []int
 
+	// An optional path to the property to sort by within the JSON value found at
+	// FieldIndexes.
+	JSONPath []string
+
 	// The direction in which the sort should be applied.
 	Direction SortDirection
 }
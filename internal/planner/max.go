@@ -0,0 +1,110 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+type maxNode struct {
+	documentIterator
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	isFloat           bool
+	virtualFieldIndex int
+	aggregateMapping  []mapper.AggregateTarget
+
+	execInfo maxExecInfo
+}
+
+type maxExecInfo struct {
+	// Total number of times maxNode was executed.
+	iterations uint64
+}
+
+func (p *Planner) Max(
+	field *mapper.Aggregate,
+	parent *mapper.Select,
+) (*maxNode, error) {
+	isFloat, err := p.isAnyValueFloat(parent, field.AggregateTargets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &maxNode{
+		p:                 p,
+		isFloat:           isFloat,
+		aggregateMapping:  field.AggregateTargets,
+		virtualFieldIndex: field.Index,
+		docMapper:         docMapper{field.DocumentMapping},
+	}, nil
+}
+
+func (n *maxNode) Kind() string {
+	return "maxNode"
+}
+
+func (n *maxNode) Init() error {
+	return n.plan.Init()
+}
+
+func (n *maxNode) Start() error { return n.plan.Start() }
+
+func (n *maxNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+
+func (n *maxNode) Close() error { return n.plan.Close() }
+
+func (n *maxNode) Source() planNode { return n.plan }
+
+func (n *maxNode) SetPlan(p planNode) { n.plan = p }
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *maxNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return explainComparableAggregate(n.documentMapping, n.aggregateMapping), nil
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (n *maxNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	hasNext, err := n.plan.Next()
+	if err != nil || !hasNext {
+		return hasNext, err
+	}
+
+	n.currentValue = n.plan.Value()
+
+	value, err := selectComparableValue(n.currentValue, n.aggregateMapping, n.isFloat, func(compare int) bool {
+		return compare > 0
+	})
+	if err != nil {
+		return false, err
+	}
+	n.currentValue.Fields[n.virtualFieldIndex] = value
+
+	return true, nil
+}
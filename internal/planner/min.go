@@ -0,0 +1,321 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/immutable/enumerable"
+
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+type minNode struct {
+	documentIterator
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	isFloat           bool
+	virtualFieldIndex int
+	aggregateMapping  []mapper.AggregateTarget
+
+	execInfo minExecInfo
+}
+
+type minExecInfo struct {
+	// Total number of times minNode was executed.
+	iterations uint64
+}
+
+func (p *Planner) Min(
+	field *mapper.Aggregate,
+	parent *mapper.Select,
+) (*minNode, error) {
+	isFloat, err := p.isAnyValueFloat(parent, field.AggregateTargets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minNode{
+		p:                 p,
+		isFloat:           isFloat,
+		aggregateMapping:  field.AggregateTargets,
+		virtualFieldIndex: field.Index,
+		docMapper:         docMapper{field.DocumentMapping},
+	}, nil
+}
+
+// isAnyValueFloat returns true if the value of any of the given targets is a float.
+func (p *Planner) isAnyValueFloat(parent *mapper.Select, targets []mapper.AggregateTarget) (bool, error) {
+	for _, target := range targets {
+		isTargetFloat, err := p.isValueFloat(parent, &target)
+		if err != nil {
+			return false, err
+		}
+		if isTargetFloat {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (n *minNode) Kind() string {
+	return "minNode"
+}
+
+func (n *minNode) Init() error {
+	return n.plan.Init()
+}
+
+func (n *minNode) Start() error { return n.plan.Start() }
+
+func (n *minNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+
+func (n *minNode) Close() error { return n.plan.Close() }
+
+func (n *minNode) Source() planNode { return n.plan }
+
+func (n *minNode) SetPlan(p planNode) { n.plan = p }
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *minNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return explainComparableAggregate(n.documentMapping, n.aggregateMapping), nil
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (n *minNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	hasNext, err := n.plan.Next()
+	if err != nil || !hasNext {
+		return hasNext, err
+	}
+
+	n.currentValue = n.plan.Value()
+
+	value, err := selectComparableValue(n.currentValue, n.aggregateMapping, n.isFloat, func(compare int) bool {
+		return compare < 0
+	})
+	if err != nil {
+		return false, err
+	}
+	n.currentValue.Fields[n.virtualFieldIndex] = value
+
+	return true, nil
+}
+
+// explainComparableAggregate returns the simple explanation of an aggregate of comparable
+// values with the given targets.
+func explainComparableAggregate(
+	documentMapping *core.DocumentMapping,
+	aggregateMapping []mapper.AggregateTarget,
+) map[string]any {
+	sourceExplanations := make([]map[string]any, len(aggregateMapping))
+
+	for i, source := range aggregateMapping {
+		simpleExplainMap := map[string]any{}
+
+		// Add the filter attribute if it exists.
+		if source.Filter == nil {
+			simpleExplainMap[filterLabel] = nil
+		} else {
+			// get the target aggregate document mapping. Since the filters
+			// are relative to the target aggregate collection (and doc mapper).
+			var targetMap *core.DocumentMapping
+			if source.Index < len(documentMapping.ChildMappings) &&
+				documentMapping.ChildMappings[source.Index] != nil {
+				targetMap = documentMapping.ChildMappings[source.Index]
+			} else {
+				targetMap = documentMapping
+			}
+			simpleExplainMap[filterLabel] = source.Filter.ToMap(targetMap)
+		}
+
+		// Add the main field name.
+		simpleExplainMap[fieldNameLabel] = source.Field.Name
+
+		// Add the child field name if it exists.
+		if source.ChildTarget.HasValue {
+			simpleExplainMap[childFieldNameLabel] = source.ChildTarget.Name
+		} else {
+			simpleExplainMap[childFieldNameLabel] = nil
+		}
+
+		sourceExplanations[i] = simpleExplainMap
+	}
+
+	return map[string]any{
+		sourcesLabel: sourceExplanations,
+	}
+}
+
+// selectComparableValue returns the value targeted by the given aggregate targets that is
+// selected over all the others, that is for which isSelected returns true when given the
+// result of comparing it to any other value.
+//
+// Nil values are skipped, and nil is returned if there are no other values. Integers are
+// converted to floats if isFloat is true.
+func selectComparableValue(
+	doc core.Doc,
+	aggregateMapping []mapper.AggregateTarget,
+	isFloat bool,
+	isSelected func(compare int) bool,
+) (any, error) {
+	var selected any
+	consider := func(value any) error {
+		value = normalizeComparableValue(value, isFloat)
+		if value == nil {
+			return nil
+		}
+		if selected == nil {
+			selected = value
+			return nil
+		}
+		if reflect.TypeOf(value) != reflect.TypeOf(selected) {
+			return ErrIncomparableAggregateValues
+		}
+		if isSelected(base.Compare(value, selected)) {
+			selected = value
+		}
+		return nil
+	}
+
+	for _, source := range aggregateMapping {
+		child := doc.Fields[source.Index]
+		var err error
+		switch childCollection := child.(type) {
+		case []core.Doc:
+			for _, childItem := range childCollection {
+				if childItem.Hidden {
+					continue
+				}
+				err = consider(childItem.Fields[source.ChildTarget.Index])
+				if err != nil {
+					break
+				}
+			}
+
+		case []int64:
+			err = forEachItem(childCollection, &source, lessN[int64], func(item int64) error {
+				return consider(item)
+			})
+
+		case []immutable.Option[int64]:
+			err = forEachItem(childCollection, &source, lessO[int64], func(item immutable.Option[int64]) error {
+				return consider(optionValue(item))
+			})
+
+		case []float64:
+			err = forEachItem(childCollection, &source, lessN[float64], func(item float64) error {
+				return consider(item)
+			})
+
+		case []immutable.Option[float64]:
+			err = forEachItem(childCollection, &source, lessO[float64], func(item immutable.Option[float64]) error {
+				return consider(optionValue(item))
+			})
+
+		case []string:
+			err = forEachItem(childCollection, &source, lessN[string], func(item string) error {
+				return consider(item)
+			})
+
+		case []immutable.Option[string]:
+			err = forEachItem(childCollection, &source, lessO[string], func(item immutable.Option[string]) error {
+				return consider(optionValue(item))
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return selected, nil
+}
+
+// normalizeComparableValue returns the given value in the type it is compared as, or nil
+// if it is not a comparable value.
+func normalizeComparableValue(value any, isFloat bool) any {
+	switch v := value.(type) {
+	case int:
+		return normalizeComparableValue(int64(v), isFloat)
+	case int64:
+		if isFloat {
+			return float64(v)
+		}
+		return v
+	case float64, string, time.Time:
+		return v
+	default:
+		return nil
+	}
+}
+
+// forEachItem calls the given function on each of the given items that match the filter
+// of the given aggregate target, in its order and within its limit.
+func forEachItem[T any](
+	source []T,
+	aggregateTarget *mapper.AggregateTarget,
+	less func(T, T) bool,
+	fn func(T) error,
+) error {
+	items := enumerable.New(source)
+	if aggregateTarget.Filter != nil {
+		items = enumerable.Where(items, func(item T) (bool, error) {
+			return mapper.RunFilter(item, aggregateTarget.Filter)
+		})
+	}
+
+	if aggregateTarget.OrderBy != nil && len(aggregateTarget.OrderBy.Conditions) > 0 {
+		if aggregateTarget.OrderBy.Conditions[0].Direction == mapper.ASC {
+			items = enumerable.Sort(items, less, len(source))
+		} else {
+			items = enumerable.Sort(items, reverse(less), len(source))
+		}
+	}
+
+	if aggregateTarget.Limit != nil {
+		items = enumerable.Skip(items, aggregateTarget.Limit.Offset)
+		items = enumerable.Take(items, aggregateTarget.Limit.Limit)
+	}
+
+	for {
+		hasNext, err := items.Next()
+		if err != nil || !hasNext {
+			return err
+		}
+		item, err := items.Value()
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+}
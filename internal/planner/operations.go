@@ -18,10 +18,13 @@ var (
 	_ planNode = (*deleteNode)(nil)
 	_ planNode = (*groupNode)(nil)
 	_ planNode = (*limitNode)(nil)
+	_ planNode = (*maxNode)(nil)
+	_ planNode = (*minNode)(nil)
 	_ planNode = (*multiScanNode)(nil)
 	_ planNode = (*orderNode)(nil)
 	_ planNode = (*parallelNode)(nil)
 	_ planNode = (*pipeNode)(nil)
+	_ planNode = (*purgeNode)(nil)
 	_ planNode = (*scanNode)(nil)
 	_ planNode = (*selectNode)(nil)
 	_ planNode = (*selectTopNode)(nil)
@@ -31,6 +34,7 @@ var (
 	_ planNode = (*typeJoinMany)(nil)
 	_ planNode = (*typeJoinOne)(nil)
 	_ planNode = (*updateNode)(nil)
+	_ planNode = (*upsertNode)(nil)
 	_ planNode = (*valuesNode)(nil)
 	_ planNode = (*viewNode)(nil)
 	_ planNode = (*lensNode)(nil)
@@ -11,6 +11,8 @@
 package planner
 
 import (
+	"container/heap"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/internal/core"
@@ -35,9 +37,16 @@ type orderingStrategy interface {
 	Add(core.Doc) error
 	// Finish finalizes and applies the actual
 	// ordering mechanism to all the stored data.
-	Finish()
+	Finish() error
 }
 
+// The names of the ordering strategies, as shown in the explain output.
+const (
+	allSortStrategyName      = "allSort"
+	topKSortStrategyName     = "topKSort"
+	externalSortStrategyName = "externalSort"
+)
+
 // order the results
 type orderNode struct {
 	docMapper
@@ -61,12 +70,23 @@ type orderNode struct {
 	// consuming and sorting data.
 	needSort bool
 
+	// indicates if the underlying plan already yields its values
+	// in the requested order, in which case they are not sorted.
+	sortEliminated bool
+
+	// topK is the number of leading documents the results are limited to, if any.
+	// Only these documents are kept and sorted.
+	topK uint64
+
 	execInfo orderExecInfo
 }
 
 type orderExecInfo struct {
 	// Total number of times orderNode was executed.
 	iterations uint64
+
+	// Total number of sorted runs spilled to temporary files.
+	spilledRuns uint64
 }
 
 // OrderBy creates a new orderNode which returns the underlying
@@ -92,8 +112,11 @@ func (n *orderNode) Kind() string {
 
 func (n *orderNode) Init() error {
 	// reset stateful data
-	n.needSort = true
+	n.needSort = !n.sortEliminated
 	n.orderStrategy = nil
+	if n.sortEliminated {
+		n.valueIter = n.plan
+	}
 	return n.plan.Init()
 }
 func (n *orderNode) Start() error { return n.plan.Start() }
@@ -125,6 +148,7 @@ func (n *orderNode) simpleExplain() (map[string]any, error) {
 				}
 			}
 		}
+		fieldNames = append(fieldNames, element.JSONPath...)
 
 		// Put it all together for this order element.
 		orderings = append(orderings,
@@ -136,10 +160,39 @@ func (n *orderNode) simpleExplain() (map[string]any, error) {
 	}
 
 	return map[string]any{
-		"orderings": orderings,
+		"orderings":      orderings,
+		"sortEliminated": n.sortEliminated,
+		"strategy":       n.strategyName(),
 	}, nil
 }
 
+// strategyName returns the name of the strategy used to sort the documents, or nil if
+// they are not sorted.
+func (n *orderNode) strategyName() any {
+	switch {
+	case n.sortEliminated:
+		return nil
+	case n.topK != 0:
+		return topKSortStrategyName
+	case n.p.sortMemoryBudget > 0:
+		return externalSortStrategyName
+	default:
+		return allSortStrategyName
+	}
+}
+
+// newOrderingStrategy returns the strategy used to sort the documents.
+func (n *orderNode) newOrderingStrategy() orderingStrategy {
+	switch {
+	case n.topK != 0:
+		return newTopKSortStrategy(n.ordering, n.topK)
+	case n.p.sortMemoryBudget > 0:
+		return newExternalSortStrategy(n.ordering, n.p.sortMemoryBudget, &n.execInfo)
+	default:
+		return newAllSortStrategy(n.p.newContainerValuesNode(n.ordering))
+	}
+}
+
 // Explain method returns a map containing all attributes of this node that
 // are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
 func (n *orderNode) Explain(explainType request.ExplainType) (map[string]any, error) {
@@ -149,7 +202,9 @@ func (n *orderNode) Explain(explainType request.ExplainType) (map[string]any, er
 
 	case request.ExecuteExplain:
 		return map[string]any{
-			"iterations": n.execInfo.iterations,
+			"iterations":  n.execInfo.iterations,
+			"strategy":    n.strategyName(),
+			"spilledRuns": n.execInfo.spilledRuns,
 		}, nil
 
 	default:
@@ -163,8 +218,7 @@ func (n *orderNode) Next() (bool, error) {
 	for n.needSort {
 		// make sure our orderStrategy is initialized
 		if n.orderStrategy == nil {
-			v := n.p.newContainerValuesNode(n.ordering)
-			n.orderStrategy = newAllSortStrategy(v)
+			n.orderStrategy = n.newOrderingStrategy()
 		}
 
 		// consume data (from plan) (Next / Values())
@@ -173,7 +227,9 @@ func (n *orderNode) Next() (bool, error) {
 			return false, err
 		}
 		if !next {
-			n.orderStrategy.Finish()
+			if err := n.orderStrategy.Finish(); err != nil {
+				return false, err
+			}
 			n.valueIter = n.orderStrategy
 			n.needSort = false
 			break
@@ -198,6 +254,11 @@ func (n *orderNode) Close() error {
 		return err
 	}
 
+	// the values are iterated directly from the plan, which is already closed
+	if n.sortEliminated {
+		return nil
+	}
+
 	if n.valueIter != nil {
 		return n.valueIter.Close()
 	}
@@ -231,8 +292,9 @@ func (s *allSortStrategy) Add(doc core.Doc) error {
 }
 
 // Finish finalizes and sorts the underling valueNode
-func (s *allSortStrategy) Finish() {
+func (s *allSortStrategy) Finish() error {
 	s.valueNode.SortAll()
+	return nil
 }
 
 // Next gets the next doc ready from the underling valueNode
@@ -249,3 +311,122 @@ func (s *allSortStrategy) Value() core.Doc {
 func (s *allSortStrategy) Close() error {
 	return s.valueNode.Close()
 }
+
+// topKSortStrategy keeps only the k leading documents in a heap while
+// consuming the data, then sorts them. Its designed for a known limit.
+type topKSortStrategy struct {
+	ordering []mapper.OrderCondition
+	k        uint64
+
+	// heap holds the leading documents, with the document sorting last at its root.
+	heap orderedDocHeap
+	// added is the number of documents added so far, used to keep the sort stable.
+	added uint64
+
+	docs     []core.Doc
+	docIndex int
+}
+
+func newTopKSortStrategy(ordering []mapper.OrderCondition, k uint64) *topKSortStrategy {
+	return &topKSortStrategy{
+		ordering: ordering,
+		k:        k,
+		heap:     orderedDocHeap{ordering: ordering, reverse: true},
+		docIndex: -1,
+	}
+}
+
+// Add adds a new document to the heap if it is one of the k leading documents.
+func (s *topKSortStrategy) Add(doc core.Doc) error {
+	item := orderedDoc{doc: doc, position: s.added}
+	s.added++
+
+	if uint64(s.heap.Len()) < s.k {
+		item.doc = doc.Clone()
+		heap.Push(&s.heap, item)
+		return nil
+	}
+	// the new document sorts after the root of the heap if they are equal,
+	// as it was added later
+	if docValueLess(s.ordering, doc, s.heap.items[0].doc) {
+		item.doc = doc.Clone()
+		s.heap.items[0] = item
+		heap.Fix(&s.heap, 0)
+	}
+	return nil
+}
+
+// Finish sorts the documents kept in the heap.
+func (s *topKSortStrategy) Finish() error {
+	s.docs = make([]core.Doc, s.heap.Len())
+	for i := len(s.docs) - 1; i >= 0; i-- {
+		s.docs[i] = heap.Pop(&s.heap).(orderedDoc).doc
+	}
+	return nil
+}
+
+// Next moves to the next sorted document.
+func (s *topKSortStrategy) Next() (bool, error) {
+	if s.docIndex >= len(s.docs)-1 {
+		return false, nil
+	}
+	s.docIndex++
+	return true, nil
+}
+
+// Value returns the current sorted document.
+func (s *topKSortStrategy) Value() core.Doc {
+	return s.docs[s.docIndex]
+}
+
+// Close frees the kept documents.
+func (s *topKSortStrategy) Close() error {
+	s.heap.items = nil
+	s.docs = nil
+	return nil
+}
+
+// orderedDoc is a document along with the position it was consumed in, or the index of
+// the sorted run it was read from.
+type orderedDoc struct {
+	doc      core.Doc
+	position uint64
+}
+
+// orderedDocHeap implements heap.Interface for documents sorted by the given ordering.
+//
+// Documents that are equal are sorted by their position, which keeps the sort stable.
+// If reverse is set, the document sorting last is at the root of the heap.
+type orderedDocHeap struct {
+	ordering []mapper.OrderCondition
+	reverse  bool
+	items    []orderedDoc
+}
+
+func (h *orderedDocHeap) Len() int { return len(h.items) }
+
+func (h *orderedDocHeap) Less(i, j int) bool {
+	if h.reverse {
+		i, j = j, i
+	}
+	a, b := h.items[i], h.items[j]
+	if docValueLess(h.ordering, a.doc, b.doc) {
+		return true
+	}
+	if docValueLess(h.ordering, b.doc, a.doc) {
+		return false
+	}
+	return a.position < b.position
+}
+
+func (h *orderedDocHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *orderedDocHeap) Push(x any) { h.items = append(h.items, x.(orderedDoc)) }
+
+func (h *orderedDocHeap) Pop() any {
+	last := len(h.items) - 1
+	item := h.items[last]
+	h.items[last] = orderedDoc{}
+	h.items = h.items[:last]
+	return item
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"container/heap"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// externalSortStrategy sorts the documents in memory until their estimated size
+// exceeds the memory budget, at which point the sorted documents are spilled as
+// a run to a temporary file. The runs are then merged when the data is finished.
+// Its designed for an unknown, possibly large, number of records.
+type externalSortStrategy struct {
+	ordering []mapper.OrderCondition
+	budget   int
+	execInfo *orderExecInfo
+
+	// docs are the documents that are not spilled yet, and size their estimated size in bytes.
+	docs []core.Doc
+	size int
+
+	// runs are the temporary files the sorted runs were spilled to, in the order they were spilled.
+	runs []*os.File
+
+	// merge holds the next document of each of the runs being merged.
+	merge   orderedDocHeap
+	sources []sortedRun
+	current core.Doc
+}
+
+func newExternalSortStrategy(
+	ordering []mapper.OrderCondition,
+	budget int,
+	execInfo *orderExecInfo,
+) *externalSortStrategy {
+	return &externalSortStrategy{
+		ordering: ordering,
+		budget:   budget,
+		execInfo: execInfo,
+		merge:    orderedDocHeap{ordering: ordering},
+	}
+}
+
+// Add adds a new document to the in-memory run, spilling it if it exceeds the memory budget.
+func (s *externalSortStrategy) Add(doc core.Doc) error {
+	s.docs = append(s.docs, doc.Clone())
+	s.size += estimateDocSize(doc)
+	if s.size < s.budget {
+		return nil
+	}
+	return s.spill()
+}
+
+// spill sorts the in-memory run and writes it to a new temporary file.
+func (s *externalSortStrategy) spill() error {
+	s.sortDocs()
+
+	file, err := os.CreateTemp("", "defradb-sort-*")
+	if err != nil {
+		return err
+	}
+	s.runs = append(s.runs, file)
+
+	w := newSpillWriter(file)
+	for _, doc := range s.docs {
+		if err := w.writeDoc(doc); err != nil {
+			return err
+		}
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	s.docs = nil
+	s.size = 0
+	s.execInfo.spilledRuns++
+	return nil
+}
+
+func (s *externalSortStrategy) sortDocs() {
+	sort.SliceStable(s.docs, func(i, j int) bool {
+		return docValueLess(s.ordering, s.docs[i], s.docs[j])
+	})
+}
+
+// Finish sorts the in-memory run and prepares the merge of all the runs.
+//
+// The in-memory run holds the documents added last, so it is merged as the last run.
+func (s *externalSortStrategy) Finish() error {
+	s.sortDocs()
+
+	for _, file := range s.runs {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		s.sources = append(s.sources, &spilledRun{r: newSpillReader(file)})
+	}
+	s.sources = append(s.sources, &memoryRun{docs: s.docs})
+
+	for i := range s.sources {
+		if err := s.pushNext(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pushNext pushes the next document of the given run into the merge, if there is one.
+func (s *externalSortStrategy) pushNext(run int) error {
+	doc, hasNext, err := s.sources[run].next()
+	if err != nil || !hasNext {
+		return err
+	}
+	heap.Push(&s.merge, orderedDoc{doc: doc, position: uint64(run)})
+	return nil
+}
+
+// Next moves to the next document of the merged runs.
+func (s *externalSortStrategy) Next() (bool, error) {
+	if s.merge.Len() == 0 {
+		return false, nil
+	}
+	item := heap.Pop(&s.merge).(orderedDoc)
+	s.current = item.doc
+	return true, s.pushNext(int(item.position))
+}
+
+// Value returns the current document of the merged runs.
+func (s *externalSortStrategy) Value() core.Doc {
+	return s.current
+}
+
+// Close removes the temporary files of the spilled runs.
+func (s *externalSortStrategy) Close() error {
+	var closeErr error
+	for _, file := range s.runs {
+		if err := file.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+		if err := os.Remove(file.Name()); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	s.runs = nil
+	s.docs = nil
+	s.sources = nil
+	s.merge.items = nil
+	return closeErr
+}
+
+// sortedRun is a sequence of sorted documents to merge.
+type sortedRun interface {
+	next() (core.Doc, bool, error)
+}
+
+// memoryRun is a sorted run held in memory.
+type memoryRun struct {
+	docs []core.Doc
+}
+
+func (r *memoryRun) next() (core.Doc, bool, error) {
+	if len(r.docs) == 0 {
+		return core.Doc{}, false, nil
+	}
+	doc := r.docs[0]
+	r.docs = r.docs[1:]
+	return doc, true, nil
+}
+
+// spilledRun is a sorted run read back from its temporary file.
+type spilledRun struct {
+	r *spillReader
+}
+
+func (r *spilledRun) next() (core.Doc, bool, error) {
+	doc, err := r.r.readDoc()
+	if err == io.EOF {
+		return core.Doc{}, false, nil
+	}
+	if err != nil {
+		return core.Doc{}, false, err
+	}
+	return doc, true, nil
+}
+
+// estimateDocSize returns the approximate number of bytes the given document
+// holds in memory.
+func estimateDocSize(doc core.Doc) int {
+	size := 64 + len(doc.SchemaVersionID)
+	for _, value := range doc.Fields {
+		size += estimateValueSize(value)
+	}
+	return size
+}
+
+func estimateValueSize(value any) int {
+	// the size of an interface value
+	const baseSize = 16
+
+	switch v := value.(type) {
+	case string:
+		return baseSize + len(v)
+	case []byte:
+		return baseSize + len(v)
+	case time.Time:
+		return baseSize + 24
+	case core.Doc:
+		return baseSize + estimateDocSize(v)
+	case []core.Doc:
+		size := baseSize
+		for _, doc := range v {
+			size += estimateDocSize(doc)
+		}
+		return size
+	case []string:
+		size := baseSize
+		for _, item := range v {
+			size += baseSize + len(item)
+		}
+		return size
+	case []any:
+		size := baseSize
+		for _, item := range v {
+			size += estimateValueSize(item)
+		}
+		return size
+	case map[string]any:
+		size := baseSize
+		for key, item := range v {
+			size += len(key) + estimateValueSize(item)
+		}
+		return size
+	case []bool:
+		return baseSize + len(v)
+	case []int64:
+		return baseSize + 8*len(v)
+	case []float64:
+		return baseSize + 8*len(v)
+	case []time.Time:
+		return baseSize + 24*len(v)
+	default:
+		return baseSize
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// newSortTestDocs returns documents with an age and their position, with equal ages
+// to check the sort is stable.
+func newSortTestDocs() []core.Doc {
+	ages := []int64{30, 20, 40, 20, 10, 30, 50, 20}
+	docs := make([]core.Doc, len(ages))
+	for i, age := range ages {
+		docs[i] = core.Doc{Fields: core.DocFields{age, i}}
+	}
+	return docs
+}
+
+func sortTestOrdering(direction mapper.SortDirection) []mapper.OrderCondition {
+	return []mapper.OrderCondition{{FieldIndexes: []int{0}, Direction: direction}}
+}
+
+func collectSorted(t *testing.T, strategy orderingStrategy, docs []core.Doc) [][]any {
+	for _, doc := range docs {
+		require.NoError(t, strategy.Add(doc))
+	}
+	require.NoError(t, strategy.Finish())
+
+	var results [][]any
+	for {
+		next, err := strategy.Next()
+		require.NoError(t, err)
+		if !next {
+			break
+		}
+		results = append(results, []any(strategy.Value().Fields))
+	}
+	require.NoError(t, strategy.Close())
+	return results
+}
+
+func TestExternalSortStrategy_IfExceedingBudget_ShouldMergeSpilledRuns(t *testing.T) {
+	var execInfo orderExecInfo
+	strategy := newExternalSortStrategy(sortTestOrdering(mapper.ASC), 200, &execInfo)
+
+	results := collectSorted(t, strategy, newSortTestDocs())
+
+	assert.Equal(t, [][]any{
+		{int64(10), 4},
+		{int64(20), 1},
+		{int64(20), 3},
+		{int64(20), 7},
+		{int64(30), 0},
+		{int64(30), 5},
+		{int64(40), 2},
+		{int64(50), 6},
+	}, results)
+	assert.Equal(t, uint64(2), execInfo.spilledRuns)
+}
+
+func TestExternalSortStrategy_OnClose_ShouldRemoveSpilledRuns(t *testing.T) {
+	var execInfo orderExecInfo
+	strategy := newExternalSortStrategy(sortTestOrdering(mapper.DESC), 1, &execInfo)
+	for _, doc := range newSortTestDocs() {
+		require.NoError(t, strategy.Add(doc))
+	}
+	require.Len(t, strategy.runs, 8)
+	fileName := strategy.runs[0].Name()
+
+	require.NoError(t, strategy.Close())
+
+	_, err := os.Stat(fileName)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestTopKSortStrategy_ShouldKeepLeadingDocs(t *testing.T) {
+	strategy := newTopKSortStrategy(sortTestOrdering(mapper.DESC), 4)
+
+	results := collectSorted(t, strategy, newSortTestDocs())
+
+	assert.Equal(t, [][]any{
+		{int64(50), 6},
+		{int64(40), 2},
+		{int64(30), 0},
+		{int64(30), 5},
+	}, results)
+}
+
+func TestSpill_ShouldRoundTripDocValues(t *testing.T) {
+	now := time.Date(2024, 3, 4, 5, 6, 7, 8, time.UTC)
+	doc := core.Doc{
+		Hidden:          true,
+		Status:          client.Active,
+		SchemaVersionID: "bafy",
+		Fields: core.DocFields{
+			nil,
+			true,
+			1,
+			int64(-2),
+			uint64(3),
+			4.5,
+			"John",
+			[]byte{1, 2},
+			now,
+			core.Doc{Fields: core.DocFields{"child"}},
+			[]core.Doc{{Fields: core.DocFields{int64(1)}}},
+			[]any{"a", 1.5, nil},
+			map[string]any{"tree": map[string]any{"height": 10.0}},
+			[]bool{true, false},
+			[]int64{1, 2},
+			[]float64{1.5},
+			[]string{"a", "b"},
+			[]time.Time{now},
+			[]immutable.Option[bool]{immutable.Some(true), immutable.None[bool]()},
+			[]immutable.Option[int64]{immutable.None[int64](), immutable.Some[int64](5)},
+			[]immutable.Option[float64]{immutable.Some(2.5)},
+			[]immutable.Option[string]{immutable.Some("c"), immutable.None[string]()},
+			[]immutable.Option[time.Time]{immutable.Some(now)},
+		},
+	}
+
+	file, err := os.CreateTemp(t.TempDir(), "spill")
+	require.NoError(t, err)
+	defer file.Close() //nolint:errcheck
+
+	w := newSpillWriter(file)
+	require.NoError(t, w.writeDoc(doc))
+	require.NoError(t, w.flush())
+
+	_, err = file.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	r := newSpillReader(file)
+
+	result, err := r.readDoc()
+	require.NoError(t, err)
+	assert.Equal(t, doc, result)
+
+	_, err = r.readDoc()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestSpill_WithUnsupportedValue_ShouldError(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "spill")
+	require.NoError(t, err)
+	defer file.Close() //nolint:errcheck
+
+	err = newSpillWriter(file).writeValue(struct{}{})
+	assert.ErrorContains(t, err, errUnsupportedSortValue)
+}
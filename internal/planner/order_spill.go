@@ -0,0 +1,418 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// The tags identifying the types of the document values spilled by the external sort.
+const (
+	spillNilTag byte = iota
+	spillBoolTag
+	spillIntTag
+	spillInt64Tag
+	spillUint64Tag
+	spillFloat64Tag
+	spillStringTag
+	spillBytesTag
+	spillTimeTag
+	spillDocTag
+	spillDocArrayTag
+	spillAnyArrayTag
+	spillAnyMapTag
+	spillBoolArrayTag
+	spillInt64ArrayTag
+	spillFloat64ArrayTag
+	spillStringArrayTag
+	spillTimeArrayTag
+	spillNillableBoolArrayTag
+	spillNillableInt64ArrayTag
+	spillNillableFloat64ArrayTag
+	spillNillableStringArrayTag
+	spillNillableTimeArrayTag
+)
+
+// spillWriter encodes documents into a sorted run file.
+//
+// Write errors are kept by the underlying buffer and returned on flush.
+type spillWriter struct {
+	w   *bufio.Writer
+	buf [binary.MaxVarintLen64]byte
+}
+
+// newSpillWriter returns a spillWriter buffering the writes to the given file.
+func newSpillWriter(file *os.File) *spillWriter {
+	return &spillWriter{w: bufio.NewWriter(file)}
+}
+
+func (w *spillWriter) flush() error {
+	return w.w.Flush()
+}
+
+func (w *spillWriter) writeUvarint(v uint64) {
+	n := binary.PutUvarint(w.buf[:], v)
+	_, _ = w.w.Write(w.buf[:n])
+}
+
+func (w *spillWriter) writeVarint(v int64) {
+	n := binary.PutVarint(w.buf[:], v)
+	_, _ = w.w.Write(w.buf[:n])
+}
+
+func (w *spillWriter) writeBool(v bool) {
+	if v {
+		_ = w.w.WriteByte(1)
+	} else {
+		_ = w.w.WriteByte(0)
+	}
+}
+
+func (w *spillWriter) writeFloat64(v float64) {
+	binary.LittleEndian.PutUint64(w.buf[:8], math.Float64bits(v))
+	_, _ = w.w.Write(w.buf[:8])
+}
+
+func (w *spillWriter) writeString(v string) {
+	w.writeUvarint(uint64(len(v)))
+	_, _ = w.w.WriteString(v)
+}
+
+func (w *spillWriter) writeBytes(v []byte) {
+	w.writeUvarint(uint64(len(v)))
+	_, _ = w.w.Write(v)
+}
+
+func (w *spillWriter) writeTime(v time.Time) error {
+	b, err := v.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	w.writeBytes(b)
+	return nil
+}
+
+func (w *spillWriter) writeDoc(doc core.Doc) error {
+	w.writeBool(doc.Hidden)
+	w.writeUvarint(uint64(doc.Status))
+	w.writeString(doc.SchemaVersionID)
+	w.writeUvarint(uint64(len(doc.Fields)))
+	for _, value := range doc.Fields {
+		if err := w.writeValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeValue writes the given document value, prefixed by the tag of its type.
+func (w *spillWriter) writeValue(value any) error {
+	switch v := value.(type) {
+	case nil:
+		_ = w.w.WriteByte(spillNilTag)
+	case bool:
+		_ = w.w.WriteByte(spillBoolTag)
+		w.writeBool(v)
+	case int:
+		_ = w.w.WriteByte(spillIntTag)
+		w.writeVarint(int64(v))
+	case int64:
+		_ = w.w.WriteByte(spillInt64Tag)
+		w.writeVarint(v)
+	case uint64:
+		_ = w.w.WriteByte(spillUint64Tag)
+		w.writeUvarint(v)
+	case float64:
+		_ = w.w.WriteByte(spillFloat64Tag)
+		w.writeFloat64(v)
+	case string:
+		_ = w.w.WriteByte(spillStringTag)
+		w.writeString(v)
+	case []byte:
+		_ = w.w.WriteByte(spillBytesTag)
+		w.writeBytes(v)
+	case time.Time:
+		_ = w.w.WriteByte(spillTimeTag)
+		return w.writeTime(v)
+	case core.Doc:
+		_ = w.w.WriteByte(spillDocTag)
+		return w.writeDoc(v)
+	case []core.Doc:
+		_ = w.w.WriteByte(spillDocArrayTag)
+		return writeSpillArray(w, v, w.writeDoc)
+	case []any:
+		_ = w.w.WriteByte(spillAnyArrayTag)
+		return writeSpillArray(w, v, w.writeValue)
+	case map[string]any:
+		_ = w.w.WriteByte(spillAnyMapTag)
+		w.writeUvarint(uint64(len(v)))
+		for key, item := range v {
+			w.writeString(key)
+			if err := w.writeValue(item); err != nil {
+				return err
+			}
+		}
+	case []bool:
+		_ = w.w.WriteByte(spillBoolArrayTag)
+		return writeSpillArray(w, v, ignoreError(w.writeBool))
+	case []int64:
+		_ = w.w.WriteByte(spillInt64ArrayTag)
+		return writeSpillArray(w, v, ignoreError(w.writeVarint))
+	case []float64:
+		_ = w.w.WriteByte(spillFloat64ArrayTag)
+		return writeSpillArray(w, v, ignoreError(w.writeFloat64))
+	case []string:
+		_ = w.w.WriteByte(spillStringArrayTag)
+		return writeSpillArray(w, v, ignoreError(w.writeString))
+	case []time.Time:
+		_ = w.w.WriteByte(spillTimeArrayTag)
+		return writeSpillArray(w, v, w.writeTime)
+	case []immutable.Option[bool]:
+		_ = w.w.WriteByte(spillNillableBoolArrayTag)
+		return writeSpillArray(w, v, nillable(w, ignoreError(w.writeBool)))
+	case []immutable.Option[int64]:
+		_ = w.w.WriteByte(spillNillableInt64ArrayTag)
+		return writeSpillArray(w, v, nillable(w, ignoreError(w.writeVarint)))
+	case []immutable.Option[float64]:
+		_ = w.w.WriteByte(spillNillableFloat64ArrayTag)
+		return writeSpillArray(w, v, nillable(w, ignoreError(w.writeFloat64)))
+	case []immutable.Option[string]:
+		_ = w.w.WriteByte(spillNillableStringArrayTag)
+		return writeSpillArray(w, v, nillable(w, ignoreError(w.writeString)))
+	case []immutable.Option[time.Time]:
+		_ = w.w.WriteByte(spillNillableTimeArrayTag)
+		return writeSpillArray(w, v, nillable(w, w.writeTime))
+	default:
+		return NewErrUnsupportedSortValue(value)
+	}
+	return nil
+}
+
+func writeSpillArray[T any](w *spillWriter, items []T, write func(T) error) error {
+	w.writeUvarint(uint64(len(items)))
+	for _, item := range items {
+		if err := write(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nillable returns a function writing whether an optional value is set, followed by its value if it is.
+func nillable[T any](w *spillWriter, write func(T) error) func(immutable.Option[T]) error {
+	return func(item immutable.Option[T]) error {
+		w.writeBool(item.HasValue())
+		if !item.HasValue() {
+			return nil
+		}
+		return write(item.Value())
+	}
+}
+
+func ignoreError[T any](write func(T)) func(T) error {
+	return func(item T) error {
+		write(item)
+		return nil
+	}
+}
+
+// spillReader decodes the documents of a sorted run file.
+type spillReader struct {
+	r *bufio.Reader
+}
+
+// newSpillReader returns a spillReader buffering the reads from the given file.
+func newSpillReader(file *os.File) *spillReader {
+	return &spillReader{r: bufio.NewReader(file)}
+}
+
+func (r *spillReader) readUvarint() (uint64, error) {
+	return binary.ReadUvarint(r.r)
+}
+
+func (r *spillReader) readVarint() (int64, error) {
+	return binary.ReadVarint(r.r)
+}
+
+func (r *spillReader) readBool() (bool, error) {
+	b, err := r.r.ReadByte()
+	return b == 1, err
+}
+
+func (r *spillReader) readFloat64() (float64, error) {
+	var b [8]byte
+	_, err := io.ReadFull(r.r, b[:])
+	return math.Float64frombits(binary.LittleEndian.Uint64(b[:])), err
+}
+
+func (r *spillReader) readBytes() ([]byte, error) {
+	length, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, length)
+	_, err = io.ReadFull(r.r, b)
+	return b, err
+}
+
+func (r *spillReader) readString() (string, error) {
+	b, err := r.readBytes()
+	return string(b), err
+}
+
+func (r *spillReader) readTime() (time.Time, error) {
+	var t time.Time
+	b, err := r.readBytes()
+	if err != nil {
+		return t, err
+	}
+	err = t.UnmarshalBinary(b)
+	return t, err
+}
+
+// readDoc reads the next document, returning io.EOF if there are none left.
+func (r *spillReader) readDoc() (core.Doc, error) {
+	var doc core.Doc
+	hidden, err := r.readBool()
+	if err != nil {
+		return doc, err
+	}
+	doc.Hidden = hidden
+
+	status, err := r.readUvarint()
+	if err != nil {
+		return doc, err
+	}
+	doc.Status = client.DocumentStatus(status)
+
+	doc.SchemaVersionID, err = r.readString()
+	if err != nil {
+		return doc, err
+	}
+
+	doc.Fields, err = readSpillArray(r, r.readValue)
+	return doc, err
+}
+
+// readValue reads a document value written by spillWriter.writeValue.
+func (r *spillReader) readValue() (any, error) {
+	tag, err := r.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case spillNilTag:
+		return nil, nil
+	case spillBoolTag:
+		return r.readBool()
+	case spillIntTag:
+		v, err := r.readVarint()
+		return int(v), err
+	case spillInt64Tag:
+		return r.readVarint()
+	case spillUint64Tag:
+		return r.readUvarint()
+	case spillFloat64Tag:
+		return r.readFloat64()
+	case spillStringTag:
+		return r.readString()
+	case spillBytesTag:
+		return r.readBytes()
+	case spillTimeTag:
+		return r.readTime()
+	case spillDocTag:
+		return r.readDoc()
+	case spillDocArrayTag:
+		return readSpillArray(r, r.readDoc)
+	case spillAnyArrayTag:
+		return readSpillArray(r, r.readValue)
+	case spillAnyMapTag:
+		length, err := r.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]any, length)
+		for i := uint64(0); i < length; i++ {
+			key, err := r.readString()
+			if err != nil {
+				return nil, err
+			}
+			m[key], err = r.readValue()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case spillBoolArrayTag:
+		return readSpillArray(r, r.readBool)
+	case spillInt64ArrayTag:
+		return readSpillArray(r, r.readVarint)
+	case spillFloat64ArrayTag:
+		return readSpillArray(r, r.readFloat64)
+	case spillStringArrayTag:
+		return readSpillArray(r, r.readString)
+	case spillTimeArrayTag:
+		return readSpillArray(r, r.readTime)
+	case spillNillableBoolArrayTag:
+		return readSpillArray(r, readNillable(r, r.readBool))
+	case spillNillableInt64ArrayTag:
+		return readSpillArray(r, readNillable(r, r.readVarint))
+	case spillNillableFloat64ArrayTag:
+		return readSpillArray(r, readNillable(r, r.readFloat64))
+	case spillNillableStringArrayTag:
+		return readSpillArray(r, readNillable(r, r.readString))
+	case spillNillableTimeArrayTag:
+		return readSpillArray(r, readNillable(r, r.readTime))
+	default:
+		return nil, ErrInvalidSpilledSortValue
+	}
+}
+
+func readSpillArray[T any](r *spillReader, read func() (T, error)) ([]T, error) {
+	length, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]T, length)
+	for i := range items {
+		items[i], err = read()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// readNillable returns a function reading an optional value written by nillable.
+func readNillable[T any](r *spillReader, read func() (T, error)) func() (immutable.Option[T], error) {
+	return func() (immutable.Option[T], error) {
+		hasValue, err := r.readBool()
+		if err != nil || !hasValue {
+			return immutable.None[T](), err
+		}
+		value, err := read()
+		if err != nil {
+			return immutable.None[T](), err
+		}
+		return immutable.Some(value), nil
+	}
+}
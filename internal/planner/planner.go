@@ -91,23 +91,47 @@ type Planner struct {
 	acp      immutable.Option[acp.ACP]
 	db       client.Store
 
+	// sortMemoryBudget is the approximate number of bytes of documents an orderNode
+	// may hold in memory before spilling sorted runs to temporary files.
+	//
+	// A budget of zero sorts everything in memory.
+	sortMemoryBudget int
+
 	ctx context.Context
 }
 
+// Option is a function that sets a config value on the planner.
+type Option func(*Planner)
+
+// WithSortMemoryBudget sets the approximate number of bytes of documents that are sorted
+// in memory, beyond which sorted runs are spilled to temporary files and merged.
+//
+// A budget of zero, the default, sorts everything in memory.
+func WithSortMemoryBudget(budget int) Option {
+	return func(p *Planner) {
+		p.sortMemoryBudget = budget
+	}
+}
+
 func New(
 	ctx context.Context,
 	identity immutable.Option[acpIdentity.Identity],
 	acp immutable.Option[acp.ACP],
 	db client.Store,
 	txn datastore.Txn,
+	opts ...Option,
 ) *Planner {
-	return &Planner{
+	p := &Planner{
 		txn:      txn,
 		identity: identity,
 		acp:      acp,
 		db:       db,
 		ctx:      ctx,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 func (p *Planner) newObjectMutationPlan(stmt *mapper.Mutation) (planNode, error) {
@@ -121,6 +145,12 @@ func (p *Planner) newObjectMutationPlan(stmt *mapper.Mutation) (planNode, error)
 	case mapper.DeleteObjects:
 		return p.DeleteDocs(stmt)
 
+	case mapper.UpsertObjects:
+		return p.UpsertDocs(stmt)
+
+	case mapper.PurgeObjects:
+		return p.PurgeDocs(stmt)
+
 	default:
 		return nil, client.NewErrUnhandledType("mutation", stmt.Type)
 	}
@@ -184,6 +214,16 @@ func (p *Planner) expandPlan(planNode planNode, parentPlan *selectTopNode) error
 	case *deleteNode:
 		return p.expandPlan(n.source, parentPlan)
 
+	case *purgeNode:
+		return p.expandPlan(n.source, parentPlan)
+
+	case *upsertNode:
+		err := p.expandPlan(n.source, parentPlan)
+		if err != nil {
+			return err
+		}
+		return p.expandPlan(n.results, parentPlan)
+
 	case *viewNode:
 		return p.expandPlan(n.source, parentPlan)
 
@@ -224,6 +264,11 @@ func (p *Planner) expandSelectTopNodePlan(plan *selectTopNode, parentPlan *selec
 		p.expandLimitPlan(plan, parentPlan)
 	}
 
+	// only the documents within the limit need to be sorted
+	if plan.order != nil && plan.limit != nil && plan.limit.limit != 0 {
+		plan.order.topK = plan.limit.limit + plan.limit.offset
+	}
+
 	return nil
 }
 
@@ -0,0 +1,146 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+type purgeNode struct {
+	documentIterator
+	docMapper
+
+	p *Planner
+
+	collection client.Collection
+	source     planNode
+
+	filter *mapper.Filter
+	docIDs []string
+
+	execInfo purgeExecInfo
+}
+
+type purgeExecInfo struct {
+	// Total number of times purgeNode was executed.
+	iterations uint64
+}
+
+func (n *purgeNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	next, err := n.source.Next()
+	if err != nil {
+		return false, err
+	}
+	if !next {
+		return false, nil
+	}
+
+	n.currentValue = n.source.Value()
+	docID, err := client.NewDocIDFromString(n.currentValue.GetID())
+	if err != nil {
+		return false, err
+	}
+	_, err = n.collection.Purge(
+		n.p.ctx,
+		docID,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	n.currentValue.Status = client.Purged
+	n.documentMapping.TrySetFirstOfName(&n.currentValue, request.DeletedFieldName, true)
+
+	return true, nil
+}
+
+func (n *purgeNode) Spans(spans core.Spans) {
+	n.source.Spans(spans)
+}
+
+func (n *purgeNode) Kind() string {
+	return "purgeNode"
+}
+
+func (n *purgeNode) Init() error {
+	return n.source.Init()
+}
+
+func (n *purgeNode) Start() error {
+	return n.source.Start()
+}
+
+func (n *purgeNode) Close() error {
+	return n.source.Close()
+}
+
+func (n *purgeNode) Source() planNode {
+	return n.source
+}
+
+func (n *purgeNode) simpleExplain() (map[string]any, error) {
+	simpleExplainMap := map[string]any{}
+
+	// Add the document id(s) that request wants to purge.
+	simpleExplainMap[request.DocIDsArgName] = n.docIDs
+
+	// Add the filter attribute if it exists, otherwise have it nil.
+	if n.filter == nil {
+		simpleExplainMap[filterLabel] = nil
+	} else {
+		simpleExplainMap[filterLabel] = n.filter.ToMap(n.documentMapping)
+	}
+
+	return simpleExplainMap, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *purgeNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (p *Planner) PurgeDocs(parsed *mapper.Mutation) (planNode, error) {
+	col, err := p.db.GetCollectionByName(p.ctx, parsed.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	slctNode, err := p.Select(&parsed.Select)
+	if err != nil {
+		return nil, err
+	}
+
+	return &purgeNode{
+		p:          p,
+		filter:     parsed.Filter,
+		docIDs:     parsed.DocIDs.Value(),
+		collection: col,
+		source:     slctNode,
+		docMapper:  docMapper{parsed.DocumentMapping},
+	}, nil
+}
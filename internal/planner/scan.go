@@ -11,11 +11,17 @@
 package planner
 
 import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/connor/fulltext"
 	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
 	"github.com/sourcenetwork/defradb/internal/db/base"
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
 	"github.com/sourcenetwork/defradb/internal/lens"
@@ -45,9 +51,24 @@ type scanNode struct {
 
 	showDeleted bool
 
+	// If true, the allowances of the bounded counter fields are returned with each document.
+	showAllowance bool
+
+	// The search texts of the fields with a `_search` condition, used to score the documents.
+	searchTexts map[string]string
+	searches    []fieldSearch
+
 	spans   core.Spans
 	reverse bool
 
+	// The index the documents are fetched with, if any, and the part of the filter it serves.
+	index       immutable.Option[client.IndexDescription]
+	indexFilter *mapper.Filter
+
+	// If true, the documents are fetched in the order of the fields of the index, which
+	// is then used even if it does not serve the filter.
+	orderedByIndex bool
+
 	filter *mapper.Filter
 	slct   *mapper.Select
 
@@ -60,7 +81,18 @@ func (n *scanNode) Kind() string {
 	return "scanNode"
 }
 
+// fieldSearch holds the analyzed terms of a `_search` condition on a field.
+type fieldSearch struct {
+	fieldIndex int
+	terms      []string
+	analyzer   fulltext.Analyzer
+}
+
 func (n *scanNode) Init() error {
+	err := n.initSearches()
+	if err != nil {
+		return err
+	}
 	// init the fetcher
 	if err := n.fetcher.Init(
 		n.p.ctx,
@@ -92,6 +124,9 @@ func (n *scanNode) initFields(fields []mapper.Requestable) error {
 		switch requestable := r.(type) {
 		// field is simple as its just a base level field
 		case *mapper.Field:
+			if requestable.GetName() == request.AllowanceFieldName {
+				n.showAllowance = true
+			}
 			n.tryAddField(requestable.GetName())
 		// select might have its own select fields and filters fields
 		case *mapper.Select:
@@ -138,10 +173,74 @@ func (n *scanNode) tryAddField(fieldName string) bool {
 	return true
 }
 
+// initSearches analyzes the search texts of the request, using the analyzer of the
+// full-text index of the searched field if it has one.
+func (n *scanNode) initSearches() error {
+	n.searches = n.searches[:0]
+	for fieldName, text := range n.searchTexts {
+		analyzer := fulltext.DefaultAnalyzer()
+		for _, index := range n.col.Description().GetIndexesOnField(fieldName) {
+			if !index.IsFullText() {
+				continue
+			}
+			var err error
+			analyzer, err = fulltext.NewAnalyzer(index.Analyzer)
+			if err != nil {
+				return err
+			}
+			break
+		}
+		n.searches = append(n.searches, fieldSearch{
+			fieldIndex: n.documentMapping.FirstIndexOfName(fieldName),
+			terms:      analyzer.Tokenize(text),
+			analyzer:   analyzer,
+		})
+	}
+	return nil
+}
+
+// collectSearchTexts adds the search text of each field with a `_search` condition in
+// the given conditions to the given map.
+//
+// Conditions within `_and` are included, as they must all pass for a document to be returned.
+func collectSearchTexts(conditions map[string]any, searchTexts map[string]string) {
+	for key, cond := range conditions {
+		switch key {
+		case request.FilterOpAnd:
+			andConds, _ := cond.([]any)
+			for _, andCond := range andConds {
+				if andCondMap, ok := andCond.(map[string]any); ok {
+					collectSearchTexts(andCondMap, searchTexts)
+				}
+			}
+		default:
+			condMap, _ := cond.(map[string]any)
+			if text, ok := condMap[request.FilterOpSearch].(string); ok {
+				searchTexts[key] = text
+			}
+		}
+	}
+}
+
 func (scan *scanNode) initFetcher(
 	cid immutable.Option[string],
 	index immutable.Option[client.IndexDescription],
 ) {
+	if scan.filter != nil {
+		// the search conditions must be collected before the filter is split
+		// between the index and the scan.
+		scan.searchTexts = map[string]string{}
+		collectSearchTexts(scan.filter.ExternalConditions, scan.searchTexts)
+		// the searched fields must be fetched to score the documents
+		for fieldName := range scan.searchTexts {
+			if !slices.ContainsFunc(scan.fields, func(field client.FieldDefinition) bool {
+				return field.Name == fieldName
+			}) {
+				scan.tryAddField(fieldName)
+			}
+		}
+	}
+
 	var f fetcher.Fetcher
 	if cid.HasValue() {
 		f = new(fetcher.VersionedFetcher)
@@ -156,9 +255,15 @@ func (scan *scanNode) initFetcher(
 				fields = append(fields, mapper.Field{Index: typeIndex, Name: fieldName})
 			}
 			var indexFilter *mapper.Filter
-			scan.filter, indexFilter = filter.SplitByFields(scan.filter, fields...)
-			if indexFilter != nil {
+			// an index used only for its order does not serve the filter, which is
+			// then left entirely to the scan.
+			if !scan.orderedByIndex || isFilteredByField(scan.filter, index.Value().Fields[0].Name) {
+				scan.filter, indexFilter = filter.SplitByFields(scan.filter, fields...)
+			}
+			if indexFilter != nil || scan.orderedByIndex {
 				f = fetcher.NewIndexFetcher(f, index.Value(), indexFilter)
+				scan.index = index
+				scan.indexFilter = indexFilter
 			}
 		}
 
@@ -167,6 +272,16 @@ func (scan *scanNode) initFetcher(
 	scan.fetcher = f
 }
 
+// isFilteredByField returns true if the given filter has a top level condition on the field
+// with the given name.
+func isFilteredByField(filter *mapper.Filter, fieldName string) bool {
+	if filter == nil {
+		return false
+	}
+	_, isFiltered := filter.ExternalConditions[fieldName]
+	return isFiltered
+}
+
 // Start starts the internal logic of the scanner
 // like the DocumentFetcher, and more.
 func (n *scanNode) Start() error {
@@ -218,9 +333,61 @@ func (n *scanNode) Next() (bool, error) {
 		n.currentValue.Status.IsDeleted(),
 	)
 
+	if n.showAllowance {
+		allowance, err := n.getAllowance(n.currentValue.GetID())
+		if err != nil {
+			return false, err
+		}
+		n.documentMapping.SetFirstOfName(&n.currentValue, request.AllowanceFieldName, allowance)
+	}
+
+	if len(n.searches) > 0 {
+		n.documentMapping.SetFirstOfName(&n.currentValue, request.ScoreFieldName, n.getScore())
+	}
+
 	return true, nil
 }
 
+// getScore returns the relevance of the current document to the searches of the request.
+func (n *scanNode) getScore() float64 {
+	var score float64
+	for _, search := range n.searches {
+		var text string
+		switch value := n.currentValue.Fields[search.fieldIndex].(type) {
+		case string:
+			text = value
+		case immutable.Option[string]:
+			text = value.Value()
+		}
+		score += fulltext.Score(search.analyzer.Tokenize(text), search.terms)
+	}
+	return score
+}
+
+// getAllowance returns the JSON encoded allowance of the local replica for each bounded
+// counter field of the given document.
+func (n *scanNode) getAllowance(docID string) (string, error) {
+	allowance := map[string]int64{}
+	for _, field := range n.col.Definition().GetFields() {
+		if field.Typ != client.BOUNDED_COUNTER {
+			continue
+		}
+		key := base.MakeDataStoreKeyWithCollectionAndDocID(n.col.Description(), docID).
+			WithFieldId(fmt.Sprint(field.ID))
+		counter := crdt.NewBoundedCounter(n.p.txn.Datastore(), core.CollectionSchemaVersionKey{}, key, field.Name)
+		value, err := counter.Allowance(n.p.ctx)
+		if err != nil {
+			return "", err
+		}
+		allowance[field.Name] = value
+	}
+	out, err := json.Marshal(allowance)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
 func (n *scanNode) Spans(spans core.Spans) {
 	n.spans = spans
 }
@@ -265,9 +432,60 @@ func (n *scanNode) simpleExplain() (map[string]any, error) {
 	// Add the spans attribute.
 	simpleExplainMap[spansLabel] = n.explainSpans()
 
+	// Add the index attribute if the documents are fetched with an index.
+	if n.index.HasValue() {
+		simpleExplainMap[indexLabel] = n.explainIndex()
+	}
+
 	return simpleExplainMap, nil
 }
 
+// explainIndex explains the index attribute.
+//
+// The range contains the conditions that bound the index keys that are scanned:
+// the `_eq` conditions on the leading fields of the index, followed by either
+// the `_in` condition on the first field or the range conditions on the next field.
+// An empty range means that the whole index is scanned.
+func (n *scanNode) explainIndex() map[string]any {
+	var conditions map[string]any
+	if n.indexFilter != nil {
+		conditions = n.indexFilter.ToMap(n.documentMapping)
+	}
+
+	keyRange := map[string]any{}
+	for i, field := range n.index.Value().Fields {
+		condMap, _ := conditions[field.Name].(map[string]any)
+		if value, ok := condMap[request.FilterOpEqual]; ok {
+			keyRange[field.Name] = map[string]any{request.FilterOpEqual: value}
+			continue
+		}
+		if value, ok := condMap[request.FilterOpIn]; ok && i == 0 {
+			keyRange[field.Name] = map[string]any{request.FilterOpIn: value}
+			break
+		}
+		bounds := map[string]any{}
+		for _, op := range []string{
+			request.FilterOpGreaterThan,
+			request.FilterOpGreaterOrEqual,
+			request.FilterOpLessThan,
+			request.FilterOpLessOrEqual,
+		} {
+			if value, ok := condMap[op]; ok && value != nil {
+				bounds[op] = value
+			}
+		}
+		if len(bounds) > 0 {
+			keyRange[field.Name] = bounds
+		}
+		break
+	}
+
+	return map[string]any{
+		"name":  n.index.Value().Name,
+		"range": keyRange,
+	}
+}
+
 func (n *scanNode) executeExplain() map[string]any {
 	return map[string]any{
 		"iterations":   n.execInfo.iterations,
@@ -290,7 +290,17 @@ func (n *selectNode) initSource() ([]aggregateNode, error) {
 	}
 
 	if isScanNode {
-		origScan.initFetcher(n.selectReq.Cid, findIndexByFilteringField(origScan))
+		index := findIndexByFilteringField(origScan)
+		// the order of the documents can only be relied on if they are not joined
+		if n.source == planNode(origScan) {
+			orderIndex, reverse := findIndexByOrdering(origScan, n.selectReq, index)
+			if orderIndex.HasValue() {
+				index = orderIndex
+				origScan.orderedByIndex = true
+				origScan.reverse = reverse
+			}
+		}
+		origScan.initFetcher(n.selectReq.Cid, index)
 	}
 
 	return aggregates, nil
@@ -303,18 +313,123 @@ func findIndexByFilteringField(scanNode *scanNode) immutable.Option[client.Index
 	colDesc := scanNode.col.Description()
 
 	for _, field := range scanNode.col.Schema().Fields {
-		if _, isFiltered := scanNode.filter.ExternalConditions[field.Name]; !isFiltered {
+		cond, isFiltered := scanNode.filter.ExternalConditions[field.Name]
+		if !isFiltered {
 			continue
 		}
-		indexes := colDesc.GetIndexesOnField(field.Name)
-		if len(indexes) > 0 {
-			// we return the first found index. We will optimize it later.
-			return immutable.Some(indexes[0])
+		condMap, _ := cond.(map[string]any)
+		_, hasSearch := condMap[request.FilterOpSearch]
+		isSearch := hasSearch && len(condMap) == 1
+		if hasSearch && !isSearch {
+			// a search mixed with other conditions on the same field can not be served
+			// by a single index
+			continue
+		}
+		for _, index := range colDesc.GetIndexesOnField(field.Name) {
+			// full-text indexes can only serve search conditions, and search conditions
+			// can only be served by full-text indexes.
+			if index.IsFullText() == isSearch {
+				// we return the first found index. We will optimize it later.
+				return immutable.Some(index)
+			}
 		}
 	}
 	return immutable.None[client.IndexDescription]()
 }
 
+// findIndexByOrdering returns the index that yields the documents of the given scan in the
+// order requested by the given select, if there is one, and whether its keys must be iterated
+// in reverse to do so.
+//
+// If an index was already found to serve the filter, it is only returned if it also yields
+// the documents in the requested order.
+func findIndexByOrdering(
+	scanNode *scanNode,
+	selectReq *mapper.Select,
+	filterIndex immutable.Option[client.IndexDescription],
+) (immutable.Option[client.IndexDescription], bool) {
+	if selectReq.OrderBy == nil || len(selectReq.OrderBy.Conditions) == 0 || selectReq.GroupBy != nil ||
+		selectReq.ShowDeleted || selectReq.Cid.HasValue() || selectReq.DocIDs.HasValue() {
+		return immutable.None[client.IndexDescription](), false
+	}
+
+	if filterIndex.HasValue() {
+		isOrdered, reverse := isIndexOrderedByConditions(filterIndex.Value(), selectReq)
+		if isOrdered && !hasUnorderedIndexFilter(filterIndex.Value(), scanNode.filter) {
+			return filterIndex, reverse
+		}
+		return immutable.None[client.IndexDescription](), false
+	}
+
+	firstCond := selectReq.OrderBy.Conditions[0]
+	if len(firstCond.FieldIndexes) == 0 {
+		return immutable.None[client.IndexDescription](), false
+	}
+	fieldName, _ := selectReq.DocumentMapping.TryToFindNameFromIndex(firstCond.FieldIndexes[0])
+	for _, index := range scanNode.col.Description().GetIndexesOnField(fieldName) {
+		if isOrdered, reverse := isIndexOrderedByConditions(index, selectReq); isOrdered {
+			return immutable.Some(index), reverse
+		}
+	}
+	return immutable.None[client.IndexDescription](), false
+}
+
+// isIndexOrderedByConditions returns true if the keys of the given index are ordered by the
+// order conditions of the given select, that is if the conditions are on the leading fields
+// of the index, either all in the direction of the index or all in the opposite direction.
+//
+// The second returned value is true in the latter case, in which the index keys must be
+// iterated in reverse.
+func isIndexOrderedByConditions(index client.IndexDescription, selectReq *mapper.Select) (bool, bool) {
+	conditions := selectReq.OrderBy.Conditions
+	if index.IsFullText() || len(conditions) > len(index.Fields) {
+		return false, false
+	}
+	reverse := false
+	for i, cond := range conditions {
+		if len(cond.FieldIndexes) != 1 || len(cond.JSONPath) > 0 {
+			return false, false
+		}
+		fieldName, found := selectReq.DocumentMapping.TryToFindNameFromIndex(cond.FieldIndexes[0])
+		if !found || fieldName != index.Fields[i].Name {
+			return false, false
+		}
+		isOpposite := index.Fields[i].Descending != (cond.Direction == mapper.DESC)
+		if i == 0 {
+			reverse = isOpposite
+		} else if isOpposite != reverse {
+			return false, false
+		}
+	}
+	return true, reverse
+}
+
+// hasUnorderedIndexFilter returns true if the given filter makes the index fetcher yield the
+// documents out of the order of the given index.
+//
+// This is the case of an `_in` condition on the first field, whose values are fetched in the
+// order they are given, and of empty conditions, which fall back to fetching the documents
+// in the order of their IDs.
+func hasUnorderedIndexFilter(index client.IndexDescription, filter *mapper.Filter) bool {
+	if filter == nil {
+		return false
+	}
+	for i, field := range index.Fields {
+		cond, isFiltered := filter.ExternalConditions[field.Name]
+		if !isFiltered {
+			continue
+		}
+		condMap, _ := cond.(map[string]any)
+		if len(condMap) == 0 {
+			return true
+		}
+		if _, isIn := condMap[request.FilterOpIn]; isIn && i == 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func findIndexByFieldName(col client.Collection, fieldName string) immutable.Option[client.IndexDescription] {
 	for _, field := range col.Schema().Fields {
 		if field.Name != fieldName {
@@ -347,6 +462,10 @@ func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, erro
 				plan, aggregateError = n.planner.Sum(f, selectReq)
 			case request.AverageFieldName:
 				plan, aggregateError = n.planner.Average(f)
+			case request.MinFieldName:
+				plan, aggregateError = n.planner.Min(f, selectReq)
+			case request.MaxFieldName:
+				plan, aggregateError = n.planner.Max(f, selectReq)
 			}
 
 			if aggregateError != nil {
@@ -384,11 +503,11 @@ func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, erro
 					return nil, ErrGroupOutsideOfGroupBy
 				}
 				n.groupSelects = append(n.groupSelects, f)
-			} else if f.Name == request.LinksFieldName &&
+			} else if (f.Name == request.LinksFieldName || f.Name == request.SignatureFieldName) &&
 				(selectReq.Name == request.CommitsName || selectReq.Name == request.LatestCommitsName) &&
 				f.CollectionName == "" {
 				// no-op
-				// commit query link fields are always added and need no special treatment here
+				// commit query link and signature fields are always added and need no special treatment here
 				// WARNING: It is important to check collection name is nil and the parent select name
 				// here else we risk falsely identifying user defined fields with the name `links` as a commit links field
 			} else if !(n.collection != nil && len(n.collection.Description().QuerySources()) > 0) {
@@ -514,6 +633,10 @@ func (p *Planner) Select(selectReq *mapper.Select) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if scan, isScanNode := s.source.(*scanNode); isScanNode && orderPlan != nil {
+		// the scan already yields the documents in the requested order
+		orderPlan.sortEliminated = scan.orderedByIndex
+	}
 
 	top := &selectTopNode{
 		selectNode: s,
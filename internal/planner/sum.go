@@ -11,6 +11,8 @@
 package planner
 
 import (
+	"cmp"
+
 	"github.com/sourcenetwork/immutable"
 	"github.com/sourcenetwork/immutable/enumerable"
 
@@ -360,15 +362,11 @@ func sumItems[T any](
 
 func (n *sumNode) SetPlan(p planNode) { n.plan = p }
 
-type number interface {
-	int64 | float64
-}
-
-func lessN[T number](a T, b T) bool {
+func lessN[T cmp.Ordered](a T, b T) bool {
 	return a < b
 }
 
-func lessO[T number](a immutable.Option[T], b immutable.Option[T]) bool {
+func lessO[T cmp.Ordered](a immutable.Option[T], b immutable.Option[T]) bool {
 	if !a.HasValue() {
 		return true
 	}
@@ -71,10 +71,8 @@ func (n *updateNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
-			for k, v := range n.input {
-				if err := doc.Set(k, v); err != nil {
-					return false, err
-				}
+			if err := doc.SetWithMap(n.input); err != nil {
+				return false, err
 			}
 			err = n.collection.Update(n.p.ctx, doc)
 			if err != nil {
@@ -0,0 +1,249 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// upsertNode is used to construct and execute an object upsert mutation.
+//
+// The document matching the filter is updated using the update input,
+// if no document matches the filter a new document is created using
+// the create input instead. An error is returned if more than one
+// document matches the filter.
+type upsertNode struct {
+	documentIterator
+	docMapper
+
+	p *Planner
+
+	collection client.Collection
+
+	filter *mapper.Filter
+
+	// input map of fields and values used to create a new document
+	createInput map[string]any
+
+	// input map of fields and values used to update an existing document
+	updateInput map[string]any
+
+	isInitialized bool
+
+	// source is used to find the document matching the filter.
+	source planNode
+
+	// results yields the upserted document, it does not apply the filter
+	// so that the document is returned even if it no longer matches it.
+	results planNode
+
+	execInfo upsertExecInfo
+}
+
+type upsertExecInfo struct {
+	// Total number of times upsertNode was executed.
+	iterations uint64
+
+	// Total number of successful creates.
+	creates uint64
+
+	// Total number of successful updates.
+	updates uint64
+}
+
+// Next only returns once.
+func (n *upsertNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	if !n.isInitialized {
+		next, err := n.source.Next()
+		if err != nil {
+			return false, err
+		}
+		var docID string
+		if next {
+			n.currentValue = n.source.Value()
+
+			// make sure that multiple documents do not match the filter
+			next, err := n.source.Next()
+			if err != nil {
+				return false, err
+			}
+			if next {
+				return false, client.ErrUpsertMultipleDocuments
+			}
+
+			docID, err = n.update()
+			if err != nil {
+				return false, err
+			}
+		} else {
+			docID, err = n.create()
+			if err != nil {
+				return false, err
+			}
+		}
+		n.isInitialized = true
+
+		// Init the results node with the upserted document, so that it can be properly yielded with
+		// the upserted values, as well as any formatting (e.g. aggregates, groupings, etc)
+		n.results.Spans(docIDsToSpans([]string{docID}, n.collection.Description()))
+		err = n.results.Init()
+		if err != nil {
+			return false, err
+		}
+		err = n.results.Start()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	next, err := n.results.Next()
+	if err != nil {
+		return false, err
+	}
+	if !next {
+		return false, nil
+	}
+
+	n.currentValue = n.results.Value()
+	return true, nil
+}
+
+func (n *upsertNode) update() (string, error) {
+	docID, err := client.NewDocIDFromString(n.currentValue.GetID())
+	if err != nil {
+		return "", err
+	}
+	doc, err := n.collection.Get(n.p.ctx, docID, false)
+	if err != nil {
+		return "", err
+	}
+	if err := doc.SetWithMap(n.updateInput); err != nil {
+		return "", err
+	}
+	err = n.collection.Update(n.p.ctx, doc)
+	if err != nil {
+		return "", err
+	}
+
+	n.execInfo.updates++
+	return docID.String(), nil
+}
+
+func (n *upsertNode) create() (string, error) {
+	doc, err := client.NewDocFromMap(n.createInput, n.collection.Definition())
+	if err != nil {
+		return "", err
+	}
+	err = n.collection.Create(n.p.ctx, doc)
+	if err != nil {
+		return "", err
+	}
+
+	n.execInfo.creates++
+	return doc.ID().String(), nil
+}
+
+func (n *upsertNode) Kind() string { return "upsertNode" }
+
+func (n *upsertNode) Spans(spans core.Spans) { n.source.Spans(spans) }
+
+func (n *upsertNode) Init() error { return n.source.Init() }
+
+func (n *upsertNode) Start() error {
+	return n.source.Start()
+}
+
+func (n *upsertNode) Close() error {
+	err := n.source.Close()
+	if err != nil {
+		return err
+	}
+	return n.results.Close()
+}
+
+func (n *upsertNode) Source() planNode { return n.source }
+
+func (n *upsertNode) simpleExplain() (map[string]any, error) {
+	simpleExplainMap := map[string]any{}
+
+	// Add the filter attribute if it exists, otherwise have it nil.
+	if n.filter == nil {
+		simpleExplainMap[filterLabel] = nil
+	} else {
+		simpleExplainMap[filterLabel] = n.filter.ToMap(n.documentMapping)
+	}
+
+	// Add the attributes that represent the create and update inputs.
+	simpleExplainMap[request.CreateInput] = n.createInput
+	simpleExplainMap[request.UpdateInput] = n.updateInput
+
+	return simpleExplainMap, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *upsertNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+			"creates":    n.execInfo.creates,
+			"updates":    n.execInfo.updates,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (p *Planner) UpsertDocs(parsed *mapper.Mutation) (planNode, error) {
+	upsert := &upsertNode{
+		p:           p,
+		filter:      parsed.Filter,
+		createInput: parsed.CreateInput,
+		updateInput: parsed.UpdateInput,
+		docMapper:   docMapper{parsed.DocumentMapping},
+	}
+
+	// get collection
+	col, err := p.db.GetCollectionByName(p.ctx, parsed.Name)
+	if err != nil {
+		return nil, err
+	}
+	upsert.collection = col
+
+	// create the Select node used to find the document matching the filter
+	sourceNode, err := p.Select(&parsed.Select)
+	if err != nil {
+		return nil, err
+	}
+	upsert.source = sourceNode
+
+	// create the results Select node, without the filter so that
+	// the upserted document is returned even if it does not match it
+	resultsSelect := parsed.Select
+	resultsSelect.Filter = nil
+	resultsNode, err := p.Select(&resultsSelect)
+	if err != nil {
+		return nil, err
+	}
+	upsert.results = resultsNode
+
+	return upsert, nil
+}
@@ -87,17 +87,25 @@ func (n *valuesNode) SortAll() {
 // If both Less(i, j) and Less(j, i) are false, then the elements at index i and j are considered equal.
 func (n *valuesNode) Less(i, j int) bool {
 	da, db := n.docs.At(i), n.docs.At(j)
-	return n.docValueLess(da, db)
+	return docValueLess(n.ordering, da, db)
 }
 
 // docValueLess extracts and compare field values of a document, returns true only if strictly less when ASC,
 // and true if greater than or equal when DESC, otherwise returns false.
-func (n *valuesNode) docValueLess(docA, docB core.Doc) bool {
-	for _, order := range n.ordering {
-		compare := base.Compare(
-			getDocProp(docA, order.FieldIndexes),
-			getDocProp(docB, order.FieldIndexes),
-		)
+func docValueLess(ordering []mapper.OrderCondition, docA, docB core.Doc) bool {
+	for _, order := range ordering {
+		var compare int
+		if len(order.JSONPath) > 0 {
+			compare = compareJSON(
+				mapper.GetJSONPathValue(getDocProp(docA, order.FieldIndexes), order.JSONPath),
+				mapper.GetJSONPathValue(getDocProp(docB, order.FieldIndexes), order.JSONPath),
+			)
+		} else {
+			compare = base.Compare(
+				getDocProp(docA, order.FieldIndexes),
+				getDocProp(docB, order.FieldIndexes),
+			)
+		}
 
 		if order.Direction == mapper.DESC {
 			if compare > 0 {
@@ -116,6 +124,50 @@ func (n *valuesNode) docValueLess(docA, docB core.Doc) bool {
 	return false
 }
 
+// The ranks of the types of decoded JSON values, in the order they are sorted in.
+const (
+	jsonNullRank = iota
+	jsonBoolRank
+	jsonNumberRank
+	jsonStringRank
+	jsonCompositeRank
+)
+
+// compareJSON compares two values decoded from JSON.
+//
+// Values of different types are ordered by type: null first, then booleans,
+// numbers, strings, and lastly objects and arrays, which are considered equal.
+func compareJSON(a, b any) int {
+	rankA, rankB := jsonTypeRank(a), jsonTypeRank(b)
+	switch {
+	case rankA < rankB:
+		return -1
+	case rankA > rankB:
+		return 1
+	case rankA == jsonCompositeRank:
+		return 0
+	default:
+		return base.Compare(a, b)
+	}
+}
+
+// jsonTypeRank returns the position of the type of the given decoded JSON value
+// within the ordering of JSON values.
+func jsonTypeRank(value any) int {
+	switch value.(type) {
+	case nil:
+		return jsonNullRank
+	case bool:
+		return jsonBoolRank
+	case float64:
+		return jsonNumberRank
+	case string:
+		return jsonStringRank
+	default:
+		return jsonCompositeRank
+	}
+}
+
 // Swap implements the golang sort.Sort interface.
 // It swaps the values at the ith and jth index
 // within the docContainer.
@@ -25,6 +25,8 @@ var (
 		"create": request.CreateObjects,
 		"update": request.UpdateObjects,
 		"delete": request.DeleteObjects,
+		"upsert": request.UpsertObjects,
+		"purge":  request.PurgeObjects,
 	}
 )
 
@@ -73,7 +75,7 @@ func parseMutation(exe *gql.ExecutionContext, parent *gql.Object, field *ast.Fie
 	// parse the mutation type
 	// mutation names are either generated from a type
 	// which means they are in the form name_type, where
-	// the name is the object mutation name (ie: create, update, delete)
+	// the name is the object mutation name (ie: create, update, delete, upsert, purge)
 	// or its an general API mutation, which is in the form
 	// name (camelCase).
 	// This means we can split on the "_" character, and always
@@ -108,6 +110,10 @@ func parseMutation(exe *gql.ExecutionContext, parent *gql.Object, field *ast.Fie
 				inputs[i] = v.(map[string]any)
 			}
 			mut.Inputs = inputs
+		} else if prop == request.CreateInput {
+			mut.CreateInput = arguments[prop].(map[string]any)
+		} else if prop == request.UpdateInput {
+			mut.UpdateInput = arguments[prop].(map[string]any)
 		} else if prop == request.FilterClause { // parse filter
 			mut.Filter = immutable.Some(request.Filter{
 				Conditions: arguments[prop].(map[string]any),
@@ -195,6 +195,7 @@ func parseAggregate(
 			var limit immutable.Option[uint64]
 			var offset immutable.Option[uint64]
 			var order immutable.Option[request.OrderBy]
+			var distinct bool
 
 			for _, f := range argument.Value.(*ast.ObjectValue).Fields {
 				switch f.Name.Value {
@@ -212,6 +213,9 @@ func parseAggregate(
 				case request.OffsetClause:
 					offset = immutable.Some(uint64(v[request.OffsetClause].(int32)))
 
+				case request.DistinctClause:
+					distinct, _ = v[request.DistinctClause].(bool)
+
 				case request.OrderClause:
 					switch conditionsAST := f.Value.(type) {
 					case *ast.EnumValue:
@@ -264,6 +268,7 @@ func parseAggregate(
 				Orderable: request.Orderable{
 					OrderBy: order,
 				},
+				Distinct: distinct,
 			}
 		}
 	}
@@ -14,7 +14,9 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	gql "github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
@@ -139,6 +141,7 @@ func collectionFromAstDefinition(
 	}
 
 	policyDescription := immutable.None[client.PolicyDescription]()
+	retention := immutable.None[client.RetentionPolicy]()
 
 	indexDescriptions := []client.IndexDescription{}
 	for _, field := range def.Fields {
@@ -203,6 +206,13 @@ func collectionFromAstDefinition(
 			}
 			policyDescription = immutable.Some(policy)
 
+		case types.RetentionDirectiveLabel:
+			policy, err := retentionFromAST(directive)
+			if err != nil {
+				return client.CollectionDefinition{}, err
+			}
+			retention = immutable.Some(policy)
+
 		case types.MaterializedDirectiveLabel:
 			if isMaterialized.Value() {
 				continue
@@ -231,6 +241,7 @@ func collectionFromAstDefinition(
 			Policy:         policyDescription,
 			Fields:         collectionFieldDescriptions,
 			IsMaterialized: !isMaterialized.HasValue() || isMaterialized.Value(),
+			Retention:      retention,
 		},
 		Schema: client.SchemaDescription{
 			Name:   def.Name.Value,
@@ -590,6 +601,39 @@ func policyFromAST(directive *ast.Directive) (client.PolicyDescription, error) {
 	return policyDesc, nil
 }
 
+// retentionFromAST returns the retention policy after parsing, the validation of the
+// values is done when the collection is added.
+func retentionFromAST(directive *ast.Directive) (client.RetentionPolicy, error) {
+	policy := client.RetentionPolicy{}
+	for _, arg := range directive.Arguments {
+		switch arg.Name.Value {
+		case types.RetentionDirectivePropDepth:
+			depthProp, ok := arg.Value.(*ast.IntValue)
+			if !ok {
+				return client.RetentionPolicy{}, ErrRetentionInvalidDepthProp
+			}
+			depth, err := strconv.ParseUint(depthProp.Value, 10, 64)
+			if err != nil {
+				return client.RetentionPolicy{}, ErrRetentionInvalidDepthProp
+			}
+			policy.Depth = immutable.Some(depth)
+		case types.RetentionDirectivePropAge:
+			ageProp, ok := arg.Value.(*ast.StringValue)
+			if !ok {
+				return client.RetentionPolicy{}, ErrRetentionInvalidAgeProp
+			}
+			age, err := time.ParseDuration(ageProp.Value)
+			if err != nil {
+				return client.RetentionPolicy{}, ErrRetentionInvalidAgeProp
+			}
+			policy.Age = immutable.Some(age)
+		default:
+			return client.RetentionPolicy{}, ErrRetentionWithUnknownArg
+		}
+	}
+	return policy, nil
+}
+
 func setCRDTType(field *ast.FieldDefinition, kind client.FieldKind) (client.CType, error) {
 	if directive, exists := findDirective(field, "crdt"); exists {
 		for _, arg := range directive.Arguments {
@@ -108,6 +108,14 @@ Creates one or more documents of this type using the data provided.
 Updates documents in this collection using the data provided. Only documents
  matching any provided criteria will be updated, if no criteria are provided
  the update will be applied to all documents in the collection.
+`
+	appendOperatorDescription string = `
+Values to append to the end of the given array fields. Appends are applied
+ after all other field values have been set.
+`
+	removeOperatorDescription string = `
+Values to remove from the given array fields. All occurrences of each value
+ are removed before any values are appended.
 `
 	updateIDArgDescription string = `
 An optional docID value that will limit the update to the document with
@@ -123,6 +131,16 @@ An optional set of docID values that will limit the update to documents
 An optional filter for this update that will limit the update to the documents
  matching the given criteria. If no matching documents are found, the operation
  will succeed, but no documents will be updated.
+`
+	upsertDocumentDescription string = `
+Updates the document in this collection matching the given filter using the
+ update data provided. If no document matches the filter, a new document is
+ created using the create data provided. Both operations are executed within
+ a single transaction.
+`
+	upsertFilterArgDescription string = `
+The filter used to find the document to update. If more than one document
+ matches the filter, the operation will fail.
 `
 	deleteDocumentsDescription string = `
 Deletes documents in this collection matching any provided criteria. If no
@@ -143,6 +161,28 @@ An optional set of docID values that will limit the delete to documents with
 An optional filter for this delete that will limit the delete to documents
  matching the given criteria. If no matching documents are found, the operation
  will succeed, but no documents will be deleted.
+`
+	purgeDocumentsDescription string = `
+Permanently removes documents in this collection matching any provided criteria,
+ including their history. Only a tombstone of each document remains, which
+ prevents it from being recreated. If no criteria are provided all documents in
+ the collection will be purged.
+`
+	purgeIDArgDescription string = `
+An optional docID value that will limit the purge to the document with
+ a matching docID. If no matching document is found, the operation will
+ succeed, but no documents will be purged.
+`
+	purgeIDsArgDescription string = `
+An optional set of docID values that will limit the purge to documents with
+ a matching docID. If no matching documents are found, the operation will
+ succeed, but no documents will be purged. If an empty set is provided, no
+ documents will be purged.
+`
+	purgeFilterArgDescription string = `
+An optional filter for this purge that will limit the purge to documents
+ matching the given criteria. If no matching documents are found, the operation
+ will succeed, but no documents will be purged.
 `
 	groupFieldDescription string = `
 The group field may be used to return a set of records belonging to the group.
@@ -152,6 +192,14 @@ The group field may be used to return a set of records belonging to the group.
 `
 	deletedFieldDescription string = `
 Indicates as to whether or not this document has been deleted.
+`
+	allowanceFieldDescription string = `
+Returns the remaining allowance of the local node for each bounded counter field
+ of this document, the amount the node can still decrement the field by.
+`
+	scoreFieldDescription string = `
+Returns the relevance of this document to the '_search' conditions of the request,
+ null if the request has none. It may be used to order documents by relevance.
 `
 	versionFieldDescription string = `
 Returns the head commit for this document.
@@ -32,6 +32,9 @@ const (
 	errPolicyInvalidResourceProp     string = "policy directive with invalid resource property"
 	errDefaultValueInvalid           string = "default value type must match field type"
 	errDefaultValueNotAllowed        string = "default value is not allowed for this field type"
+	errRetentionUnknownArgument      string = "retention with unknown argument"
+	errRetentionInvalidDepthProp     string = "retention directive with invalid depth property"
+	errRetentionInvalidAgeProp       string = "retention directive with invalid age property"
 )
 
 var (
@@ -58,6 +61,9 @@ var (
 	ErrPolicyWithUnknownArg      = errors.New(errPolicyUnknownArgument)
 	ErrPolicyInvalidIDProp       = errors.New(errPolicyInvalidIDProp)
 	ErrPolicyInvalidResourceProp = errors.New(errPolicyInvalidResourceProp)
+	ErrRetentionWithUnknownArg   = errors.New(errRetentionUnknownArgument)
+	ErrRetentionInvalidDepthProp = errors.New(errRetentionInvalidDepthProp)
+	ErrRetentionInvalidAgeProp   = errors.New(errRetentionInvalidAgeProp)
 )
 
 func NewErrDuplicateField(objectName, fieldName string) error {
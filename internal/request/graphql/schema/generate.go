@@ -31,8 +31,14 @@ const (
 	filterInputNameSuffix    = "FilterArg"
 	mutationInputNameSuffix  = "MutationInputArg"
 	mutationInputsNameSuffix = "MutationInputsArg"
+	updateInputNameSuffix    = "UpdateMutationInputArg"
+	arrayInputNameSuffix     = "ArrayMutationInputArg"
 )
 
+// comparableTypeName is the name of the scalar type of the values returned by the `_min`
+// and `_max` aggregates.
+const comparableTypeName = "Comparable"
+
 const (
 	typeFieldEnumSuffix         = "Field"
 	typeExplicitFieldEnumSuffix = "ExplicitField"
@@ -515,6 +521,22 @@ func (g *Generator) buildTypes(
 					Description: deletedFieldDescription,
 					Type:        gql.Boolean,
 				}
+
+				if hasBoundedCounterField(fieldDescriptions) {
+					// add _allowance field
+					fields[request.AllowanceFieldName] = &gql.Field{
+						Description: allowanceFieldDescription,
+						Type:        fieldKindToGQLType[client.FieldKind_NILLABLE_JSON],
+					}
+				}
+
+				if hasFullTextIndex(collection.Description.Indexes) {
+					// add _score field
+					fields[request.ScoreFieldName] = &gql.Field{
+						Description: scoreFieldDescription,
+						Type:        gql.Float,
+					}
+				}
 			}
 
 			return fields, nil
@@ -532,8 +554,30 @@ func (g *Generator) buildTypes(
 	return objs, nil
 }
 
+// hasBoundedCounterField returns true if any of the given fields is a bounded counter.
+func hasBoundedCounterField(fields []client.FieldDefinition) bool {
+	for _, field := range fields {
+		if field.Typ == client.BOUNDED_COUNTER {
+			return true
+		}
+	}
+	return false
+}
+
+// hasFullTextIndex returns true if any of the given indexes is a full-text index.
+func hasFullTextIndex(indexes []client.IndexDescription) bool {
+	for _, index := range indexes {
+		if index.IsFullText() {
+			return true
+		}
+	}
+	return false
+}
+
 // buildMutationInputTypes creates the input object types
 // for collection create and update mutation operations.
+//
+// The update input type additionally holds the array operators of the collection.
 func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefinition) error {
 	for _, collection := range collections {
 		if !collection.Description.Name.HasValue() {
@@ -544,63 +588,130 @@ func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefin
 		}
 
 		mutationInputName := collection.Description.Name.Value() + mutationInputNameSuffix
+		updateInputName := collection.Description.Name.Value() + updateInputNameSuffix
 
-		// check if mutation input type exists
+		// check if mutation input types exist
 		if _, ok := g.manager.schema.TypeMap()[mutationInputName]; ok {
 			return NewErrMutationInputTypeAlreadyExist(mutationInputName)
 		}
+		if _, ok := g.manager.schema.TypeMap()[updateInputName]; ok {
+			return NewErrMutationInputTypeAlreadyExist(updateInputName)
+		}
 
-		mutationObjConf := gql.InputObjectConfig{
-			Name: mutationInputName,
+		arrayInputObj, err := g.buildArrayMutationInputType(collection)
+		if err != nil {
+			return err
 		}
 
-		// Wrap mutation input object definition in a thunk so we can
+		// Wrap mutation input object definitions in a thunk so we can
 		// handle any embedded object which is defined
 		// at a future point in time.
-		mutationObjConf.Fields = (gql.InputObjectConfigFieldMapThunk)(func() (gql.InputObjectConfigFieldMap, error) {
-			fields := make(gql.InputObjectConfigFieldMap)
+		mutationObj := gql.NewInputObject(gql.InputObjectConfig{
+			Name: mutationInputName,
+			Fields: (gql.InputObjectConfigFieldMapThunk)(func() (gql.InputObjectConfigFieldMap, error) {
+				return g.buildMutationInputFields(collection)
+			}),
+		})
+		g.manager.schema.TypeMap()[mutationObj.Name()] = mutationObj
 
-			for _, field := range collection.GetFields() {
-				if strings.HasPrefix(field.Name, "_") {
-					// ignore system defined args as the
-					// user cannot override their values
-					continue
+		updateObj := gql.NewInputObject(gql.InputObjectConfig{
+			Name: updateInputName,
+			Fields: (gql.InputObjectConfigFieldMapThunk)(func() (gql.InputObjectConfigFieldMap, error) {
+				fields, err := g.buildMutationInputFields(collection)
+				if err != nil {
+					return nil, err
 				}
-
-				var ttype gql.Type
-				if field.Kind.IsObject() {
-					if field.Kind.IsArray() {
-						ttype = gql.NewList(gql.ID)
-					} else {
-						ttype = gql.ID
+				if arrayInputObj != nil {
+					fields[request.AppendOperatorName] = &gql.InputObjectFieldConfig{
+						Type:        arrayInputObj,
+						Description: appendOperatorDescription,
 					}
-				} else {
-					var ok bool
-					ttype, ok = fieldKindToGQLType[field.Kind]
-					if !ok {
-						return nil, NewErrTypeNotFound(fmt.Sprint(field.Kind))
+					fields[request.RemoveOperatorName] = &gql.InputObjectFieldConfig{
+						Type:        arrayInputObj,
+						Description: removeOperatorDescription,
 					}
 				}
+				return fields, nil
+			}),
+		})
+		g.manager.schema.TypeMap()[updateObj.Name()] = updateObj
+	}
 
-				fields[field.Name] = &gql.InputObjectFieldConfig{
-					Type:         ttype,
-					DefaultValue: field.DefaultValue,
-				}
+	return nil
+}
+
+// buildMutationInputFields returns the input fields of the given collection
+// that can be set by create and update mutation operations.
+func (g *Generator) buildMutationInputFields(
+	collection client.CollectionDefinition,
+) (gql.InputObjectConfigFieldMap, error) {
+	fields := make(gql.InputObjectConfigFieldMap)
+
+	for _, field := range collection.GetFields() {
+		if strings.HasPrefix(field.Name, "_") {
+			// ignore system defined args as the
+			// user cannot override their values
+			continue
+		}
+
+		var ttype gql.Type
+		if field.Kind.IsObject() {
+			if field.Kind.IsArray() {
+				ttype = gql.NewList(gql.ID)
+			} else {
+				ttype = gql.ID
+			}
+		} else {
+			var ok bool
+			ttype, ok = fieldKindToGQLType[field.Kind]
+			if !ok {
+				return nil, NewErrTypeNotFound(fmt.Sprint(field.Kind))
 			}
+		}
 
-			return fields, nil
-		})
+		fields[field.Name] = &gql.InputObjectFieldConfig{
+			Type:         ttype,
+			DefaultValue: field.DefaultValue,
+		}
+	}
 
-		mutationObj := gql.NewInputObject(mutationObjConf)
-		g.manager.schema.TypeMap()[mutationObj.Name()] = mutationObj
+	return fields, nil
+}
+
+// buildArrayMutationInputType builds the input type used by the array operators of the
+// mutation input, holding all the scalar array fields of the given collection.
+//
+// Nil is returned if the collection has no scalar array fields.
+func (g *Generator) buildArrayMutationInputType(collection client.CollectionDefinition) (*gql.InputObject, error) {
+	fields := gql.InputObjectConfigFieldMap{}
+	for _, field := range collection.GetFields() {
+		if strings.HasPrefix(field.Name, "_") || !field.Kind.IsArray() || field.Kind.IsObject() {
+			continue
+		}
+		ttype, ok := fieldKindToGQLType[field.Kind]
+		if !ok {
+			return nil, NewErrTypeNotFound(fmt.Sprint(field.Kind))
+		}
+		fields[field.Name] = &gql.InputObjectFieldConfig{
+			Type: ttype,
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
 	}
 
-	return nil
+	arrayInputObj := gql.NewInputObject(gql.InputObjectConfig{
+		Name:   collection.Description.Name.Value() + arrayInputNameSuffix,
+		Fields: fields,
+	})
+	g.manager.schema.TypeMap()[arrayInputObj.Name()] = arrayInputObj
+	return arrayInputObj, nil
 }
 
 func (g *Generator) genAggregateFields() error {
 	topLevelCountInputs := map[string]*gql.InputObject{}
 	topLevelNumericAggInputs := map[string]*gql.InputObject{}
+	topLevelComparableAggInputs := map[string]*gql.InputObject{}
 
 	for _, t := range g.typeDefs {
 		numArg := g.genNumericAggregateBaseArgInputs(t)
@@ -619,6 +730,21 @@ func (g *Generator) genAggregateFields() error {
 			}
 		}
 
+		comparableArg := g.genComparableAggregateBaseArgInputs(t)
+		topLevelComparableAggInputs[t.Name()] = comparableArg
+		err = g.appendIfNotExists(comparableArg)
+		if err != nil {
+			return err
+		}
+
+		comparableInlineArrayInputs := g.genComparableInlineArraySelectorObject(t)
+		for _, obj := range comparableInlineArrayInputs {
+			err = g.appendIfNotExists(obj)
+			if err != nil {
+				return err
+			}
+		}
+
 		obj := g.genCountBaseArgInputs(t)
 		topLevelCountInputs[t.Name()] = obj
 		err = g.appendIfNotExists(obj)
@@ -653,6 +779,18 @@ func (g *Generator) genAggregateFields() error {
 			return err
 		}
 		t.AddFieldConfig(averageField.Name, &averageField)
+
+		minField, err := g.genComparableAggregateFieldConfig(t, request.MinFieldName, schemaTypes.MinFieldDescription)
+		if err != nil {
+			return err
+		}
+		t.AddFieldConfig(minField.Name, &minField)
+
+		maxField, err := g.genComparableAggregateFieldConfig(t, request.MaxFieldName, schemaTypes.MaxFieldDescription)
+		if err != nil {
+			return err
+		}
+		t.AddFieldConfig(maxField.Name, &maxField)
 	}
 
 	queryType := g.manager.schema.QueryType()
@@ -664,6 +802,11 @@ func (g *Generator) genAggregateFields() error {
 		queryType.AddFieldConfig(topLevelAgg.Name, topLevelAgg)
 	}
 
+	comparableType := g.manager.schema.TypeMap()[comparableTypeName]
+	for _, topLevelAgg := range genTopLevelComparableAggregates(comparableType, topLevelComparableAggInputs) {
+		queryType.AddFieldConfig(topLevelAgg.Name, topLevelAgg)
+	}
+
 	return nil
 }
 
@@ -705,6 +848,32 @@ func genTopLevelNumericAggregates(topLevelNumericAggInputs map[string]*gql.Input
 	return []*gql.Field{&topLevelSumField, &topLevelAverageField}
 }
 
+func genTopLevelComparableAggregates(
+	comparableType gql.Type,
+	topLevelComparableAggInputs map[string]*gql.InputObject,
+) []*gql.Field {
+	topLevelMinField := gql.Field{
+		Name:        request.MinFieldName,
+		Description: schemaTypes.MinFieldDescription,
+		Type:        comparableType,
+		Args:        gql.FieldConfigArgument{},
+	}
+
+	topLevelMaxField := gql.Field{
+		Name:        request.MaxFieldName,
+		Description: schemaTypes.MaxFieldDescription,
+		Type:        comparableType,
+		Args:        gql.FieldConfigArgument{},
+	}
+
+	for name, inputObject := range topLevelComparableAggInputs {
+		topLevelMinField.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+		topLevelMaxField.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+	}
+
+	return []*gql.Field{&topLevelMinField, &topLevelMaxField}
+}
+
 func (g *Generator) genCountFieldConfig(obj *gql.Object) (gql.Field, error) {
 	childTypesByFieldName := map[string]gql.Type{}
 
@@ -822,6 +991,52 @@ func (g *Generator) genAverageFieldConfig(obj *gql.Object) (gql.Field, error) {
 	return field, nil
 }
 
+// genComparableAggregateFieldConfig generates the config of the aggregate field with the given
+// name, which aggregates comparable values, such as `_min` and `_max`.
+func (g *Generator) genComparableAggregateFieldConfig(
+	obj *gql.Object,
+	name string,
+	description string,
+) (gql.Field, error) {
+	childTypesByFieldName := map[string]gql.Type{}
+
+	for _, field := range obj.Fields() {
+		// we can only compare list items
+		listType, isList := field.Type.(*gql.List)
+		if !isList {
+			continue
+		}
+
+		var inputObjectName string
+		if isComparableArray(listType) {
+			inputObjectName = genComparableInlineArraySelectorName(obj.Name(), field.Name)
+		} else {
+			inputObjectName = genComparableObjectSelectorName(listType.OfType.Name())
+		}
+
+		subComparableType, isSubTypeComparable := g.manager.schema.TypeMap()[inputObjectName]
+		// If the item is not in the type map, it must contain no comparable
+		//  fields (e.g. no Int/Float/DateTime/Strings)
+		if !isSubTypeComparable {
+			continue
+		}
+		childTypesByFieldName[field.Name] = subComparableType
+	}
+
+	field := gql.Field{
+		Name:        name,
+		Description: description,
+		Type:        g.manager.schema.TypeMap()[comparableTypeName],
+		Args:        gql.FieldConfigArgument{},
+	}
+
+	for name, inputObject := range childTypesByFieldName {
+		field.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+	}
+
+	return field, nil
+}
+
 func (g *Generator) genNumericInlineArraySelectorObject(obj *gql.Object) []*gql.InputObject {
 	objects := []*gql.InputObject{}
 	for _, field := range obj.Fields() {
@@ -858,6 +1073,50 @@ func (g *Generator) genNumericInlineArraySelectorObject(obj *gql.Object) []*gql.
 	return objects
 }
 
+func (g *Generator) genComparableInlineArraySelectorObject(obj *gql.Object) []*gql.InputObject {
+	objects := []*gql.InputObject{}
+	for _, field := range obj.Fields() {
+		// we can only act on list items
+		listType, isList := field.Type.(*gql.List)
+		if !isList {
+			continue
+		}
+
+		if isComparableArray(listType) {
+			// If it is an inline scalar array then we require an empty
+			//  object as an argument due to the lack of union input types
+			selectorObject := gql.NewInputObject(gql.InputObjectConfig{
+				Name: genComparableInlineArraySelectorName(obj.Name(), field.Name),
+				Fields: gql.InputObjectConfigFieldMap{
+					request.LimitClause: &gql.InputObjectFieldConfig{
+						Type:        gql.Int,
+						Description: schemaTypes.LimitArgDescription,
+					},
+					request.OffsetClause: &gql.InputObjectFieldConfig{
+						Type:        gql.Int,
+						Description: schemaTypes.OffsetArgDescription,
+					},
+					request.OrderClause: &gql.InputObjectFieldConfig{
+						Type:        g.manager.schema.TypeMap()["Ordering"],
+						Description: schemaTypes.OrderArgDescription,
+					},
+				},
+			})
+
+			objects = append(objects, selectorObject)
+		}
+	}
+	return objects
+}
+
+func genComparableObjectSelectorName(hostName string) string {
+	return fmt.Sprintf("%s__%s", hostName, "ComparableSelector")
+}
+
+func genComparableInlineArraySelectorName(hostName string, fieldName string) string {
+	return fmt.Sprintf("%s__%s__%s", hostName, fieldName, "ComparableSelector")
+}
+
 func genNumericObjectSelectorName(hostName string) string {
 	return fmt.Sprintf("%s__%s", hostName, "NumericSelector")
 }
@@ -867,9 +1126,8 @@ func genNumericInlineArraySelectorName(hostName string, fieldName string) string
 }
 
 func (g *Generator) genCountBaseArgInputs(obj *gql.Object) *gql.InputObject {
-	countableObject := gql.NewInputObject(gql.InputObjectConfig{
-		Name: genObjectCountName(obj.Name()),
-		Fields: gql.InputObjectConfigFieldMap{
+	var fieldThunk gql.InputObjectConfigFieldMapThunk = func() (gql.InputObjectConfigFieldMap, error) {
+		fields := gql.InputObjectConfigFieldMap{
 			request.LimitClause: &gql.InputObjectFieldConfig{
 				Type:        gql.Int,
 				Description: schemaTypes.LimitArgDescription,
@@ -878,7 +1136,29 @@ func (g *Generator) genCountBaseArgInputs(obj *gql.Object) *gql.InputObject {
 				Type:        gql.Int,
 				Description: schemaTypes.OffsetArgDescription,
 			},
-		},
+			request.DistinctClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Boolean,
+				Description: schemaTypes.CountDistinctArgDescription,
+			},
+		}
+
+		fieldsEnum, err := g.genComparableFieldsEnum(obj)
+		if err != nil {
+			return nil, err
+		}
+		if fieldsEnum != nil {
+			// If a field is given, the values of the field are counted instead of the items.
+			fields[request.FieldName] = &gql.InputObjectFieldConfig{
+				Type: fieldsEnum,
+			}
+		}
+
+		return fields, nil
+	}
+
+	countableObject := gql.NewInputObject(gql.InputObjectConfig{
+		Name:   genObjectCountName(obj.Name()),
+		Fields: fieldThunk,
 	})
 
 	return countableObject
@@ -906,6 +1186,10 @@ func (g *Generator) genCountInlineArrayInputs(obj *gql.Object) []*gql.InputObjec
 					Type:        gql.Int,
 					Description: schemaTypes.OffsetArgDescription,
 				},
+				request.DistinctClause: &gql.InputObjectFieldConfig{
+					Type:        gql.Boolean,
+					Description: schemaTypes.CountDistinctArgDescription,
+				},
 			},
 		})
 
@@ -993,6 +1277,92 @@ func (g *Generator) genNumericAggregateBaseArgInputs(obj *gql.Object) *gql.Input
 	})
 }
 
+// Generates the base (comparable-only) aggregate input object-type for the give gql object,
+// declaring which fields are available for aggregation.
+func (g *Generator) genComparableAggregateBaseArgInputs(obj *gql.Object) *gql.InputObject {
+	var fieldThunk gql.InputObjectConfigFieldMapThunk = func() (gql.InputObjectConfigFieldMap, error) {
+		fieldsEnum, err := g.genComparableFieldsEnum(obj)
+		if err != nil || fieldsEnum == nil {
+			return nil, err
+		}
+
+		return gql.InputObjectConfigFieldMap{
+			"field": &gql.InputObjectFieldConfig{
+				Type: gql.NewNonNull(fieldsEnum),
+			},
+			request.LimitClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Int,
+				Description: schemaTypes.LimitArgDescription,
+			},
+			request.OffsetClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Int,
+				Description: schemaTypes.OffsetArgDescription,
+			},
+			request.OrderClause: &gql.InputObjectFieldConfig{
+				Type:        g.manager.schema.TypeMap()[genTypeName(obj, "OrderArg")],
+				Description: schemaTypes.OrderArgDescription,
+			},
+		}, nil
+	}
+
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:   genComparableObjectSelectorName(obj.Name()),
+		Fields: fieldThunk,
+	})
+}
+
+// genComparableFieldsEnum returns the enum of the fields of the given gql object that hold
+// comparable values, creating it if it does not exist yet.
+//
+// It returns nil if the object has no comparable fields.
+func (g *Generator) genComparableFieldsEnum(obj *gql.Object) (*gql.Enum, error) {
+	if fieldsEnum, enumExists := g.manager.schema.TypeMap()[genTypeName(obj, "ComparableFieldsArg")]; enumExists {
+		return fieldsEnum.(*gql.Enum), nil
+	}
+
+	fieldsEnumCfg := gql.EnumConfig{
+		Name:   genTypeName(obj, "ComparableFieldsArg"),
+		Values: gql.EnumValueConfigMap{},
+	}
+
+	hasComparableFields := false
+	// generate the enum values for all the comparable types
+	for _, field := range obj.Fields() {
+		if isComparableType(field.Type) {
+			hasComparableFields = true
+			fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
+			continue
+		}
+
+		if list, isList := field.Type.(*gql.List); isList {
+			if isComparableArray(list) {
+				hasComparableFields = true
+				fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
+			} else if _, isObject := list.OfType.(*gql.Object); isObject {
+				hasComparableFields = true
+				// If it is a related list, we need to add count in here so that we can compare it
+				fieldsEnumCfg.Values[request.CountFieldName] = &gql.EnumValueConfig{Value: request.CountFieldName}
+			}
+		}
+	}
+
+	if !hasComparableFields {
+		return nil, nil
+	}
+
+	// A child aggregate will always be aggregatable, as it can be present via an inner grouping
+	for aggregateName := range request.Aggregates {
+		fieldsEnumCfg.Values[aggregateName] = &gql.EnumValueConfig{Value: aggregateName}
+	}
+
+	fieldsEnum := gql.NewEnum(fieldsEnumCfg)
+	err := g.manager.schema.AppendType(fieldsEnum)
+	if err != nil {
+		return nil, err
+	}
+	return fieldsEnum, nil
+}
+
 func (g *Generator) appendCommitChildGroupField() {
 	commitObject := g.manager.schema.TypeMap()[request.CommitTypeName]
 
@@ -1035,6 +1405,7 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 
 	filterInputName := genTypeName(obj, filterInputNameSuffix)
 	mutationInputName := genTypeName(obj, mutationInputNameSuffix)
+	updateInputName := genTypeName(obj, updateInputNameSuffix)
 
 	filterInput, ok := g.manager.schema.TypeMap()[filterInputName].(*gql.InputObject)
 	if !ok {
@@ -1046,6 +1417,11 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 		return nil, NewErrTypeNotFound(mutationInputName)
 	}
 
+	updateInput, ok := g.manager.schema.TypeMap()[updateInputName]
+	if !ok {
+		return nil, NewErrTypeNotFound(updateInputName)
+	}
+
 	explicitUserFieldsEnum := g.genUserExplicitTypeFieldsEnum(obj)
 
 	g.manager.schema.TypeMap()[explicitUserFieldsEnum.Name()] = explicitUserFieldsEnum
@@ -1072,7 +1448,7 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 			request.DocIDArgName:  schemaTypes.NewArgConfig(gql.ID, updateIDArgDescription),
 			request.DocIDsArgName: schemaTypes.NewArgConfig(gql.NewList(gql.ID), updateIDsArgDescription),
 			"filter":              schemaTypes.NewArgConfig(filterInput, updateFilterArgDescription),
-			request.Input:         schemaTypes.NewArgConfig(mutationInput, "Update field values"),
+			request.Input:         schemaTypes.NewArgConfig(updateInput, "Update field values"),
 		},
 	}
 
@@ -1087,7 +1463,31 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 		},
 	}
 
-	return []*gql.Field{create, update, delete}, nil
+	purge := &gql.Field{
+		Name:        "purge_" + obj.Name(),
+		Description: purgeDocumentsDescription,
+		Type:        gql.NewList(obj),
+		Args: gql.FieldConfigArgument{
+			request.DocIDArgName:  schemaTypes.NewArgConfig(gql.ID, purgeIDArgDescription),
+			request.DocIDsArgName: schemaTypes.NewArgConfig(gql.NewList(gql.ID), purgeIDsArgDescription),
+			"filter":              schemaTypes.NewArgConfig(filterInput, purgeFilterArgDescription),
+		},
+	}
+
+	upsert := &gql.Field{
+		Name:        "upsert_" + obj.Name(),
+		Description: upsertDocumentDescription,
+		Type:        gql.NewList(obj),
+		Args: gql.FieldConfigArgument{
+			request.FilterClause: schemaTypes.NewArgConfig(gql.NewNonNull(filterInput), upsertFilterArgDescription),
+			request.CreateInput: schemaTypes.NewArgConfig(gql.NewNonNull(mutationInput),
+				"Create a "+obj.Name()+" document if no document matches the filter"),
+			request.UpdateInput: schemaTypes.NewArgConfig(gql.NewNonNull(updateInput),
+				"Update field values of the "+obj.Name()+" document matching the filter"),
+		},
+	}
+
+	return []*gql.Field{create, update, delete, upsert, purge}, nil
 }
 
 func (g *Generator) genTypeFieldsEnum(obj *gql.Object) *gql.Enum {
@@ -1146,7 +1546,7 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 			// generate basic filter operator blocks
 			// @todo: Extract object field loop into its own utility func
 			for f, field := range obj.Fields() {
-				if _, ok := request.ReservedFields[f]; ok && f != request.DocIDFieldName {
+				if _, ok := request.ReservedFields[f]; ok && f != request.DocIDFieldName && f != request.ScoreFieldName {
 					continue
 				}
 				// scalars (leafs)
@@ -1250,12 +1650,20 @@ func (g *Generator) genTypeOrderArgInput(obj *gql.Object) *gql.InputObject {
 			fields := gql.InputObjectConfigFieldMap{}
 
 			for f, field := range obj.Fields() {
-				if _, ok := request.ReservedFields[f]; ok && f != request.DocIDFieldName {
+				// Aggregates may be ordered by when they are selected
+				_, isAggregate := request.Aggregates[f]
+				if _, ok := request.ReservedFields[f]; ok && !isAggregate &&
+					f != request.DocIDFieldName && f != request.ScoreFieldName {
 					continue
 				}
 				typeMap := g.manager.schema.TypeMap()
 				configType, isOrderable := typeMap[genTypeName(field.Type, "OrderArg")]
-				if gql.IsLeafType(field.Type) { // only Scalars, and enums
+				if field.Type.Name() == fieldKindToGQLType[client.FieldKind_NILLABLE_JSON].Name() {
+					// JSON values may also be ordered by the properties within them
+					fields[field.Name] = &gql.InputObjectFieldConfig{
+						Type: typeMap["JSONOrdering"],
+					}
+				} else if gql.IsLeafType(field.Type) { // only Scalars, and enums
 					fields[field.Name] = &gql.InputObjectFieldConfig{
 						Type: typeMap["Ordering"],
 					}
@@ -1335,6 +1743,23 @@ func genTypeName(obj gql.Type, name string) string {
 	return fmt.Sprintf("%s%s", obj.Name(), name)
 }
 
+// isComparableType returns true if the given type is a type of comparable values.
+func isComparableType(fieldType gql.Type) bool {
+	return fieldType == gql.Int ||
+		fieldType == gql.Float ||
+		fieldType == gql.DateTime ||
+		fieldType == gql.String
+}
+
+// isComparableArray returns true if the given list is a list of comparable values.
+func isComparableArray(list *gql.List) bool {
+	// We have to compare the names here, as the gql lib we use
+	// does not have an easier way to compare non-nullable types
+	return isNumericArray(list) ||
+		list.OfType.Name() == gql.NewNonNull(gql.String).Name() ||
+		list.OfType == gql.String
+}
+
 // isNumericArray returns true if the given list is a list of numerical values.
 func isNumericArray(list *gql.List) bool {
 	// We have to compare the names here, as the gql lib we use
@@ -33,7 +33,8 @@ func NewSchemaManager() (*SchemaManager, error) {
 	explainEnum := schemaTypes.ExplainEnum()
 
 	commitLinkObject := schemaTypes.CommitLinkObject()
-	commitObject := schemaTypes.CommitObject(commitLinkObject)
+	commitSignatureObject := schemaTypes.CommitSignatureObject()
+	commitObject := schemaTypes.CommitObject(commitLinkObject, commitSignatureObject)
 	commitsOrderArg := schemaTypes.CommitsOrderArg(orderEnum)
 
 	indexFieldInput := schemaTypes.IndexFieldInputObject(orderEnum)
@@ -42,6 +43,7 @@ func NewSchemaManager() (*SchemaManager, error) {
 		Types: defaultTypes(
 			commitObject,
 			commitLinkObject,
+			commitSignatureObject,
 			commitsOrderArg,
 			orderEnum,
 			crdtEnum,
@@ -159,6 +161,7 @@ func defaultDirectivesType(
 		schemaTypes.PrimaryDirective(),
 		schemaTypes.RelationDirective(),
 		schemaTypes.MaterializedDirective(),
+		schemaTypes.RetentionDirective(),
 	}
 }
 
@@ -179,6 +182,7 @@ func inlineArrayTypes() []gql.Type {
 func defaultTypes(
 	commitObject *gql.Object,
 	commitLinkObject *gql.Object,
+	commitSignatureObject *gql.Object,
 	commitsOrderArg *gql.InputObject,
 	orderEnum *gql.Enum,
 	crdtEnum *gql.Enum,
@@ -200,11 +204,13 @@ func defaultTypes(
 		// Custom Scalar types
 		blobScalarType,
 		jsonScalarType,
+		schemaTypes.ComparableScalarType(),
 
 		// Base Query types
 
 		// Sort/Order enum
 		orderEnum,
+		schemaTypes.JSONOrderingScalarType(),
 
 		// Filter scalar blocks
 		schemaTypes.BooleanOperatorBlock(),
@@ -217,13 +223,14 @@ func defaultTypes(
 		schemaTypes.NotNullIntOperatorBlock(),
 		schemaTypes.StringOperatorBlock(),
 		schemaTypes.NotNullstringOperatorBlock(),
-		schemaTypes.JSONOperatorBlock(jsonScalarType),
-		schemaTypes.NotNullJSONOperatorBlock(jsonScalarType),
+		schemaTypes.JSONOperatorBlock(),
+		schemaTypes.NotNullJSONOperatorBlock(),
 		schemaTypes.BlobOperatorBlock(blobScalarType),
 		schemaTypes.NotNullBlobOperatorBlock(blobScalarType),
 
 		commitsOrderArg,
 		commitLinkObject,
+		commitSignatureObject,
 		commitObject,
 
 		crdtEnum,
@@ -314,6 +314,10 @@ func StringOperatorBlock() *gql.InputObject {
 				Description: nilikeStringOperatorDescription,
 				Type:        gql.String,
 			},
+			"_search": &gql.InputObjectFieldConfig{
+				Description: searchStringOperatorDescription,
+				Type:        gql.String,
+			},
 		},
 	})
 }
@@ -356,91 +360,37 @@ func NotNullstringOperatorBlock() *gql.InputObject {
 				Description: nilikeStringOperatorDescription,
 				Type:        gql.String,
 			},
+			"_search": &gql.InputObjectFieldConfig{
+				Description: searchStringOperatorDescription,
+				Type:        gql.String,
+			},
 		},
 	})
 }
 
-// JSONOperatorBlock filter block for string types.
-func JSONOperatorBlock(jsonScalarType *gql.Scalar) *gql.InputObject {
-	return gql.NewInputObject(gql.InputObjectConfig{
-		Name:        "JSONOperatorBlock",
-		Description: stringOperatorBlockDescription,
-		Fields: gql.InputObjectConfigFieldMap{
-			"_eq": &gql.InputObjectFieldConfig{
-				Description: eqOperatorDescription,
-				Type:        jsonScalarType,
-			},
-			"_ne": &gql.InputObjectFieldConfig{
-				Description: neOperatorDescription,
-				Type:        jsonScalarType,
-			},
-			"_in": &gql.InputObjectFieldConfig{
-				Description: inOperatorDescription,
-				Type:        gql.NewList(jsonScalarType),
-			},
-			"_nin": &gql.InputObjectFieldConfig{
-				Description: ninOperatorDescription,
-				Type:        gql.NewList(jsonScalarType),
-			},
-			"_like": &gql.InputObjectFieldConfig{
-				Description: likeStringOperatorDescription,
-				Type:        gql.String,
-			},
-			"_nlike": &gql.InputObjectFieldConfig{
-				Description: nlikeStringOperatorDescription,
-				Type:        gql.String,
-			},
-			"_ilike": &gql.InputObjectFieldConfig{
-				Description: ilikeStringOperatorDescription,
-				Type:        gql.String,
-			},
-			"_nilike": &gql.InputObjectFieldConfig{
-				Description: nilikeStringOperatorDescription,
-				Type:        gql.String,
-			},
-		},
+// JSONOperatorBlock filter block for JSON types.
+//
+// Besides the operators that apply to the whole JSON value, the block accepts
+// nested objects that target the properties within the JSON value, for example
+// `{color: {_eq: "red"}}`.
+func JSONOperatorBlock() *gql.Scalar {
+	return gql.NewScalar(gql.ScalarConfig{
+		Name:         "JSONOperatorBlock",
+		Description:  jsonOperatorBlockDescription,
+		Serialize:    coerceJSONFilter,
+		ParseValue:   coerceJSONFilter,
+		ParseLiteral: parseJSONFilterLiteral,
 	})
 }
 
-// NotNullJSONOperatorBlock filter block for string! types.
-func NotNullJSONOperatorBlock(jsonScalarType *gql.Scalar) *gql.InputObject {
-	return gql.NewInputObject(gql.InputObjectConfig{
-		Name:        "NotNullJSONOperatorBlock",
-		Description: notNullStringOperatorBlockDescription,
-		Fields: gql.InputObjectConfigFieldMap{
-			"_eq": &gql.InputObjectFieldConfig{
-				Description: eqOperatorDescription,
-				Type:        jsonScalarType,
-			},
-			"_ne": &gql.InputObjectFieldConfig{
-				Description: neOperatorDescription,
-				Type:        jsonScalarType,
-			},
-			"_in": &gql.InputObjectFieldConfig{
-				Description: inOperatorDescription,
-				Type:        gql.NewList(gql.NewNonNull(jsonScalarType)),
-			},
-			"_nin": &gql.InputObjectFieldConfig{
-				Description: ninOperatorDescription,
-				Type:        gql.NewList(gql.NewNonNull(jsonScalarType)),
-			},
-			"_like": &gql.InputObjectFieldConfig{
-				Description: likeStringOperatorDescription,
-				Type:        gql.String,
-			},
-			"_nlike": &gql.InputObjectFieldConfig{
-				Description: nlikeStringOperatorDescription,
-				Type:        gql.String,
-			},
-			"_ilike": &gql.InputObjectFieldConfig{
-				Description: ilikeStringOperatorDescription,
-				Type:        gql.String,
-			},
-			"_nilike": &gql.InputObjectFieldConfig{
-				Description: nilikeStringOperatorDescription,
-				Type:        gql.String,
-			},
-		},
+// NotNullJSONOperatorBlock filter block for JSON! types.
+func NotNullJSONOperatorBlock() *gql.Scalar {
+	return gql.NewScalar(gql.ScalarConfig{
+		Name:         "NotNullJSONOperatorBlock",
+		Description:  notNullJSONOperatorBlockDescription,
+		Serialize:    coerceJSONFilter,
+		ParseValue:   coerceJSONFilter,
+		ParseLiteral: parseJSONFilterLiteral,
 	})
 }
 
@@ -27,11 +27,12 @@ import (
 //		Delta: String
 //		Previous: [Commit]
 //	 Links: [Commit]
+//		Signature: CommitSignature
 //	}
 //
 // Any self referential type needs to be initialized
 // inside the init() func
-func CommitObject(commitLinkObject *gql.Object) *gql.Object {
+func CommitObject(commitLinkObject *gql.Object, commitSignatureObject *gql.Object) *gql.Object {
 	return gql.NewObject(gql.ObjectConfig{
 		Name:        request.CommitTypeName,
 		Description: commitDescription,
@@ -72,6 +73,10 @@ func CommitObject(commitLinkObject *gql.Object) *gql.Object {
 				Description: commitLinksDescription,
 				Type:        gql.NewList(commitLinkObject),
 			},
+			request.SignatureFieldName: &gql.Field{
+				Description: commitSignatureDescription,
+				Type:        commitSignatureObject,
+			},
 			request.CountFieldName: &gql.Field{
 				Description: CountFieldDescription,
 				Type:        gql.Int,
@@ -113,6 +118,28 @@ func CommitLinkObject() *gql.Object {
 	})
 }
 
+// CommitSignatureObject is the signature of a commit by the identity that created it.
+func CommitSignatureObject() *gql.Object {
+	return gql.NewObject(gql.ObjectConfig{
+		Name:        "CommitSignature",
+		Description: commitSignatureDescription,
+		Fields: gql.Fields{
+			request.SignatureTypeFieldName: &gql.Field{
+				Description: commitSignatureTypeFieldDescription,
+				Type:        gql.String,
+			},
+			request.SignatureIdentityFieldName: &gql.Field{
+				Description: commitSignatureIdentityFieldDescription,
+				Type:        gql.String,
+			},
+			request.SignatureValueFieldName: &gql.Field{
+				Description: commitSignatureValueFieldDescription,
+				Type:        gql.String,
+			},
+		},
+	})
+}
+
 func CommitsOrderArg(orderEnum *gql.Enum) *gql.InputObject {
 	return gql.NewInputObject(
 		gql.InputObjectConfig{
@@ -97,6 +97,20 @@ The Name of the field that this linked commit mutated.
 `
 	commitLinkCIDFieldDescription string = `
 The CID of this linked commit.
+`
+	commitSignatureDescription string = `
+The signature of this commit by the identity that created it. Only composite commits
+ created with an identity holding a private key are signed, the value will be null otherwise.
+`
+	commitSignatureTypeFieldDescription string = `
+The algorithm used to produce the signature, "ES256K" (secp256k1).
+`
+	commitSignatureIdentityFieldDescription string = `
+The DID of the identity that signed this commit, derived from its secp256k1 public key
+ using the did:key method in the same way as for access control.
+`
+	commitSignatureValueFieldDescription string = `
+The hex encoded signature of this commit.
 `
 	commitFieldsEnumDescription string = `
 These are the set of fields supported for grouping by in a commits query.
@@ -124,6 +138,19 @@ Returns the total sum of the specified field values within the specified child s
 Returns the average of the specified field values within the specified child sets. If
  multiple fields/sets are specified, the combined average of all items within each set
  (true average, not an average of averages) will be returned as a single value.
+`
+	MinFieldDescription string = `
+Returns the smallest of the specified field values within the specified child sets. If
+ multiple fields/sets are specified, the smallest value of all of them will be returned as
+ a single value.
+`
+	MaxFieldDescription string = `
+Returns the largest of the specified field values within the specified child sets. If
+ multiple fields/sets are specified, the largest value of all of them will be returned as
+ a single value.
+`
+	CountDistinctArgDescription string = `
+Counts only the distinct values. Nil values are not counted.
 `
 	booleanOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on Boolean
@@ -160,6 +187,16 @@ These are the set of filter operators available for use when filtering on String
 	notNullStringOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on String!
  values.
+`
+	jsonOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on JSON
+ values. Properties within the JSON value may be filtered on by nesting them,
+ e.g. {color: {_eq: "red"}}.
+`
+	notNullJSONOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on JSON!
+ values. Properties within the JSON value may be filtered on by nesting them,
+ e.g. {color: {_eq: "red"}}.
 `
 	idOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on ID
@@ -212,6 +249,11 @@ The case insensitive like operator - if the target value contains the given case
 The case insensitive not-like operator - if the target value does not contain the given case insensitive sub-string
  the check will pass. '%' characters may be used as wildcards, for example '_nlike: "%ritchie"' would match on
  the string 'Quentin Tarantino'.
+`
+	searchStringOperatorDescription string = `
+The full-text search operator - if the target value contains all the words of the given text the check will
+ pass. Words are compared case insensitively and by their stem, for example '_search: "running shoes"' would
+ match on the string 'Shoes for runs'. Searches are served by the full-text index of the field if one exists.
 `
 	AndOperatorDescription string = `
 The and operator - all checks within this clause must pass in order for this check to pass.
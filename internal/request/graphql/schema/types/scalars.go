@@ -13,6 +13,9 @@ package types
 import (
 	"encoding/hex"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
@@ -120,3 +123,206 @@ func JSONScalarType() *graphql.Scalar {
 		},
 	})
 }
+
+// jsonFilterOperators is the set of operators that can be used on the values of a JSON field.
+var jsonFilterOperators = map[string]struct{}{
+	"_eq":     {},
+	"_ne":     {},
+	"_gt":     {},
+	"_ge":     {},
+	"_lt":     {},
+	"_le":     {},
+	"_in":     {},
+	"_nin":    {},
+	"_like":   {},
+	"_nlike":  {},
+	"_ilike":  {},
+	"_nilike": {},
+}
+
+// coerceJSONFilter validates the given JSON filter condition.
+//
+// Keys starting with an underscore must be operators, all other keys are
+// properties within the JSON value and must hold a nested condition.
+// If the condition is not valid nil is returned.
+func coerceJSONFilter(value any) any {
+	condition, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+	for key, inner := range condition {
+		if strings.HasPrefix(key, "_") {
+			if _, isOperator := jsonFilterOperators[key]; !isOperator {
+				return nil
+			}
+			continue
+		}
+		if coerceJSONFilter(inner) == nil {
+			return nil
+		}
+	}
+	return condition
+}
+
+// parseJSONFilterLiteral converts the given ast value into a JSON filter condition.
+// If the value cannot be converted nil is returned.
+func parseJSONFilterLiteral(valueAST ast.Value) any {
+	objectAST, ok := valueAST.(*ast.ObjectValue)
+	if !ok {
+		return nil
+	}
+	condition := make(map[string]any, len(objectAST.Fields))
+	for _, field := range objectAST.Fields {
+		if !strings.HasPrefix(field.Name.Value, "_") {
+			condition[field.Name.Value] = parseJSONFilterLiteral(field.Value)
+			continue
+		}
+		value, ok := parseJSONLeafLiteral(field.Value)
+		if !ok {
+			return nil
+		}
+		condition[field.Name.Value] = value
+	}
+	return coerceJSONFilter(condition)
+}
+
+// parseJSONLeafLiteral converts the given ast value into the value of a JSON filter operator.
+func parseJSONLeafLiteral(valueAST ast.Value) (any, bool) {
+	switch valueAST := valueAST.(type) {
+	case *ast.NullValue:
+		return nil, true
+	case *ast.StringValue:
+		return valueAST.Value, true
+	case *ast.BooleanValue:
+		return valueAST.Value, true
+	case *ast.IntValue:
+		value, err := strconv.ParseInt(valueAST.Value, 10, 64)
+		return value, err == nil
+	case *ast.FloatValue:
+		value, err := strconv.ParseFloat(valueAST.Value, 64)
+		return value, err == nil
+	case *ast.ListValue:
+		values := make([]any, len(valueAST.Values))
+		for i, itemAST := range valueAST.Values {
+			item, ok := parseJSONLeafLiteral(itemAST)
+			if !ok {
+				return nil, false
+			}
+			values[i] = item
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+// coerceJSONOrdering converts the given value into a JSON field ordering.
+//
+// The ordering is either a direction, or an object holding the orderings of
+// properties within the JSON value. If the value cannot be converted nil is returned.
+func coerceJSONOrdering(value any) any {
+	switch value := value.(type) {
+	case string:
+		switch value {
+		case FieldOrderASC:
+			return 0
+		case FieldOrderDESC:
+			return 1
+		}
+		return nil
+
+	case map[string]any:
+		ordering := make(map[string]any, len(value))
+		for key, inner := range value {
+			ordering[key] = coerceJSONOrdering(inner)
+			if ordering[key] == nil {
+				return nil
+			}
+		}
+		return ordering
+
+	default:
+		return nil
+	}
+}
+
+// parseJSONOrderingLiteral converts the given ast value into a JSON field ordering.
+// If the value cannot be converted nil is returned.
+func parseJSONOrderingLiteral(valueAST ast.Value) any {
+	switch valueAST := valueAST.(type) {
+	case *ast.EnumValue:
+		return coerceJSONOrdering(valueAST.Value)
+
+	case *ast.ObjectValue:
+		ordering := make(map[string]any, len(valueAST.Fields))
+		for _, field := range valueAST.Fields {
+			ordering[field.Name.Value] = parseJSONOrderingLiteral(field.Value)
+			if ordering[field.Name.Value] == nil {
+				return nil
+			}
+		}
+		return ordering
+
+	default:
+		return nil
+	}
+}
+
+func JSONOrderingScalarType() *graphql.Scalar {
+	return graphql.NewScalar(graphql.ScalarConfig{
+		Name: "JSONOrdering",
+		Description: "The `JSONOrdering` scalar type represents the ordering of a JSON field, " +
+			"either `ASC`, `DESC`, or an object holding the orderings of the properties within the JSON value.",
+		// Serialize returns the value as is
+		Serialize: func(value any) any {
+			return value
+		},
+		// ParseValue converts the value to an ordering
+		ParseValue: coerceJSONOrdering,
+		// ParseLiteral converts the ast value to an ordering
+		ParseLiteral: parseJSONOrderingLiteral,
+	})
+}
+
+// coerceComparable converts the given value into a valid comparable value.
+//
+// Numbers and strings are returned as is, and date times are converted to strings.
+// If the value cannot be converted nil is returned.
+func coerceComparable(value any) any {
+	switch value := value.(type) {
+	case int, int32, int64, uint64, float32, float64, string:
+		return value
+
+	case time.Time:
+		return graphql.DateTime.Serialize(value)
+
+	case *time.Time:
+		return coerceComparable(*value)
+
+	default:
+		return nil
+	}
+}
+
+func ComparableScalarType() *graphql.Scalar {
+	return graphql.NewScalar(graphql.ScalarConfig{
+		Name: "Comparable",
+		Description: "The `Comparable` scalar type represents an Int, Float, DateTime or String value, " +
+			"such as the value returned by the `_min` and `_max` aggregates.",
+		// Serialize converts the value to a number or a string
+		Serialize: coerceComparable,
+		// ParseValue converts the value to a number or a string
+		ParseValue: coerceComparable,
+		// ParseLiteral converts the ast value to a number or a string
+		ParseLiteral: func(valueAST ast.Value) any {
+			switch valueAST := valueAST.(type) {
+			case *ast.StringValue, *ast.IntValue, *ast.FloatValue:
+				value, _ := parseJSONLeafLiteral(valueAST)
+				return value
+			default:
+				// return nil if the value cannot be parsed
+				return nil
+			}
+		},
+	})
+}
@@ -12,6 +12,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sourcenetwork/graphql-go/language/ast"
 	"github.com/stretchr/testify/assert"
@@ -169,3 +170,123 @@ func TestJSONScalarTypeParseLiteral(t *testing.T) {
 		assert.Equal(t, c.expect, result)
 	}
 }
+
+func TestJSONOperatorBlockParseLiteral(t *testing.T) {
+	cases := []struct {
+		input  ast.Value
+		expect any
+	}{
+		{
+			&ast.ObjectValue{Fields: []*ast.ObjectField{
+				{Name: &ast.Name{Value: "_eq"}, Value: &ast.StringValue{Value: "{}"}},
+			}},
+			map[string]any{"_eq": "{}"},
+		},
+		{
+			&ast.ObjectValue{Fields: []*ast.ObjectField{
+				{Name: &ast.Name{Value: "color"}, Value: &ast.ObjectValue{Fields: []*ast.ObjectField{
+					{Name: &ast.Name{Value: "_in"}, Value: &ast.ListValue{Values: []ast.Value{
+						&ast.StringValue{Value: "red"},
+						&ast.IntValue{Value: "1"},
+						&ast.FloatValue{Value: "1.5"},
+						&ast.BooleanValue{Value: true},
+						&ast.NullValue{},
+					}}},
+				}}},
+			}},
+			map[string]any{"color": map[string]any{"_in": []any{"red", int64(1), 1.5, true, nil}}},
+		},
+		{
+			&ast.ObjectValue{Fields: []*ast.ObjectField{
+				{Name: &ast.Name{Value: "_unknown"}, Value: &ast.StringValue{Value: "red"}},
+			}},
+			nil,
+		},
+		{
+			&ast.ObjectValue{Fields: []*ast.ObjectField{
+				{Name: &ast.Name{Value: "color"}, Value: &ast.StringValue{Value: "red"}},
+			}},
+			nil,
+		},
+		{
+			&ast.ObjectValue{Fields: []*ast.ObjectField{
+				{Name: &ast.Name{Value: "_eq"}, Value: &ast.ObjectValue{}},
+			}},
+			nil,
+		},
+		{&ast.StringValue{Value: "{}"}, nil},
+	}
+	for _, c := range cases {
+		result := JSONOperatorBlock().ParseLiteral(c.input)
+		assert.Equal(t, c.expect, result)
+	}
+}
+
+func TestJSONOrderingScalarTypeParseLiteral(t *testing.T) {
+	cases := []struct {
+		input  ast.Value
+		expect any
+	}{
+		{&ast.EnumValue{Value: "ASC"}, 0},
+		{&ast.EnumValue{Value: "DESC"}, 1},
+		{&ast.EnumValue{Value: "UP"}, nil},
+		{
+			&ast.ObjectValue{Fields: []*ast.ObjectField{
+				{Name: &ast.Name{Value: "meta"}, Value: &ast.ObjectValue{Fields: []*ast.ObjectField{
+					{Name: &ast.Name{Value: "priority"}, Value: &ast.EnumValue{Value: "DESC"}},
+				}}},
+			}},
+			map[string]any{"meta": map[string]any{"priority": 1}},
+		},
+		{
+			&ast.ObjectValue{Fields: []*ast.ObjectField{
+				{Name: &ast.Name{Value: "meta"}, Value: &ast.StringValue{Value: "DESC"}},
+			}},
+			nil,
+		},
+		{&ast.StringValue{Value: "ASC"}, nil},
+	}
+	for _, c := range cases {
+		result := JSONOrderingScalarType().ParseLiteral(c.input)
+		assert.Equal(t, c.expect, result)
+	}
+}
+
+func TestComparableScalarTypeSerialize(t *testing.T) {
+	timeInput := time.Date(2017, 7, 23, 8, 46, 56, 0, time.UTC)
+
+	cases := []struct {
+		input  any
+		expect any
+	}{
+		{int64(21), int64(21)},
+		{1.82, 1.82},
+		{"John", "John"},
+		{timeInput, "2017-07-23T08:46:56Z"},
+		{&timeInput, "2017-07-23T08:46:56Z"},
+		{nil, nil},
+		{false, nil},
+	}
+	for _, c := range cases {
+		result := ComparableScalarType().Serialize(c.input)
+		assert.Equal(t, c.expect, result)
+	}
+}
+
+func TestComparableScalarTypeParseLiteral(t *testing.T) {
+	cases := []struct {
+		input  ast.Value
+		expect any
+	}{
+		{&ast.StringValue{Value: "John"}, "John"},
+		{&ast.IntValue{Value: "21"}, int64(21)},
+		{&ast.FloatValue{Value: "1.82"}, 1.82},
+		{&ast.BooleanValue{Value: true}, nil},
+		{&ast.NullValue{}, nil},
+		{&ast.ObjectValue{}, nil},
+	}
+	for _, c := range cases {
+		result := ComparableScalarType().ParseLiteral(c.input)
+		assert.Equal(t, c.expect, result)
+	}
+}
@@ -54,6 +54,10 @@ const (
 	MaterializedDirectiveLabel  = "materialized"
 	MaterializedDirectivePropIf = "if"
 
+	RetentionDirectiveLabel     = "retention"
+	RetentionDirectivePropDepth = "depth"
+	RetentionDirectivePropAge   = "age"
+
 	FieldOrderASC  = "ASC"
 	FieldOrderDESC = "DESC"
 )
@@ -237,6 +241,26 @@ func MaterializedDirective() *gql.Directive {
 	})
 }
 
+func RetentionDirective() *gql.Directive {
+	return gql.NewDirective(gql.DirectiveConfig{
+		Name: RetentionDirectiveLabel,
+		Description: `@retention is a directive that sets the retention policy used when compacting the history
+ of the documents in a collection. The depth is the number of versions to retain and the age is a duration,
+ such as "720h", for which the versions are retained.`,
+		Args: gql.FieldConfigArgument{
+			RetentionDirectivePropDepth: &gql.ArgumentConfig{
+				Type: gql.Int,
+			},
+			RetentionDirectivePropAge: &gql.ArgumentConfig{
+				Type: gql.String,
+			},
+		},
+		Locations: []string{
+			gql.DirectiveLocationObject,
+		},
+	})
+}
+
 func CRDTEnum() *gql.Enum {
 	return gql.NewEnum(gql.EnumConfig{
 		Name:        "CRDTType",
@@ -262,6 +286,28 @@ func CRDTEnum() *gql.Enum {
 	will cause the value to roll over to the int64 min value. Incremeting a float and
 	causing it to overflow the float64 max value will act like a no-op.`,
 			},
+			client.OR_SET.String(): &gql.EnumValueConfig{
+				Value: client.OR_SET,
+				Description: `Observed-Remove Set.
+	
+	Only valid for array fields. Values added and removed concurrently are merged,
+	a value concurrently added and removed is kept. Duplicate values are stored once.`,
+			},
+			client.LIST.String(): &gql.EnumValueConfig{
+				Value: client.LIST,
+				Description: `Ordered list.
+	
+	Only valid for array fields. Values inserted and removed concurrently are merged,
+	values inserted concurrently at the same position are ordered deterministically.`,
+			},
+			client.BOUNDED_COUNTER.String(): &gql.EnumValueConfig{
+				Value: client.BOUNDED_COUNTER,
+				Description: `Bounded Counter.
+
+	Only valid for Int fields. The value can never go below zero across peers: each node
+	may only decrement by the amount it has itself incremented and not yet decremented,
+	its remaining allowance is returned by the _allowance field.`,
+			},
 		},
 	})
 }
@@ -16,10 +16,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/ipfs/go-cid"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/sourcenetwork/corelog"
 
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/event"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
 	pb "github.com/sourcenetwork/defradb/net/pb"
 )
 
@@ -63,3 +66,119 @@ func (s *server) pushLog(evt event.Update, pid peer.ID) error {
 	}
 	return nil
 }
+
+// pushLogs pushes the given updates to another node, stopping at the first failed push.
+func (s *server) pushLogs(updates []event.Update, pid peer.ID) error {
+	for _, update := range updates {
+		if err := s.pushLog(update, pid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pullHeads requests the current document heads of the given collection from another
+// node over libp2p grpc connection and syncs their DAGs into the local blockstore.
+//
+// The returned merges need to be executed for the documents to catch up with the remote
+// peer. If skipKnown is true, heads whose block is already in the local blockstore are skipped.
+func (s *server) pullHeads(
+	ctx context.Context,
+	pid peer.ID,
+	schemaRoot string,
+	skipKnown bool,
+) ([]event.Merge, error) {
+	client, err := s.dial(pid) // grpc dial over P2P stream
+	if err != nil {
+		return nil, NewErrPullHeads(err)
+	}
+
+	var heads []*pb.GetHeadLogReply_HeadLog
+	var cursor []byte
+	for {
+		reply, err := s.getHeadLogPage(ctx, client, schemaRoot, cursor)
+		if err != nil {
+			return nil, NewErrPullHeads(
+				err,
+				errors.NewKV("SchemaRoot", schemaRoot),
+				errors.NewKV("PeerID", pid),
+			)
+		}
+		heads = append(heads, reply.Heads...)
+		if len(reply.Cursor) == 0 {
+			break
+		}
+		cursor = reply.Cursor
+	}
+
+	merges := []event.Merge{}
+	for _, head := range heads {
+		headCID, err := cid.Cast(head.Cid)
+		if err != nil {
+			return nil, err
+		}
+
+		if skipKnown {
+			isKnown, err := s.peer.blockstore.Has(ctx, headCID)
+			if err != nil {
+				return nil, NewErrCheckingForExistingBlock(err, headCID.String())
+			}
+			if isKnown {
+				continue
+			}
+		}
+
+		if head.Log == nil {
+			return nil, NewErrMissingHeadLog(headCID.String())
+		}
+		block, err := coreblock.GetFromBytes(head.Log.Block)
+		if err != nil {
+			return nil, err
+		}
+
+		err = syncDAG(ctx, s.peer.bserv, s.peer.blockstore, block)
+		if err != nil {
+			return nil, err
+		}
+
+		// Subscribe to the DocID topic on the pubsub network unless we already
+		// suscribe to the collection.
+		if !s.hasPubSubTopic(schemaRoot) {
+			err = s.addPubSubTopic(string(head.DocID), true)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		merges = append(merges, event.Merge{
+			DocID:      string(head.DocID),
+			ByPeer:     pid,
+			FromPeer:   pid,
+			Cid:        headCID,
+			SchemaRoot: schemaRoot,
+		})
+	}
+
+	log.InfoContext(ctx, "Pulled document heads",
+		corelog.Any("PeerID", pid),
+		corelog.String("SchemaRoot", schemaRoot),
+		corelog.Int("Heads", len(merges)))
+
+	return merges, nil
+}
+
+// getHeadLogPage requests a single page of the document heads of the given collection.
+func (s *server) getHeadLogPage(
+	ctx context.Context,
+	client pb.ServiceClient,
+	schemaRoot string,
+	cursor []byte,
+) (*pb.GetHeadLogReply, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, PullTimeout)
+	defer cancel()
+
+	return client.GetHeadLog(reqCtx, &pb.GetHeadLogRequest{
+		SchemaRoot: []byte(schemaRoot),
+		Cursor:     cursor,
+	})
+}
@@ -57,6 +57,22 @@ func (s *server) dial(peerID libpeer.ID) (pb.ServiceClient, error) {
 	return pb.NewServiceClient(conn), nil
 }
 
+// closeFailedConn closes the gRPC connection to the given peer if it failed to connect.
+//
+// Requests made on a connection that failed to connect fail straight away until its
+// reconnection backoff expires, so it must be closed once the peer becomes reachable
+// again for the next dial to open a new connection.
+func (s *server) closeFailedConn(peerID libpeer.ID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conn, ok := s.conns[peerID]
+	if !ok || conn.GetState() != connectivity.TransientFailure {
+		return nil
+	}
+	delete(s.conns, peerID)
+	return conn.Close()
+}
+
 // getLibp2pDialer returns a WithContextDialer option for libp2p dialing.
 func (s *server) getLibp2pDialer() grpc.DialOption {
 	return grpc.WithContextDialer(func(ctx context.Context, peerIDStr string) (gonet.Conn, error) {
@@ -26,7 +26,7 @@ func TestDial_WithConnectedPeer_NoError(t *testing.T) {
 	ctx := context.Background()
 	n1, err := NewPeer(
 		ctx,
-		db1.Blockstore(),
+		db1,
 		db1.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -34,7 +34,7 @@ func TestDial_WithConnectedPeer_NoError(t *testing.T) {
 	defer n1.Close()
 	n2, err := NewPeer(
 		ctx,
-		db2.Blockstore(),
+		db2,
 		db2.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -56,7 +56,7 @@ func TestDial_WithConnectedPeerAndSecondConnection_NoError(t *testing.T) {
 	ctx := context.Background()
 	n1, err := NewPeer(
 		ctx,
-		db1.Blockstore(),
+		db1,
 		db1.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -64,7 +64,7 @@ func TestDial_WithConnectedPeerAndSecondConnection_NoError(t *testing.T) {
 	defer n1.Close()
 	n2, err := NewPeer(
 		ctx,
-		db2.Blockstore(),
+		db2,
 		db2.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -89,7 +89,7 @@ func TestDial_WithConnectedPeerAndSecondConnectionWithConnectionShutdown_Closing
 	ctx := context.Background()
 	n1, err := NewPeer(
 		ctx,
-		db1.Blockstore(),
+		db1,
 		db1.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -97,7 +97,7 @@ func TestDial_WithConnectedPeerAndSecondConnectionWithConnectionShutdown_Closing
 	defer n1.Close()
 	n2, err := NewPeer(
 		ctx,
-		db2.Blockstore(),
+		db2,
 		db2.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -22,6 +22,9 @@ const (
 	errPublishingToDocIDTopic   = "can't publish log %s for docID %s"
 	errPublishingToSchemaTopic  = "can't publish log %s for schema %s"
 	errCheckingForExistingBlock = "failed to check for existing block"
+	errPullHeads                = "failed to pull document heads"
+	errMissingHeadLog           = "missing block of document head"
+	errMissingSchemaRoot        = "missing schema root"
 )
 
 var (
@@ -31,6 +34,9 @@ var (
 	ErrNilDB                    = errors.New("database object can't be nil")
 	ErrNilUpdateChannel         = errors.New("tried to subscribe to update channel, but update channel is nil")
 	ErrCheckingForExistingBlock = errors.New(errCheckingForExistingBlock)
+	ErrPullHeads                = errors.New(errPullHeads)
+	ErrMissingHeadLog           = errors.New(errMissingHeadLog)
+	ErrMissingSchemaRoot        = errors.New(errMissingSchemaRoot)
 )
 
 func NewErrPushLog(inner error, kv ...errors.KV) error {
@@ -52,3 +58,11 @@ func NewErrPublishingToSchemaTopic(inner error, cid, docID string, kv ...errors.
 func NewErrCheckingForExistingBlock(inner error, cid string) error {
 	return errors.Wrap(errCheckingForExistingBlock, inner, errors.NewKV("cid", cid))
 }
+
+func NewErrPullHeads(inner error, kv ...errors.KV) error {
+	return errors.Wrap(errPullHeads, inner, kv...)
+}
+
+func NewErrMissingHeadLog(cid string) error {
+	return errors.New(errMissingHeadLog, errors.NewKV("cid", cid))
+}
@@ -82,6 +82,15 @@ type GetDocGraphRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// docID is the ID of the document whose graph is requested.
+	DocID []byte `protobuf:"bytes,1,opt,name=docID,proto3" json:"docID,omitempty"`
+	// schemaRoot is the SchemaRoot of the collection that the document resides in.
+	SchemaRoot []byte `protobuf:"bytes,2,opt,name=schemaRoot,proto3" json:"schemaRoot,omitempty"`
+	// offset is the number of blocks of the graph to skip.
+	//
+	// It is used to request the pages following the first one.
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
 func (x *GetDocGraphRequest) Reset() {
@@ -116,10 +125,40 @@ func (*GetDocGraphRequest) Descriptor() ([]byte, []int) {
 	return file_net_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetDocGraphRequest) GetDocID() []byte {
+	if x != nil {
+		return x.DocID
+	}
+	return nil
+}
+
+func (x *GetDocGraphRequest) GetSchemaRoot() []byte {
+	if x != nil {
+		return x.SchemaRoot
+	}
+	return nil
+}
+
+func (x *GetDocGraphRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type GetDocGraphReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// heads are the CIDs of the current composite heads of the document.
+	Heads [][]byte `protobuf:"bytes,1,rep,name=heads,proto3" json:"heads,omitempty"`
+	// logs hold all the blocks that form the graph of the document.
+	Logs []*Document_Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+	// nextOffset is the offset of the next page of the graph.
+	//
+	// It is zero if all the blocks of the graph have been returned.
+	NextOffset uint64 `protobuf:"varint,3,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
 }
 
 func (x *GetDocGraphReply) Reset() {
@@ -154,10 +193,33 @@ func (*GetDocGraphReply) Descriptor() ([]byte, []int) {
 	return file_net_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *GetDocGraphReply) GetHeads() [][]byte {
+	if x != nil {
+		return x.Heads
+	}
+	return nil
+}
+
+func (x *GetDocGraphReply) GetLogs() []*Document_Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *GetDocGraphReply) GetNextOffset() uint64 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
 type PushDocGraphRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Body *PushDocGraphRequest_Body `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
 }
 
 func (x *PushDocGraphRequest) Reset() {
@@ -192,6 +254,13 @@ func (*PushDocGraphRequest) Descriptor() ([]byte, []int) {
 	return file_net_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *PushDocGraphRequest) GetBody() *PushDocGraphRequest_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
 type PushDocGraphReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -234,6 +303,9 @@ type GetLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// cids are the CIDs of the blocks that are requested.
+	Cids [][]byte `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
 }
 
 func (x *GetLogRequest) Reset() {
@@ -268,10 +340,20 @@ func (*GetLogRequest) Descriptor() ([]byte, []int) {
 	return file_net_proto_rawDescGZIP(), []int{5}
 }
 
+func (x *GetLogRequest) GetCids() [][]byte {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
 type GetLogReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// logs hold the requested blocks in the same order as the requested CIDs.
+	Logs []*Document_Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 }
 
 func (x *GetLogReply) Reset() {
@@ -306,6 +388,13 @@ func (*GetLogReply) Descriptor() ([]byte, []int) {
 	return file_net_proto_rawDescGZIP(), []int{6}
 }
 
+func (x *GetLogReply) GetLogs() []*Document_Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
 type PushLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -357,6 +446,17 @@ type GetHeadLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// schemaRoot is the SchemaRoot of the collection that the documents reside in.
+	SchemaRoot []byte `protobuf:"bytes,1,opt,name=schemaRoot,proto3" json:"schemaRoot,omitempty"`
+	// docID is the ID of the document whose heads are requested.
+	//
+	// If empty, the heads of all the documents within the collection are returned.
+	DocID []byte `protobuf:"bytes,2,opt,name=docID,proto3" json:"docID,omitempty"`
+	// cursor is the docID of the last document returned by the previous page.
+	//
+	// If empty, the first page is returned.
+	Cursor []byte `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *GetHeadLogRequest) Reset() {
@@ -391,6 +491,27 @@ func (*GetHeadLogRequest) Descriptor() ([]byte, []int) {
 	return file_net_proto_rawDescGZIP(), []int{8}
 }
 
+func (x *GetHeadLogRequest) GetSchemaRoot() []byte {
+	if x != nil {
+		return x.SchemaRoot
+	}
+	return nil
+}
+
+func (x *GetHeadLogRequest) GetDocID() []byte {
+	if x != nil {
+		return x.DocID
+	}
+	return nil
+}
+
+func (x *GetHeadLogRequest) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
 type PushLogReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -433,6 +554,13 @@ type GetHeadLogReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// heads are the current composite heads of the requested documents.
+	Heads []*GetHeadLogReply_HeadLog `protobuf:"bytes,1,rep,name=heads,proto3" json:"heads,omitempty"`
+	// cursor is the docID of the last document of this page.
+	//
+	// It is empty if there are no more pages.
+	Cursor []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *GetHeadLogReply) Reset() {
@@ -467,6 +595,20 @@ func (*GetHeadLogReply) Descriptor() ([]byte, []int) {
 	return file_net_proto_rawDescGZIP(), []int{10}
 }
 
+func (x *GetHeadLogReply) GetHeads() []*GetHeadLogReply_HeadLog {
+	if x != nil {
+		return x.Heads
+	}
+	return nil
+}
+
+func (x *GetHeadLogReply) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
 // Record is a thread record containing link data.
 type Document_Log struct {
 	state         protoimpl.MessageState
@@ -516,6 +658,90 @@ func (x *Document_Log) GetBlock() []byte {
 	return nil
 }
 
+type PushDocGraphRequest_Body struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// docID is the ID of the document that is affected by the graph.
+	DocID []byte `protobuf:"bytes,1,opt,name=docID,proto3" json:"docID,omitempty"`
+	// heads are the CIDs of the composite heads of the document.
+	Heads [][]byte `protobuf:"bytes,2,rep,name=heads,proto3" json:"heads,omitempty"`
+	// schemaRoot is the SchemaRoot of the collection that the document resides in.
+	SchemaRoot []byte `protobuf:"bytes,3,opt,name=schemaRoot,proto3" json:"schemaRoot,omitempty"`
+	// creator is the PeerID of the peer that pushed the graph.
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// logs hold all the blocks that form the graph of the document.
+	Logs []*Document_Log `protobuf:"bytes,5,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *PushDocGraphRequest_Body) Reset() {
+	*x = PushDocGraphRequest_Body{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_net_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushDocGraphRequest_Body) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDocGraphRequest_Body) ProtoMessage() {}
+
+func (x *PushDocGraphRequest_Body) ProtoReflect() protoreflect.Message {
+	mi := &file_net_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDocGraphRequest_Body.ProtoReflect.Descriptor instead.
+func (*PushDocGraphRequest_Body) Descriptor() ([]byte, []int) {
+	return file_net_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *PushDocGraphRequest_Body) GetDocID() []byte {
+	if x != nil {
+		return x.DocID
+	}
+	return nil
+}
+
+func (x *PushDocGraphRequest_Body) GetHeads() [][]byte {
+	if x != nil {
+		return x.Heads
+	}
+	return nil
+}
+
+func (x *PushDocGraphRequest_Body) GetSchemaRoot() []byte {
+	if x != nil {
+		return x.SchemaRoot
+	}
+	return nil
+}
+
+func (x *PushDocGraphRequest_Body) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *PushDocGraphRequest_Body) GetLogs() []*Document_Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
 type PushLogRequest_Body struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -536,7 +762,7 @@ type PushLogRequest_Body struct {
 func (x *PushLogRequest_Body) Reset() {
 	*x = PushLogRequest_Body{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_net_proto_msgTypes[12]
+		mi := &file_net_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -549,7 +775,7 @@ func (x *PushLogRequest_Body) String() string {
 func (*PushLogRequest_Body) ProtoMessage() {}
 
 func (x *PushLogRequest_Body) ProtoReflect() protoreflect.Message {
-	mi := &file_net_proto_msgTypes[12]
+	mi := &file_net_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,6 +826,72 @@ func (x *PushLogRequest_Body) GetLog() *Document_Log {
 	return nil
 }
 
+type GetHeadLogReply_HeadLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// docID is the ID of the document the head belongs to.
+	DocID []byte `protobuf:"bytes,1,opt,name=docID,proto3" json:"docID,omitempty"`
+	// cid is the CID of the composite head.
+	Cid []byte `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	// log holds the block of the composite head.
+	Log *Document_Log `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (x *GetHeadLogReply_HeadLog) Reset() {
+	*x = GetHeadLogReply_HeadLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_net_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeadLogReply_HeadLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeadLogReply_HeadLog) ProtoMessage() {}
+
+func (x *GetHeadLogReply_HeadLog) ProtoReflect() protoreflect.Message {
+	mi := &file_net_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeadLogReply_HeadLog.ProtoReflect.Descriptor instead.
+func (*GetHeadLogReply_HeadLog) Descriptor() ([]byte, []int) {
+	return file_net_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *GetHeadLogReply_HeadLog) GetDocID() []byte {
+	if x != nil {
+		return x.DocID
+	}
+	return nil
+}
+
+func (x *GetHeadLogReply_HeadLog) GetCid() []byte {
+	if x != nil {
+		return x.Cid
+	}
+	return nil
+}
+
+func (x *GetHeadLogReply_HeadLog) GetLog() *Document_Log {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
 var File_net_proto protoreflect.FileDescriptor
 
 var file_net_proto_rawDesc = []byte{
@@ -609,54 +901,97 @@ var file_net_proto_rawDesc = []byte{
 	0x64, 0x6f, 0x63, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x1a, 0x1b, 0x0a, 0x03, 0x4c, 0x6f, 0x67,
 	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63,
-	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x15, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x44,
-	0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x0f, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0d, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xd4, 0x01, 0x0a,
-	0x0e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2f, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x1a, 0x90, 0x01, 0x0a, 0x04, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x12,
-	0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69,
-	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6f, 0x74, 0x18,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x6f, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x64, 0x6f, 0x63,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x72, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x65, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x68,
+	0x65, 0x61, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xe4,
+	0x01, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x96, 0x01, 0x0a,
+	0x04, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x68,
+	0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x68, 0x65, 0x61, 0x64,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6f, 0x74, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6f,
 	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x03, 0x6c,
-	0x6f, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70,
-	0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x03,
-	0x6c, 0x6f, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68,
-	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48,
-	0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xd1, 0x02, 0x0a, 0x07,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x6f,
-	0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44,
-	0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48,
-	0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1b,
-	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63, 0x47,
-	0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65,
-	0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4c,
-	0x6f, 0x67, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c,
-	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x39, 0x0a, 0x07, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x12, 0x16, 0x2e, 0x6e, 0x65,
-	0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73,
-	0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42,
-	0x0a, 0x5a, 0x08, 0x2f, 0x3b, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x6c,
+	0x6f, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x52,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22,
+	0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28,
+	0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c,
+	0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x73,
+	0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x90, 0x01, 0x0a,
+	0x04, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22,
+	0x61, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0xbb, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x68, 0x65, 0x61, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x05, 0x68, 0x65, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x59, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x64, 0x6f, 0x63, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67,
+	0x32, 0xd1, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1a, 0x2e, 0x6e, 0x65,
+	0x74, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73,
+	0x68, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f,
+	0x63, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x06, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x07, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67,
+	0x12, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x19,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2f, 0x3b, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -671,40 +1006,48 @@ func file_net_proto_rawDescGZIP() []byte {
 	return file_net_proto_rawDescData
 }
 
-var file_net_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_net_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_net_proto_goTypes = []interface{}{
-	(*Document)(nil),            // 0: net.pb.Document
-	(*GetDocGraphRequest)(nil),  // 1: net.pb.GetDocGraphRequest
-	(*GetDocGraphReply)(nil),    // 2: net.pb.GetDocGraphReply
-	(*PushDocGraphRequest)(nil), // 3: net.pb.PushDocGraphRequest
-	(*PushDocGraphReply)(nil),   // 4: net.pb.PushDocGraphReply
-	(*GetLogRequest)(nil),       // 5: net.pb.GetLogRequest
-	(*GetLogReply)(nil),         // 6: net.pb.GetLogReply
-	(*PushLogRequest)(nil),      // 7: net.pb.PushLogRequest
-	(*GetHeadLogRequest)(nil),   // 8: net.pb.GetHeadLogRequest
-	(*PushLogReply)(nil),        // 9: net.pb.PushLogReply
-	(*GetHeadLogReply)(nil),     // 10: net.pb.GetHeadLogReply
-	(*Document_Log)(nil),        // 11: net.pb.Document.Log
-	(*PushLogRequest_Body)(nil), // 12: net.pb.PushLogRequest.Body
+	(*Document)(nil),                 // 0: net.pb.Document
+	(*GetDocGraphRequest)(nil),       // 1: net.pb.GetDocGraphRequest
+	(*GetDocGraphReply)(nil),         // 2: net.pb.GetDocGraphReply
+	(*PushDocGraphRequest)(nil),      // 3: net.pb.PushDocGraphRequest
+	(*PushDocGraphReply)(nil),        // 4: net.pb.PushDocGraphReply
+	(*GetLogRequest)(nil),            // 5: net.pb.GetLogRequest
+	(*GetLogReply)(nil),              // 6: net.pb.GetLogReply
+	(*PushLogRequest)(nil),           // 7: net.pb.PushLogRequest
+	(*GetHeadLogRequest)(nil),        // 8: net.pb.GetHeadLogRequest
+	(*PushLogReply)(nil),             // 9: net.pb.PushLogReply
+	(*GetHeadLogReply)(nil),          // 10: net.pb.GetHeadLogReply
+	(*Document_Log)(nil),             // 11: net.pb.Document.Log
+	(*PushDocGraphRequest_Body)(nil), // 12: net.pb.PushDocGraphRequest.Body
+	(*PushLogRequest_Body)(nil),      // 13: net.pb.PushLogRequest.Body
+	(*GetHeadLogReply_HeadLog)(nil),  // 14: net.pb.GetHeadLogReply.HeadLog
 }
 var file_net_proto_depIdxs = []int32{
-	12, // 0: net.pb.PushLogRequest.body:type_name -> net.pb.PushLogRequest.Body
-	11, // 1: net.pb.PushLogRequest.Body.log:type_name -> net.pb.Document.Log
-	1,  // 2: net.pb.Service.GetDocGraph:input_type -> net.pb.GetDocGraphRequest
-	3,  // 3: net.pb.Service.PushDocGraph:input_type -> net.pb.PushDocGraphRequest
-	5,  // 4: net.pb.Service.GetLog:input_type -> net.pb.GetLogRequest
-	7,  // 5: net.pb.Service.PushLog:input_type -> net.pb.PushLogRequest
-	8,  // 6: net.pb.Service.GetHeadLog:input_type -> net.pb.GetHeadLogRequest
-	2,  // 7: net.pb.Service.GetDocGraph:output_type -> net.pb.GetDocGraphReply
-	4,  // 8: net.pb.Service.PushDocGraph:output_type -> net.pb.PushDocGraphReply
-	6,  // 9: net.pb.Service.GetLog:output_type -> net.pb.GetLogReply
-	9,  // 10: net.pb.Service.PushLog:output_type -> net.pb.PushLogReply
-	10, // 11: net.pb.Service.GetHeadLog:output_type -> net.pb.GetHeadLogReply
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	11, // 0: net.pb.GetDocGraphReply.logs:type_name -> net.pb.Document.Log
+	12, // 1: net.pb.PushDocGraphRequest.body:type_name -> net.pb.PushDocGraphRequest.Body
+	11, // 2: net.pb.GetLogReply.logs:type_name -> net.pb.Document.Log
+	13, // 3: net.pb.PushLogRequest.body:type_name -> net.pb.PushLogRequest.Body
+	14, // 4: net.pb.GetHeadLogReply.heads:type_name -> net.pb.GetHeadLogReply.HeadLog
+	11, // 5: net.pb.PushDocGraphRequest.Body.logs:type_name -> net.pb.Document.Log
+	11, // 6: net.pb.PushLogRequest.Body.log:type_name -> net.pb.Document.Log
+	11, // 7: net.pb.GetHeadLogReply.HeadLog.log:type_name -> net.pb.Document.Log
+	1,  // 8: net.pb.Service.GetDocGraph:input_type -> net.pb.GetDocGraphRequest
+	3,  // 9: net.pb.Service.PushDocGraph:input_type -> net.pb.PushDocGraphRequest
+	5,  // 10: net.pb.Service.GetLog:input_type -> net.pb.GetLogRequest
+	7,  // 11: net.pb.Service.PushLog:input_type -> net.pb.PushLogRequest
+	8,  // 12: net.pb.Service.GetHeadLog:input_type -> net.pb.GetHeadLogRequest
+	2,  // 13: net.pb.Service.GetDocGraph:output_type -> net.pb.GetDocGraphReply
+	4,  // 14: net.pb.Service.PushDocGraph:output_type -> net.pb.PushDocGraphReply
+	6,  // 15: net.pb.Service.GetLog:output_type -> net.pb.GetLogReply
+	9,  // 16: net.pb.Service.PushLog:output_type -> net.pb.PushLogReply
+	10, // 17: net.pb.Service.GetHeadLog:output_type -> net.pb.GetHeadLogReply
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_net_proto_init() }
@@ -858,6 +1201,18 @@ func file_net_proto_init() {
 			}
 		}
 		file_net_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushDocGraphRequest_Body); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_net_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushLogRequest_Body); i {
 			case 0:
 				return &v.state
@@ -869,6 +1224,18 @@ func file_net_proto_init() {
 				return nil
 			}
 		}
+		file_net_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeadLogReply_HeadLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -876,7 +1243,7 @@ func file_net_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_net_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
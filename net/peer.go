@@ -15,6 +15,7 @@ package net
 import (
 	"context"
 	"io"
+	"slices"
 	"time"
 
 	"github.com/ipfs/boxo/bitswap"
@@ -26,8 +27,10 @@ import (
 	"github.com/ipfs/go-cid"
 	gostream "github.com/libp2p/go-libp2p-gostream"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	libp2pEvent "github.com/libp2p/go-libp2p/core/event"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
 	"github.com/libp2p/go-libp2p/core/routing"
 
 	"github.com/multiformats/go-multiaddr"
@@ -45,11 +48,15 @@ import (
 // Peer is a DefraDB Peer node which exposes all the LibP2P host/peer functionality
 // to the underlying DefraDB instance.
 type Peer struct {
+	db         client.DB
 	blockstore datastore.Blockstore
 
 	bus       *event.Bus
 	updateSub *event.Subscription
 
+	// identifiedSub receives the libp2p events of newly identified peer connections.
+	identifiedSub libp2pEvent.Subscription
+
 	ctx    context.Context
 	cancel context.CancelFunc
 
@@ -70,7 +77,7 @@ type Peer struct {
 // NewPeer creates a new instance of the DefraDB server as a peer-to-peer node.
 func NewPeer(
 	ctx context.Context,
-	blockstore datastore.Blockstore,
+	db client.DB,
 	bus *event.Bus,
 	opts ...NodeOpt,
 ) (p *Peer, err error) {
@@ -83,9 +90,10 @@ func NewPeer(
 		}
 	}()
 
-	if blockstore == nil {
+	if db == nil {
 		return nil, ErrNilDB
 	}
+	blockstore := db.Blockstore()
 
 	options := DefaultOptions()
 	for _, opt := range opts {
@@ -119,6 +127,7 @@ func NewPeer(
 	p = &Peer{
 		host:       h,
 		dht:        ddht,
+		db:         db,
 		blockstore: blockstore,
 		ctx:        ctx,
 		cancel:     cancel,
@@ -128,6 +137,12 @@ func NewPeer(
 		exch:       bswap,
 	}
 
+	// P2P sync and replicator retry requests only use the gRPC service, so they
+	// are handled whether or not pubsub is enabled.
+	events := []event.Name{
+		event.P2PSyncName,
+		event.ReplicatorRetryName,
+	}
 	if options.EnablePubSub {
 		p.ps, err = pubsub.NewGossipSub(
 			ctx,
@@ -138,19 +153,31 @@ func NewPeer(
 		if err != nil {
 			return nil, err
 		}
-		p.updateSub, err = p.bus.Subscribe(event.UpdateName, event.P2PTopicName, event.ReplicatorName)
-		if err != nil {
-			return nil, err
-		}
+		events = append(
+			events,
+			event.UpdateName,
+			event.P2PTopicName,
+			event.ReplicatorName,
+		)
 		log.Info("Starting internal broadcaster for pubsub network")
-		go p.handleMessageLoop()
 	}
+	p.updateSub, err = p.bus.Subscribe(events...)
+	if err != nil {
+		return nil, err
+	}
+	go p.handleMessageLoop()
 
 	p.server, err = newServer(p, options.GRPCDialOptions...)
 	if err != nil {
 		return nil, err
 	}
 
+	p.identifiedSub, err = h.EventBus().Subscribe(new(libp2pEvent.EvtPeerIdentificationCompleted))
+	if err != nil {
+		return nil, err
+	}
+	go p.handlePeerIdentifiedLoop()
+
 	p2plistener, err := gostream.Listen(h, corenet.Protocol)
 	if err != nil {
 		return nil, err
@@ -204,6 +231,12 @@ func (p *Peer) Close() {
 		p.bus.Unsubscribe(p.updateSub)
 	}
 
+	if p.identifiedSub != nil {
+		if err := p.identifiedSub.Close(); err != nil {
+			log.ErrorE("Error closing peer identification subscription", err)
+		}
+	}
+
 	if err := p.bserv.Close(); err != nil {
 		log.ErrorE("Error closing block service", err)
 	}
@@ -254,6 +287,18 @@ func (p *Peer) handleMessageLoop() {
 
 		case event.Replicator:
 			p.server.updateReplicators(evt)
+
+		case event.P2PSync:
+			go func() {
+				merges, err := p.syncCollections(p.ctx, evt.Info, evt.SchemaRoots)
+				evt.Result <- event.P2PSyncResult{Merges: merges, Err: err}
+			}()
+
+		case event.ReplicatorRetry:
+			go func() {
+				evt.Result <- p.server.pushLogs(evt.Updates, evt.PeerID)
+			}()
+
 		default:
 			// ignore other events
 			continue
@@ -261,6 +306,91 @@ func (p *Peer) handleMessageLoop() {
 	}
 }
 
+// handlePeerIdentifiedLoop pulls the current document heads of the subscribed P2P collections
+// from every newly identified DefraDB peer, so that a node that was offline catches up on the
+// updates it missed without having to wait for them to be pushed again.
+func (p *Peer) handlePeerIdentifiedLoop() {
+	for {
+		msg, isOpen := <-p.identifiedSub.Out()
+		if !isOpen {
+			return
+		}
+
+		evt, ok := msg.(libp2pEvent.EvtPeerIdentificationCompleted)
+		if !ok || !slices.Contains(evt.Protocols, corenet.Protocol) {
+			continue
+		}
+
+		// the peer may have been unreachable before, in which case
+		// its grpc connection must not wait to be re-established
+		if err := p.server.closeFailedConn(evt.Peer); err != nil {
+			log.ErrorE("Failed to close grpc connection", err, corelog.Any("PeerID", evt.Peer))
+		}
+
+		if p.server.isReplicator(evt.Peer) {
+			p.bus.Publish(event.NewMessage(event.ReplicatorConnectedName, event.ReplicatorConnected{
+				PeerID: evt.Peer,
+			}))
+		}
+
+		go p.pullP2PCollections(evt.Peer)
+	}
+}
+
+// pullP2PCollections pulls the document heads of all the subscribed P2P collections
+// from the given peer and publishes a merge event for every head we are missing.
+func (p *Peer) pullP2PCollections(pid peer.ID) {
+	schemaRoots, err := p.db.GetAllP2PCollections(p.ctx)
+	if err != nil {
+		log.ErrorE("Failed to get P2P collections", err)
+		return
+	}
+
+	for _, schemaRoot := range schemaRoots {
+		merges, err := p.server.pullHeads(p.ctx, pid, schemaRoot, true)
+		if err != nil {
+			log.ErrorE(
+				"Failed to pull document heads",
+				err,
+				corelog.Any("PeerID", pid),
+				corelog.String("SchemaRoot", schemaRoot),
+			)
+			continue
+		}
+		for _, merge := range merges {
+			p.bus.Publish(event.NewMessage(event.MergeName, merge))
+		}
+	}
+}
+
+// syncCollections pulls the document heads of the given collections from the given peer and
+// returns the merges required to catch up with it.
+//
+// Unlike pullP2PCollections, heads that are already known locally are returned as well, so that
+// a forced sync can recover from a merge that previously failed.
+func (p *Peer) syncCollections(
+	ctx context.Context,
+	info peer.AddrInfo,
+	schemaRoots []string,
+) ([]event.Merge, error) {
+	if len(info.Addrs) > 0 {
+		p.host.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.TempAddrTTL)
+	}
+	if err := p.Connect(ctx, info); err != nil {
+		return nil, err
+	}
+
+	var merges []event.Merge
+	for _, schemaRoot := range schemaRoots {
+		schemaMerges, err := p.server.pullHeads(ctx, info.ID, schemaRoot, false)
+		if err != nil {
+			return nil, err
+		}
+		merges = append(merges, schemaMerges...)
+	}
+	return merges, nil
+}
+
 // RegisterNewDocument registers a new document with the peer node.
 func (p *Peer) RegisterNewDocument(
 	ctx context.Context,
@@ -355,26 +485,17 @@ func (p *Peer) pushLogToReplicators(lg event.Update) {
 		log.ErrorE("Failed to notify new blocks", err)
 	}
 
-	// push to each peer (replicator)
-	peers := make(map[string]struct{})
-	for _, peer := range p.ps.ListPeers(lg.DocID) {
-		peers[peer.String()] = struct{}{}
-	}
-	for _, peer := range p.ps.ListPeers(lg.SchemaRoot) {
-		peers[peer.String()] = struct{}{}
-	}
-
 	p.server.mu.Lock()
 	reps, exists := p.server.replicators[lg.SchemaRoot]
 	p.server.mu.Unlock()
 
 	if exists {
+		// push to each peer (replicator)
+		//
+		// Replicators that are also subscribed to the pubsub topics are pushed to as well,
+		// pubsub delivery is not guaranteed and a failed push must be recorded for it to
+		// be retried.
 		for pid := range reps {
-			// Don't push if pid is in the list of peers for the topic.
-			// It will be handled by the pubsub system.
-			if _, ok := peers[pid.String()]; ok {
-				continue
-			}
 			go func(peerID peer.ID) {
 				if err := p.server.pushLog(lg, peerID); err != nil {
 					log.ErrorE(
@@ -383,6 +504,7 @@ func (p *Peer) pushLogToReplicators(lg event.Update) {
 						corelog.String("DocID", lg.DocID),
 						corelog.Any("CID", lg.Cid),
 						corelog.Any("PeerID", peerID))
+					p.server.publishReplicatorFailure(lg, peerID, err)
 				}
 			}(pid)
 		}
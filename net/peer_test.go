@@ -13,8 +13,10 @@ package net
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
 	mh "github.com/multiformats/go-multihash"
 	badger "github.com/sourcenetwork/badger/v4"
 	rpc "github.com/sourcenetwork/go-libp2p-pubsub-rpc"
@@ -72,7 +74,7 @@ func newTestPeer(ctx context.Context, t *testing.T) (client.DB, *Peer) {
 
 	n, err := NewPeer(
 		ctx,
-		db.Blockstore(),
+		db,
 		db.Events(),
 		WithListenAddresses(randomMultiaddr),
 	)
@@ -87,7 +89,7 @@ func TestNewPeer_NoError(t *testing.T) {
 	db, err := db.NewDB(ctx, store, acp.NoACP, nil)
 	require.NoError(t, err)
 	defer db.Close()
-	p, err := NewPeer(ctx, db.Blockstore(), db.Events())
+	p, err := NewPeer(ctx, db, db.Events())
 	require.NoError(t, err)
 	p.Close()
 }
@@ -112,7 +114,7 @@ func TestStart_WithKnownPeer_NoError(t *testing.T) {
 
 	n1, err := NewPeer(
 		ctx,
-		db1.Blockstore(),
+		db1,
 		db1.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -120,7 +122,7 @@ func TestStart_WithKnownPeer_NoError(t *testing.T) {
 	defer n1.Close()
 	n2, err := NewPeer(
 		ctx,
-		db2.Blockstore(),
+		db2,
 		db2.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -384,7 +386,7 @@ func TestNewPeer_WithEnableRelay_NoError(t *testing.T) {
 	defer db.Close()
 	n, err := NewPeer(
 		context.Background(),
-		db.Blockstore(),
+		db,
 		db.Events(),
 		WithEnableRelay(true),
 	)
@@ -401,7 +403,7 @@ func TestNewPeer_NoPubSub_NoError(t *testing.T) {
 
 	n, err := NewPeer(
 		context.Background(),
-		db.Blockstore(),
+		db,
 		db.Events(),
 		WithEnablePubSub(false),
 	)
@@ -419,7 +421,7 @@ func TestNewPeer_WithEnablePubSub_NoError(t *testing.T) {
 
 	n, err := NewPeer(
 		ctx,
-		db.Blockstore(),
+		db,
 		db.Events(),
 		WithEnablePubSub(true),
 	)
@@ -438,7 +440,7 @@ func TestNodeClose_NoError(t *testing.T) {
 	defer db.Close()
 	n, err := NewPeer(
 		context.Background(),
-		db.Blockstore(),
+		db,
 		db.Events(),
 	)
 	require.NoError(t, err)
@@ -454,7 +456,7 @@ func TestListenAddrs_WithListenAddresses_NoError(t *testing.T) {
 
 	n, err := NewPeer(
 		context.Background(),
-		db.Blockstore(),
+		db,
 		db.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -472,7 +474,7 @@ func TestPeer_WithBootstrapPeers_NoError(t *testing.T) {
 
 	n, err := NewPeer(
 		context.Background(),
-		db.Blockstore(),
+		db,
 		db.Events(),
 		WithBootstrapPeers("/ip4/127.0.0.1/tcp/6666/p2p/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ"),
 	)
@@ -480,3 +482,136 @@ func TestPeer_WithBootstrapPeers_NoError(t *testing.T) {
 
 	n.Close()
 }
+
+func TestHandleReplicatorRetry_WithUnreachableReplicator_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, p := newTestPeer(ctx, t)
+	defer db.Close()
+	defer p.Close()
+
+	col, doc := createTestUser(ctx, t, db)
+
+	headCID, err := getHead(ctx, db, doc.ID())
+	require.NoError(t, err)
+
+	b, err := db.Blockstore().AsIPLDStorage().Get(ctx, headCID.KeyString())
+	require.NoError(t, err)
+
+	// a valid peer ID without any known address
+	pid, err := peer.Decode("12D3KooWB8Na2fKhdGtej5GjoVhmBBYFvqXiqFCSkR7fJFWHUbNr")
+	require.NoError(t, err)
+
+	result := make(chan error, 1)
+	db.Events().Publish(event.NewMessage(event.ReplicatorRetryName, event.ReplicatorRetry{
+		PeerID: pid,
+		Updates: []event.Update{
+			{
+				DocID:      doc.ID().String(),
+				Cid:        headCID,
+				SchemaRoot: col.SchemaRoot(),
+				Block:      b,
+			},
+		},
+		Result: result,
+	}))
+
+	select {
+	case err := <-result:
+		require.ErrorContains(t, err, errPushLog)
+	case <-time.After(2 * PushTimeout):
+		t.Fatal("timeout waiting for replicator retry result")
+	}
+}
+
+func TestHandleReplicatorRetry_WithReachableReplicator_NoError(t *testing.T) {
+	ctx := context.Background()
+	db1, n1 := newTestPeer(ctx, t)
+	defer db1.Close()
+	defer n1.Close()
+	db2, n2 := newTestPeer(ctx, t)
+	defer db2.Close()
+	defer n2.Close()
+
+	col, doc := createTestUser(ctx, t, db1)
+	_, err := db2.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+	}`)
+	require.NoError(t, err)
+
+	headCID, err := getHead(ctx, db1, doc.ID())
+	require.NoError(t, err)
+
+	b, err := db1.Blockstore().AsIPLDStorage().Get(ctx, headCID.KeyString())
+	require.NoError(t, err)
+
+	err = n1.Connect(ctx, n2.PeerInfo())
+	require.NoError(t, err)
+
+	result := make(chan error, 1)
+	db1.Events().Publish(event.NewMessage(event.ReplicatorRetryName, event.ReplicatorRetry{
+		PeerID: n2.PeerID(),
+		Updates: []event.Update{
+			{
+				DocID:      doc.ID().String(),
+				Cid:        headCID,
+				SchemaRoot: col.SchemaRoot(),
+				Block:      b,
+			},
+		},
+		Result: result,
+	}))
+
+	select {
+	case err := <-result:
+		require.NoError(t, err)
+	case <-time.After(2 * PushTimeout):
+		t.Fatal("timeout waiting for replicator retry result")
+	}
+}
+
+func TestSyncCollections_WithPubSubDisabled_NoError(t *testing.T) {
+	ctx := context.Background()
+	newPeer := func() (client.DB, *Peer) {
+		store := memory.NewDatastore(ctx)
+		db, err := db.NewDB(ctx, store, acp.NoACP, nil)
+		require.NoError(t, err)
+		n, err := NewPeer(
+			ctx,
+			db,
+			db.Events(),
+			WithListenAddresses(randomMultiaddr),
+			WithEnablePubSub(false),
+		)
+		require.NoError(t, err)
+		return db, n
+	}
+	db1, n1 := newPeer()
+	defer db1.Close()
+	defer n1.Close()
+	db2, n2 := newPeer()
+	defer db2.Close()
+	defer n2.Close()
+
+	_, doc := createTestUser(ctx, t, db2)
+	_, err := db1.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+	}`)
+	require.NoError(t, err)
+
+	// the peer info is set on the db asynchronously
+	require.Eventually(t, func() bool {
+		return db1.PeerInfo().ID != ""
+	}, time.Second, 10*time.Millisecond)
+
+	syncCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	err = db1.SyncCollections(syncCtx, n2.PeerInfo(), []string{"User"})
+	require.NoError(t, err)
+
+	col, err := db1.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, doc.ID(), false)
+	require.NoError(t, err)
+}
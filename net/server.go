@@ -15,13 +15,19 @@ package net
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 
 	cid "github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/ipld/go-ipld-prime/linking"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	libpeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
 	"github.com/sourcenetwork/corelog"
 	rpc "github.com/sourcenetwork/go-libp2p-pubsub-rpc"
+	"github.com/sourcenetwork/immutable"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	grpcpeer "google.golang.org/grpc/peer"
@@ -30,6 +36,7 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/core"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
 	pb "github.com/sourcenetwork/defradb/net/pb"
 )
@@ -81,25 +88,161 @@ func newServer(p *Peer, opts ...grpc.DialOption) (*server, error) {
 	return s, nil
 }
 
+// maxReplyBlocksSize is the total size of the blocks, in bytes, after which the GetDocGraph
+// and GetHeadLog replies are cut short and the remaining blocks left to the following pages.
+//
+// A page always holds at least one block so that the requester can make progress.
+var maxReplyBlocksSize = 1 << 20
+
 // GetDocGraph receives a get graph request
+//
+// It replies with the current heads of the requested document and the blocks
+// of its composite DAG, starting from the requested offset. If the blocks exceed
+// [maxReplyBlocksSize] the offset of the next page is set on the reply.
 func (s *server) GetDocGraph(
 	ctx context.Context,
 	req *pb.GetDocGraphRequest,
 ) (*pb.GetDocGraphReply, error) {
-	return nil, nil
+	col, err := s.getSchemaCollection(ctx, string(req.SchemaRoot))
+	if err != nil {
+		return nil, err
+	}
+	docID, err := s.getAccessibleDocID(ctx, col, string(req.DocID))
+	if err != nil {
+		return nil, err
+	}
+	heads, err := s.getDocHeads(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetDocGraphReply{}
+	visited := make(map[cid.Cid]struct{})
+	toVisit := make([]cid.Cid, 0, len(heads))
+	for _, head := range heads {
+		reply.Heads = append(reply.Heads, head.Bytes())
+		toVisit = append(toVisit, head)
+	}
+
+	// The graph is walked in the same order for every page, so that the
+	// offset identifies the same blocks from one request to the next.
+	var offset uint64
+	var size int
+	for len(toVisit) > 0 {
+		current := toVisit[len(toVisit)-1]
+		toVisit = toVisit[:len(toVisit)-1]
+		if _, ok := visited[current]; ok {
+			continue
+		}
+		visited[current] = struct{}{}
+
+		// the blocks removed by a compaction are left out of the graph
+		compacted, err := s.peer.blockstore.IsCompacted(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		if compacted {
+			continue
+		}
+
+		if offset >= req.Offset && size >= maxReplyBlocksSize {
+			reply.NextOffset = offset
+			break
+		}
+
+		data, err := s.getBlockData(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		block, err := coreblock.GetFromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range block.Links {
+			toVisit = append(toVisit, link.Cid)
+		}
+		if offset >= req.Offset {
+			reply.Logs = append(reply.Logs, &pb.Document_Log{Block: data})
+			size += len(data)
+		}
+		offset++
+	}
+
+	return reply, nil
 }
 
 // PushDocGraph receives a push graph request
+//
+// The pushed blocks are stored locally and every head is then processed
+// the same way as a pushed log.
 func (s *server) PushDocGraph(
 	ctx context.Context,
 	req *pb.PushDocGraphRequest,
 ) (*pb.PushDocGraphReply, error) {
-	return nil, nil
+	if req.Body == nil {
+		return &pb.PushDocGraphReply{}, nil
+	}
+
+	blocks := make(map[cid.Cid][]byte, len(req.Body.Logs))
+	for _, docLog := range req.Body.Logs {
+		block, err := coreblock.GetFromBytes(docLog.Block)
+		if err != nil {
+			return nil, err
+		}
+		link, err := s.putBlock(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		blocks[link.Cid] = docLog.Block
+	}
+
+	for _, head := range req.Body.Heads {
+		headCID, err := cid.Cast(head)
+		if err != nil {
+			return nil, err
+		}
+		data, ok := blocks[headCID]
+		if !ok {
+			data, err = s.getBlockData(ctx, headCID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = s.PushLog(ctx, &pb.PushLogRequest{
+			Body: &pb.PushLogRequest_Body{
+				DocID:      req.Body.DocID,
+				Cid:        head,
+				SchemaRoot: req.Body.SchemaRoot,
+				Creator:    req.Body.Creator,
+				Log:        &pb.Document_Log{Block: data},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.PushDocGraphReply{}, nil
 }
 
 // GetLog receives a get log request
+//
+// It replies with the blocks of the requested CIDs.
 func (s *server) GetLog(ctx context.Context, req *pb.GetLogRequest) (*pb.GetLogReply, error) {
-	return nil, nil
+	reply := &pb.GetLogReply{}
+	for _, c := range req.Cids {
+		blockCID, err := cid.Cast(c)
+		if err != nil {
+			return nil, err
+		}
+		data, err := s.getBlockData(ctx, blockCID)
+		if err != nil {
+			return nil, err
+		}
+		reply.Logs = append(reply.Logs, &pb.Document_Log{Block: data})
+	}
+	return reply, nil
 }
 
 // PushLog receives a push log request
@@ -134,7 +277,7 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 		corelog.Any("PeerID", pid.String()),
 		corelog.Any("DocID", docID.String()))
 
-	err = syncDAG(ctx, s.peer.bserv, block)
+	err = syncDAG(ctx, s.peer.bserv, s.peer.blockstore, block)
 	if err != nil {
 		return nil, err
 	}
@@ -164,11 +307,157 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 }
 
 // GetHeadLog receives a get head log request
+//
+// It replies with the current heads, and their blocks, of the requested document
+// or of every document of the requested schema root if no document is specified.
+//
+// Documents are returned in docID order, after the requested cursor. If their blocks
+// exceed [maxReplyBlocksSize] the cursor of the next page is set on the reply.
 func (s *server) GetHeadLog(
 	ctx context.Context,
 	req *pb.GetHeadLogRequest,
 ) (*pb.GetHeadLogReply, error) {
-	return nil, nil
+	col, err := s.getSchemaCollection(ctx, string(req.SchemaRoot))
+	if err != nil {
+		return nil, err
+	}
+
+	var docIDs []client.DocID
+	if len(req.DocID) > 0 {
+		docID, err := s.getAccessibleDocID(ctx, col, string(req.DocID))
+		if err != nil {
+			return nil, err
+		}
+		docIDs = append(docIDs, docID)
+	} else {
+		docIDResults, err := col.GetAllDocIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for docIDResult := range docIDResults {
+			if docIDResult.Err != nil {
+				return nil, docIDResult.Err
+			}
+			docIDs = append(docIDs, docIDResult.ID)
+		}
+		slices.SortFunc(docIDs, func(a, b client.DocID) int {
+			return strings.Compare(a.String(), b.String())
+		})
+	}
+
+	reply := &pb.GetHeadLogReply{}
+	var size int
+	for i, docID := range docIDs {
+		if len(req.Cursor) > 0 && docID.String() <= string(req.Cursor) {
+			continue
+		}
+		if size >= maxReplyBlocksSize {
+			reply.Cursor = []byte(docIDs[i-1].String())
+			break
+		}
+
+		heads, err := s.getDocHeads(ctx, docID)
+		if err != nil {
+			return nil, err
+		}
+		for _, head := range heads {
+			data, err := s.getBlockData(ctx, head)
+			if err != nil {
+				return nil, err
+			}
+			reply.Heads = append(reply.Heads, &pb.GetHeadLogReply_HeadLog{
+				DocID: []byte(docID.String()),
+				Cid:   head.Bytes(),
+				Log:   &pb.Document_Log{Block: data},
+			})
+			size += len(data)
+		}
+	}
+
+	return reply, nil
+}
+
+// getSchemaCollection returns the collection of the given schema root.
+func (s *server) getSchemaCollection(ctx context.Context, schemaRoot string) (client.Collection, error) {
+	if schemaRoot == "" {
+		return nil, ErrMissingSchemaRoot
+	}
+	cols, err := s.peer.db.GetCollections(
+		ctx,
+		client.CollectionFetchOptions{
+			SchemaRoot: immutable.Some(schemaRoot),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(cols) == 0 {
+		return nil, client.NewErrCollectionNotFoundForSchema(schemaRoot)
+	}
+	return cols[0], nil
+}
+
+// getAccessibleDocID returns the given document ID if the document exists within the collection
+// and is accessible to remote peers.
+func (s *server) getAccessibleDocID(ctx context.Context, col client.Collection, docIDStr string) (client.DocID, error) {
+	docID, err := client.NewDocIDFromString(docIDStr)
+	if err != nil {
+		return client.DocID{}, err
+	}
+	// Remote requests carry no identity, this ensures we only ever
+	// expose the heads of documents that are publicly accessible.
+	_, err = col.Get(ctx, docID, true)
+	if err != nil {
+		return client.DocID{}, err
+	}
+	return docID, nil
+}
+
+// getDocHeads returns the current composite heads of the given document.
+func (s *server) getDocHeads(ctx context.Context, docID client.DocID) ([]cid.Cid, error) {
+	prefix := core.DataStoreKeyFromDocID(docID).ToHeadStoreKey().WithFieldId(core.COMPOSITE_NAMESPACE).ToString()
+	results, err := s.peer.db.Headstore().Query(ctx, query.Query{Prefix: prefix, KeysOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := results.Close(); err != nil {
+			log.ErrorE("Failed to close headstore query results", err)
+		}
+	}()
+
+	var heads []cid.Cid
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		headKey, err := core.NewHeadStoreKey(result.Key)
+		if err != nil {
+			return nil, err
+		}
+		heads = append(heads, headKey.Cid)
+	}
+	return heads, nil
+}
+
+// getBlockData returns the raw data of the block with the given CID from the local blockstore.
+func (s *server) getBlockData(ctx context.Context, blockCID cid.Cid) ([]byte, error) {
+	block, err := s.peer.blockstore.Get(ctx, blockCID)
+	if err != nil {
+		return nil, err
+	}
+	return block.RawData(), nil
+}
+
+// putBlock stores the given block in the local blockstore.
+func (s *server) putBlock(ctx context.Context, block *coreblock.Block) (cidlink.Link, error) {
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(s.peer.blockstore.AsIPLDStorage())
+	link, err := lsys.Store(linking.LinkContext{Ctx: ctx}, coreblock.GetLinkPrototype(), block.GenerateNode())
+	if err != nil {
+		return cidlink.Link{}, err
+	}
+	return link.(cidlink.Link), nil
 }
 
 // addPubSubTopic subscribes to a topic on the pubsub network
@@ -404,8 +693,32 @@ func (s *server) updateReplicators(evt event.Replicator) {
 					corelog.Any("CID", update.Cid),
 					corelog.Any("PeerID", evt.Info.ID),
 				)
+				s.publishReplicatorFailure(update, evt.Info.ID, err)
 			}
 		}
 	}
 	s.peer.bus.Publish(event.NewMessage(event.ReplicatorCompletedName, nil))
 }
+
+// isReplicator returns true if the given peer is a replicator of any collection.
+func (s *server) isReplicator(pid libpeer.ID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, peers := range s.replicators {
+		if _, ok := peers[pid]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// publishReplicatorFailure notifies the database that the given update
+// failed to be pushed to the given replicator so that it can be retried.
+func (s *server) publishReplicatorFailure(update event.Update, pid libpeer.ID, err error) {
+	s.peer.bus.Publish(event.NewMessage(event.ReplicatorFailureName, event.ReplicatorFailure{
+		PeerID:     pid,
+		DocID:      update.DocID,
+		SchemaRoot: update.SchemaRoot,
+		Err:        err,
+	}))
+}
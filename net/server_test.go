@@ -39,9 +39,71 @@ func TestGetDocGraph(t *testing.T) {
 	db, p := newTestPeer(ctx, t)
 	defer db.Close()
 	defer p.Close()
-	r, err := p.server.GetDocGraph(ctx, &net_pb.GetDocGraphRequest{})
-	require.Nil(t, r)
-	require.Nil(t, err)
+
+	col, doc := createTestUser(ctx, t, db)
+
+	err := doc.Set("age", 31)
+	require.NoError(t, err)
+	err = col.Update(ctx, doc)
+	require.NoError(t, err)
+
+	headCID, err := getHead(ctx, db, doc.ID())
+	require.NoError(t, err)
+
+	r, err := p.server.GetDocGraph(ctx, &net_pb.GetDocGraphRequest{
+		DocID:      []byte(doc.ID().String()),
+		SchemaRoot: []byte(col.SchemaRoot()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{headCID.Bytes()}, r.Heads)
+	// 2 composite blocks and 2 + 1 field blocks
+	require.Len(t, r.Logs, 5)
+}
+
+func TestGetDocGraph_WithSmallMaxReplySize_ReturnsPages(t *testing.T) {
+	ctx := context.Background()
+	db, p := newTestPeer(ctx, t)
+	defer db.Close()
+	defer p.Close()
+
+	col, doc := createTestUser(ctx, t, db)
+
+	err := doc.Set("age", 31)
+	require.NoError(t, err)
+	err = col.Update(ctx, doc)
+	require.NoError(t, err)
+
+	maxSize := maxReplyBlocksSize
+	maxReplyBlocksSize = 1
+	defer func() { maxReplyBlocksSize = maxSize }()
+
+	var logs []*net_pb.Document_Log
+	var offset uint64
+	for {
+		r, err := p.server.GetDocGraph(ctx, &net_pb.GetDocGraphRequest{
+			DocID:      []byte(doc.ID().String()),
+			SchemaRoot: []byte(col.SchemaRoot()),
+			Offset:     offset,
+		})
+		require.NoError(t, err)
+		require.Len(t, r.Logs, 1)
+		logs = append(logs, r.Logs...)
+		if r.NextOffset == 0 {
+			break
+		}
+		offset = r.NextOffset
+	}
+	// 2 composite blocks and 2 + 1 field blocks
+	require.Len(t, logs, 5)
+}
+
+func TestGetDocGraph_WithoutSchemaRoot_Error(t *testing.T) {
+	ctx := context.Background()
+	db, p := newTestPeer(ctx, t)
+	defer db.Close()
+	defer p.Close()
+	_, err := p.server.GetDocGraph(ctx, &net_pb.GetDocGraphRequest{})
+	require.ErrorIs(t, err, ErrMissingSchemaRoot)
 }
 
 func TestPushDocGraph(t *testing.T) {
@@ -49,9 +111,30 @@ func TestPushDocGraph(t *testing.T) {
 	db, p := newTestPeer(ctx, t)
 	defer db.Close()
 	defer p.Close()
-	r, err := p.server.PushDocGraph(ctx, &net_pb.PushDocGraphRequest{})
-	require.Nil(t, r)
-	require.Nil(t, err)
+
+	col, doc := createTestUser(ctx, t, db)
+
+	graph, err := p.server.GetDocGraph(ctx, &net_pb.GetDocGraphRequest{
+		DocID:      []byte(doc.ID().String()),
+		SchemaRoot: []byte(col.SchemaRoot()),
+	})
+	require.NoError(t, err)
+
+	ctx = grpcpeer.NewContext(ctx, &grpcpeer.Peer{
+		Addr: addr{p.PeerID()},
+	})
+
+	r, err := p.server.PushDocGraph(ctx, &net_pb.PushDocGraphRequest{
+		Body: &net_pb.PushDocGraphRequest_Body{
+			DocID:      []byte(doc.ID().String()),
+			Heads:      graph.Heads,
+			SchemaRoot: []byte(col.SchemaRoot()),
+			Creator:    p.PeerID().String(),
+			Logs:       graph.Logs,
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, r)
 }
 
 func TestGetLog(t *testing.T) {
@@ -59,9 +142,21 @@ func TestGetLog(t *testing.T) {
 	db, p := newTestPeer(ctx, t)
 	defer db.Close()
 	defer p.Close()
-	r, err := p.server.GetLog(ctx, &net_pb.GetLogRequest{})
-	require.Nil(t, r)
-	require.Nil(t, err)
+
+	_, doc := createTestUser(ctx, t, db)
+
+	headCID, err := getHead(ctx, db, doc.ID())
+	require.NoError(t, err)
+
+	b, err := db.Blockstore().AsIPLDStorage().Get(ctx, headCID.KeyString())
+	require.NoError(t, err)
+
+	r, err := p.server.GetLog(ctx, &net_pb.GetLogRequest{
+		Cids: [][]byte{headCID.Bytes()},
+	})
+	require.NoError(t, err)
+	require.Len(t, r.Logs, 1)
+	require.Equal(t, b, r.Logs[0].Block)
 }
 
 func TestGetHeadLog(t *testing.T) {
@@ -69,9 +164,109 @@ func TestGetHeadLog(t *testing.T) {
 	db, p := newTestPeer(ctx, t)
 	defer db.Close()
 	defer p.Close()
-	r, err := p.server.GetHeadLog(ctx, &net_pb.GetHeadLogRequest{})
-	require.Nil(t, r)
-	require.Nil(t, err)
+
+	col, doc := createTestUser(ctx, t, db)
+
+	headCID, err := getHead(ctx, db, doc.ID())
+	require.NoError(t, err)
+
+	r, err := p.server.GetHeadLog(ctx, &net_pb.GetHeadLogRequest{
+		SchemaRoot: []byte(col.SchemaRoot()),
+	})
+	require.NoError(t, err)
+	require.Len(t, r.Heads, 1)
+	require.Equal(t, []byte(doc.ID().String()), r.Heads[0].DocID)
+	require.Equal(t, headCID.Bytes(), r.Heads[0].Cid)
+	require.NotEmpty(t, r.Heads[0].Log.Block)
+}
+
+func TestGetHeadLog_WithDocID(t *testing.T) {
+	ctx := context.Background()
+	db, p := newTestPeer(ctx, t)
+	defer db.Close()
+	defer p.Close()
+
+	col, doc := createTestUser(ctx, t, db)
+
+	doc2, err := client.NewDocFromJSON([]byte(`{"name": "Fred", "age": 40}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc2)
+	require.NoError(t, err)
+
+	r, err := p.server.GetHeadLog(ctx, &net_pb.GetHeadLogRequest{
+		SchemaRoot: []byte(col.SchemaRoot()),
+		DocID:      []byte(doc.ID().String()),
+	})
+	require.NoError(t, err)
+	require.Len(t, r.Heads, 1)
+	require.Equal(t, []byte(doc.ID().String()), r.Heads[0].DocID)
+}
+
+func TestGetHeadLog_WithSmallMaxReplySize_ReturnsPages(t *testing.T) {
+	ctx := context.Background()
+	db, p := newTestPeer(ctx, t)
+	defer db.Close()
+	defer p.Close()
+
+	col, doc := createTestUser(ctx, t, db)
+
+	doc2, err := client.NewDocFromJSON([]byte(`{"name": "Fred", "age": 40}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc2)
+	require.NoError(t, err)
+
+	maxSize := maxReplyBlocksSize
+	maxReplyBlocksSize = 1
+	defer func() { maxReplyBlocksSize = maxSize }()
+
+	r, err := p.server.GetHeadLog(ctx, &net_pb.GetHeadLogRequest{
+		SchemaRoot: []byte(col.SchemaRoot()),
+	})
+	require.NoError(t, err)
+	require.Len(t, r.Heads, 1)
+	require.Equal(t, r.Heads[0].DocID, r.Cursor)
+
+	r2, err := p.server.GetHeadLog(ctx, &net_pb.GetHeadLogRequest{
+		SchemaRoot: []byte(col.SchemaRoot()),
+		Cursor:     r.Cursor,
+	})
+	require.NoError(t, err)
+	require.Len(t, r2.Heads, 1)
+	require.Empty(t, r2.Cursor)
+
+	require.ElementsMatch(
+		t,
+		[][]byte{[]byte(doc.ID().String()), []byte(doc2.ID().String())},
+		[][]byte{r.Heads[0].DocID, r2.Heads[0].DocID},
+	)
+}
+
+func TestGetHeadLog_WithoutSchemaRoot_Error(t *testing.T) {
+	ctx := context.Background()
+	db, p := newTestPeer(ctx, t)
+	defer db.Close()
+	defer p.Close()
+	_, err := p.server.GetHeadLog(ctx, &net_pb.GetHeadLogRequest{})
+	require.ErrorIs(t, err, ErrMissingSchemaRoot)
+}
+
+func createTestUser(ctx context.Context, t *testing.T, db client.DB) (client.Collection, *client.Document) {
+	_, err := db.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`), col.Definition())
+	require.NoError(t, err)
+
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	return col, doc
 }
 
 func getHead(ctx context.Context, db client.DB, docID client.DocID) (cid.Cid, error) {
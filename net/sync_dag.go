@@ -20,6 +20,7 @@ import (
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	"github.com/ipld/go-ipld-prime/storage/bsrvadapter"
 
+	"github.com/sourcenetwork/defradb/datastore"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
 )
 
@@ -30,9 +31,16 @@ var syncDAGTimeout = 60 * time.Second
 // syncDAG synchronizes the DAG starting with the given block
 // using the blockservice to fetch remote blocks.
 //
+// The walk stops at the blocks that have been removed from the blockstore by a compaction.
+//
 // This process walks the entire DAG until the issue below is resolved.
 // https://github.com/sourcenetwork/defradb/issues/2722
-func syncDAG(ctx context.Context, bserv blockservice.BlockService, block *coreblock.Block) error {
+func syncDAG(
+	ctx context.Context,
+	bserv blockservice.BlockService,
+	bstore datastore.Blockstore,
+	block *coreblock.Block,
+) error {
 	// use a session to make remote fetches more efficient
 	ctx = blockservice.ContextWithSession(ctx, bserv)
 	store := &bsrvadapter.Adapter{Wrapped: bserv}
@@ -48,7 +56,7 @@ func syncDAG(ctx context.Context, bserv blockservice.BlockService, block *corebl
 		return err
 	}
 
-	err = loadBlockLinks(ctx, lsys, block)
+	err = loadBlockLinks(ctx, lsys, bstore, block)
 	if err != nil {
 		return err
 	}
@@ -59,7 +67,12 @@ func syncDAG(ctx context.Context, bserv blockservice.BlockService, block *corebl
 //
 // If it encounters errors in the concurrent loading of links, it will return
 // the first error it encountered.
-func loadBlockLinks(ctx context.Context, lsys linking.LinkSystem, block *coreblock.Block) error {
+func loadBlockLinks(
+	ctx context.Context,
+	lsys linking.LinkSystem,
+	bstore datastore.Blockstore,
+	block *coreblock.Block,
+) error {
 	ctx, cancel := context.WithTimeout(ctx, syncDAGTimeout)
 	defer cancel()
 
@@ -79,6 +92,14 @@ func loadBlockLinks(ctx context.Context, lsys linking.LinkSystem, block *coreblo
 			if ctx.Err() != nil {
 				return
 			}
+			compacted, err := bstore.IsCompacted(ctx, lnk.Cid)
+			if err != nil {
+				asyncErrOnce.Do(func() { setAsyncErr(err) })
+				return
+			}
+			if compacted {
+				return
+			}
 			nd, err := lsys.Load(linking.LinkContext{Ctx: ctx}, lnk, coreblock.SchemaPrototype)
 			if err != nil {
 				asyncErrOnce.Do(func() { setAsyncErr(err) })
@@ -89,7 +110,7 @@ func loadBlockLinks(ctx context.Context, lsys linking.LinkSystem, block *coreblo
 				asyncErrOnce.Do(func() { setAsyncErr(err) })
 				return
 			}
-			err = loadBlockLinks(ctx, lsys, linkBlock)
+			err = loadBlockLinks(ctx, lsys, bstore, linkBlock)
 			if err != nil {
 				asyncErrOnce.Do(func() { setAsyncErr(err) })
 				return
@@ -132,7 +132,7 @@ func NewNode(ctx context.Context, opts ...Option) (*Node, error) {
 	var peer *net.Peer
 	if !options.disableP2P {
 		// setup net node
-		peer, err = net.NewPeer(ctx, db.Blockstore(), db.Events(), netOpts...)
+		peer, err = net.NewPeer(ctx, db, db.Events(), netOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -149,6 +149,20 @@ func (w *Wrapper) GetAllP2PCollections(ctx context.Context) ([]string, error) {
 	return cols, nil
 }
 
+func (w *Wrapper) SyncCollections(ctx context.Context, info peer.AddrInfo, collectionNames []string) error {
+	args := []string{"client", "p2p", "sync"}
+	args = append(args, "--collection", strings.Join(collectionNames, ","))
+
+	infoJSON, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	args = append(args, string(infoJSON))
+
+	_, err = w.cmd.execute(ctx, args)
+	return err
+}
+
 func (w *Wrapper) BasicImport(ctx context.Context, filepath string) error {
 	args := []string{"client", "backup", "import"}
 	args = append(args, filepath)
@@ -195,6 +209,62 @@ func (w *Wrapper) AddPolicy(
 	return addPolicyResult, err
 }
 
+func (w *Wrapper) AddDocActorRelationship(
+	ctx context.Context,
+	collectionName string,
+	docID string,
+	relation string,
+	targetActor string,
+) (client.AddDocActorRelationshipResult, error) {
+	args := []string{
+		"client", "acp", "relationship", "add",
+		"--collection", collectionName,
+		"--docID", docID,
+		"--relation", relation,
+		"--actor", targetActor,
+	}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.AddDocActorRelationshipResult{}, err
+	}
+
+	var exists client.AddDocActorRelationshipResult
+	if err := json.Unmarshal(data, &exists); err != nil {
+		return client.AddDocActorRelationshipResult{}, err
+	}
+
+	return exists, err
+}
+
+func (w *Wrapper) DeleteDocActorRelationship(
+	ctx context.Context,
+	collectionName string,
+	docID string,
+	relation string,
+	targetActor string,
+) (client.DeleteDocActorRelationshipResult, error) {
+	args := []string{
+		"client", "acp", "relationship", "delete",
+		"--collection", collectionName,
+		"--docID", docID,
+		"--relation", relation,
+		"--actor", targetActor,
+	}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.DeleteDocActorRelationshipResult{}, err
+	}
+
+	var deleteDocActorRelResult client.DeleteDocActorRelationshipResult
+	if err := json.Unmarshal(data, &deleteDocActorRelResult); err != nil {
+		return client.DeleteDocActorRelationshipResult{}, err
+	}
+
+	return deleteDocActorRelResult, err
+}
+
 func (w *Wrapper) AddSchema(ctx context.Context, schema string) ([]client.CollectionDescription, error) {
 	args := []string{"client", "schema", "add"}
 	args = append(args, schema)
@@ -300,6 +370,32 @@ func (w *Wrapper) RefreshViews(ctx context.Context, options client.CollectionFet
 	return err
 }
 
+func (w *Wrapper) CompactCollection(
+	ctx context.Context,
+	collectionName string,
+	policy immutable.Option[client.RetentionPolicy],
+) (client.CompactionResult, error) {
+	args := []string{"client", "collection", "compact", "--name", collectionName}
+	if policy.HasValue() {
+		if policy.Value().Depth.HasValue() {
+			args = append(args, "--depth", strconv.FormatUint(policy.Value().Depth.Value(), 10))
+		}
+		if policy.Value().Age.HasValue() {
+			args = append(args, "--age", policy.Value().Age.Value().String())
+		}
+	}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	var res client.CompactionResult
+	if err := json.Unmarshal(data, &res); err != nil {
+		return client.CompactionResult{}, err
+	}
+	return res, nil
+}
+
 func (w *Wrapper) SetMigration(ctx context.Context, config client.LensConfig) error {
 	args := []string{"client", "schema", "migration", "set"}
 
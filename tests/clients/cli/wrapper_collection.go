@@ -184,6 +184,21 @@ func (c *Collection) Delete(
 	return true, nil
 }
 
+func (c *Collection) Purge(
+	ctx context.Context,
+	docID client.DocID,
+) (bool, error) {
+	args := []string{"client", "collection", "purge"}
+	args = append(args, "--name", c.Description().Name.Value())
+	args = append(args, "--docID", docID.String())
+
+	_, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (c *Collection) Exists(
 	ctx context.Context,
 	docID client.DocID,
@@ -226,6 +241,47 @@ func (c *Collection) UpdateWithFilter(
 	return &res, nil
 }
 
+func (c *Collection) Upsert(
+	ctx context.Context,
+	filter any,
+	doc *client.Document,
+	updater string,
+) (*client.UpsertResult, error) {
+	if !c.Description().Name.HasValue() {
+		return nil, client.ErrOperationNotPermittedOnNamelessCols
+	}
+
+	args := []string{"client", "collection", "upsert"}
+	args = append(args, "--name", c.Description().Name.Value())
+	args = append(args, "--updater", updater)
+
+	filterJSON, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, "--filter", string(filterJSON))
+
+	document, err := doc.String()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, document)
+
+	data, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var res client.UpsertResult
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	if res.Created {
+		doc.Clean()
+	}
+	return &res, nil
+}
+
 func (c *Collection) DeleteWithFilter(
 	ctx context.Context,
 	filter any,
@@ -347,6 +403,16 @@ func (c *Collection) CreateIndex(
 	if indexDesc.Unique {
 		args = append(args, "--unique")
 	}
+	if indexDesc.Type != client.IndexTypeDefault {
+		args = append(args, "--type", string(indexDesc.Type))
+	}
+	if len(indexDesc.Analyzer) > 0 {
+		filters := make([]string, len(indexDesc.Analyzer))
+		for i := range indexDesc.Analyzer {
+			filters[i] = string(indexDesc.Analyzer[i])
+		}
+		args = append(args, "--analyzer", strings.Join(filters, ","))
+	}
 
 	fields := make([]string, len(indexDesc.Fields))
 	for i := range indexDesc.Fields {
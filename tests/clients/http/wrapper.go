@@ -86,6 +86,10 @@ func (w *Wrapper) GetAllP2PCollections(ctx context.Context) ([]string, error) {
 	return w.client.GetAllP2PCollections(ctx)
 }
 
+func (w *Wrapper) SyncCollections(ctx context.Context, info peer.AddrInfo, collectionNames []string) error {
+	return w.client.SyncCollections(ctx, info, collectionNames)
+}
+
 func (w *Wrapper) BasicImport(ctx context.Context, filepath string) error {
 	return w.client.BasicImport(ctx, filepath)
 }
@@ -105,6 +109,38 @@ func (w *Wrapper) AddPolicy(
 	return w.client.AddPolicy(ctx, policy)
 }
 
+func (w *Wrapper) AddDocActorRelationship(
+	ctx context.Context,
+	collectionName string,
+	docID string,
+	relation string,
+	targetActor string,
+) (client.AddDocActorRelationshipResult, error) {
+	return w.client.AddDocActorRelationship(
+		ctx,
+		collectionName,
+		docID,
+		relation,
+		targetActor,
+	)
+}
+
+func (w *Wrapper) DeleteDocActorRelationship(
+	ctx context.Context,
+	collectionName string,
+	docID string,
+	relation string,
+	targetActor string,
+) (client.DeleteDocActorRelationshipResult, error) {
+	return w.client.DeleteDocActorRelationship(
+		ctx,
+		collectionName,
+		docID,
+		relation,
+		targetActor,
+	)
+}
+
 func (w *Wrapper) PatchSchema(
 	ctx context.Context,
 	patch string,
@@ -138,6 +174,14 @@ func (w *Wrapper) RefreshViews(ctx context.Context, opts client.CollectionFetchO
 	return w.client.RefreshViews(ctx, opts)
 }
 
+func (w *Wrapper) CompactCollection(
+	ctx context.Context,
+	collectionName string,
+	policy immutable.Option[client.RetentionPolicy],
+) (client.CompactionResult, error) {
+	return w.client.CompactCollection(ctx, collectionName, policy)
+}
+
 func (w *Wrapper) SetMigration(ctx context.Context, config client.LensConfig) error {
 	return w.client.SetMigration(ctx, config)
 }
@@ -119,6 +119,187 @@ func addPolicyACP(
 	}
 }
 
+// AddDocActorRelationship will attempt to create a new relationship for a document with an actor.
+type AddDocActorRelationship struct {
+	// NodeID may hold the ID (index) of the node we want to add doc actor relationship on.
+	//
+	// If a value is not provided the relationship will be added in all nodes, unless testing with
+	// sourcehub ACP, in which case the relationship will only be defined once.
+	NodeID immutable.Option[int]
+
+	// The collection in which this document we want to add a relationship for exists.
+	CollectionID int
+
+	// The index-identifier of the document within the collection.  This is based on
+	// the order in which it was created, not the ordering of the document within the
+	// database.
+	DocID int
+
+	// The name of the relation to set between document and target actor (should be defined in the policy).
+	Relation string
+
+	// The target public identity, i.e. the identity of the actor to tie the document's relation with.
+	//
+	// If -1 is given, the relationship is made with all actors ("*").
+	TargetIdentity int
+
+	// The requestor identity, i.e. identity of the actor creating the relationship.
+	// Note: This identity must either own or have managing access defined in the policy.
+	RequestorIdentity int
+
+	// Result returns true if it was a no-op due to existing before, and false if a new relationship was made.
+	ExpectedExistence bool
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+func addDocActorRelationshipACP(
+	s *state,
+	action AddDocActorRelationship,
+) {
+	for i, node := range getNodes(action.NodeID, s.nodes) {
+		collectionName, docID, targetActor := getDocActorRelationshipArgs(
+			s,
+			i,
+			action.CollectionID,
+			action.DocID,
+			action.TargetIdentity,
+		)
+
+		requestorIdentity := getIdentity(s, i, immutable.Some(action.RequestorIdentity))
+		ctx := db.SetContextIdentity(s.ctx, requestorIdentity)
+
+		exists, err := node.AddDocActorRelationship(
+			ctx,
+			collectionName,
+			docID,
+			action.Relation,
+			targetActor,
+		)
+
+		expectedErrorRaised := AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+		assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+
+		if !expectedErrorRaised {
+			require.Equal(s.t, action.ExpectedError, "")
+			require.Equal(s.t, action.ExpectedExistence, exists.ExistedAlready)
+		}
+
+		// The relationship should only be added to a SourceHub chain once - there is no need to loop through
+		// the nodes.
+		if acpType == SourceHubACPType {
+			break
+		}
+	}
+}
+
+// DeleteDocActorRelationship will attempt to delete a relationship between a document and an actor.
+type DeleteDocActorRelationship struct {
+	// NodeID may hold the ID (index) of the node we want to delete doc actor relationship on.
+	//
+	// If a value is not provided the relationship will be deleted on all nodes, unless testing with
+	// sourcehub ACP, in which case the relationship will only be deleted once.
+	NodeID immutable.Option[int]
+
+	// The collection in which the target document we want to delete relationship for exists.
+	CollectionID int
+
+	// The index-identifier of the document within the collection.  This is based on
+	// the order in which it was created, not the ordering of the document within the
+	// database.
+	DocID int
+
+	// The name of the relation within the relationship we want to delete (should be defined in the policy).
+	Relation string
+
+	// The target public identity, i.e. the identity of the actor with whom the relationship is with.
+	//
+	// If -1 is given, the relationship with all actors ("*") is deleted.
+	TargetIdentity int
+
+	// The requestor identity, i.e. identity of the actor deleting the relationship.
+	// Note: This identity must either own or have managing access defined in the policy.
+	RequestorIdentity int
+
+	// Result returns true if the relationship record was expected to be found and deleted,
+	// and returns false if no matching relationship record was found (no-op).
+	ExpectedRecordFound bool
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+func deleteDocActorRelationshipACP(
+	s *state,
+	action DeleteDocActorRelationship,
+) {
+	for i, node := range getNodes(action.NodeID, s.nodes) {
+		collectionName, docID, targetActor := getDocActorRelationshipArgs(
+			s,
+			i,
+			action.CollectionID,
+			action.DocID,
+			action.TargetIdentity,
+		)
+
+		requestorIdentity := getIdentity(s, i, immutable.Some(action.RequestorIdentity))
+		ctx := db.SetContextIdentity(s.ctx, requestorIdentity)
+
+		deleteDocActorRelationshipResult, err := node.DeleteDocActorRelationship(
+			ctx,
+			collectionName,
+			docID,
+			action.Relation,
+			targetActor,
+		)
+
+		expectedErrorRaised := AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+		assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+
+		if !expectedErrorRaised {
+			require.Equal(s.t, action.ExpectedError, "")
+			require.Equal(s.t, action.ExpectedRecordFound, deleteDocActorRelationshipResult.RecordFound)
+		}
+
+		// The relationship should only be deleted from a SourceHub chain once - there is no need to loop through
+		// the nodes.
+		if acpType == SourceHubACPType {
+			break
+		}
+	}
+}
+
+// getDocActorRelationshipArgs returns the collection name, docID and target actor of a
+// document actor relationship action.
+func getDocActorRelationshipArgs(
+	s *state,
+	nodeIndex int,
+	collectionID int,
+	docIndex int,
+	targetIdentity int,
+) (string, string, string) {
+	collectionName := s.collections[nodeIndex][collectionID].Name().Value()
+
+	var docID string
+	if collectionID < len(s.docIDs) && docIndex < len(s.docIDs[collectionID]) {
+		docID = s.docIDs[collectionID][docIndex].String()
+	}
+
+	targetActor := "*"
+	if targetIdentity != -1 {
+		targetActor = getIdentity(s, nodeIndex, immutable.Some(targetIdentity)).Value().DID
+	}
+
+	return collectionName, docID, targetActor
+}
+
 func setupSourceHub(s *state) ([]node.ACPOpt, error) {
 	var isACPTest bool
 	for _, a := range s.testCase.Actions {
@@ -410,7 +591,11 @@ func getIdentity(s *state, nodeIndex int, index immutable.Option[int]) immutable
 		copy(identities, nodeIdentities)
 		nodeIdentities = identities
 		s.identities[nodeIndex] = nodeIdentities
+	}
 
+	// Identities may be requested out of order, in which case the slots of the
+	// lower indexes will have been allocated but not yet generated.
+	if nodeIdentities[index.Value()].PrivateKey == nil {
 		var audience immutable.Option[string]
 		switch client := s.nodes[nodeIndex].(type) {
 		case *http.Wrapper:
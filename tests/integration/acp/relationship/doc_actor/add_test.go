@@ -0,0 +1,207 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_relationship_doc_actor
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestACP_OwnerGivesReaderAccessToAnotherActor_OtherActorCanRead(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, owner gives reader access to another actor, other actor can read",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			readUsersAction(2, []map[string]any{}),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			readUsersAction(2, userDocResult),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_OwnerGivesSameReaderAccessTwice_SecondIsNoop(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, owner gives the same reader access twice, second is a no-op",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: true,
+			},
+
+			readUsersAction(2, userDocResult),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_OwnerGivesReaderAccessToAllActors_AnyActorCanRead(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, owner gives reader access to all actors, any actor can read",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    -1,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			readUsersAction(2, userDocResult),
+
+			readUsersAction(3, userDocResult),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_NonOwnerGivesReaderAccessToAnotherActor_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, non-owner gives reader access to another actor, error",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 2,
+				TargetIdentity:    3,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedError:     "failed to add document actor relationship with acp",
+			},
+
+			readUsersAction(3, []map[string]any{}),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_ManagerGivesReaderAccessToAnotherActor_OtherActorCanRead(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, manager gives reader access to another actor, other actor can read",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "admin",
+				ExpectedExistence: false,
+			},
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 2,
+				TargetIdentity:    3,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			readUsersAction(3, userDocResult),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_ManagerGivesUnmanagedWriterAccessToAnotherActor_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, manager gives writer access it does not manage to another actor, error",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "admin",
+				ExpectedExistence: false,
+			},
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 2,
+				TargetIdentity:    3,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "writer",
+				ExpectedError:     "failed to add document actor relationship with acp",
+			},
+
+			readUsersAction(3, []map[string]any{}),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_OwnerGivesAccessToDocThatDoesNotExist_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, owner gives access to a document that does not exist, error",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             1,
+				Relation:          "reader",
+				ExpectedError:     "missing a required argument needed to add doc actor relationship",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_relationship_doc_actor
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestACP_OwnerRevokesReaderAccessFromAnotherActor_OtherActorCanNotRead(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, owner revokes reader access from another actor, other actor can not read",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			readUsersAction(2, userDocResult),
+
+			testUtils.DeleteDocActorRelationship{
+				RequestorIdentity:   1,
+				TargetIdentity:      2,
+				CollectionID:        0,
+				DocID:               0,
+				Relation:            "reader",
+				ExpectedRecordFound: true,
+			},
+
+			readUsersAction(2, []map[string]any{}),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_OwnerRevokesReaderAccessThatDoesNotExist_Noop(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, owner revokes reader access that does not exist, no-op",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.DeleteDocActorRelationship{
+				RequestorIdentity:   1,
+				TargetIdentity:      2,
+				CollectionID:        0,
+				DocID:               0,
+				Relation:            "reader",
+				ExpectedRecordFound: false,
+			},
+
+			readUsersAction(1, userDocResult),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_OwnerRevokesReaderAccessFromAllActors_OtherActorsCanNotRead(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, owner revokes reader access from all actors, other actors can not read",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    -1,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			readUsersAction(2, userDocResult),
+
+			testUtils.DeleteDocActorRelationship{
+				RequestorIdentity:   1,
+				TargetIdentity:      -1,
+				CollectionID:        0,
+				DocID:               0,
+				Relation:            "reader",
+				ExpectedRecordFound: true,
+			},
+
+			readUsersAction(2, []map[string]any{}),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_NonManagerRevokesReaderAccessFromAnotherActor_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test acp, non-manager revokes reader access from another actor, error",
+
+		Actions: []any{
+			getSetupUserActions(),
+
+			testUtils.AddDocActorRelationship{
+				RequestorIdentity: 1,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			testUtils.DeleteDocActorRelationship{
+				RequestorIdentity: 3,
+				TargetIdentity:    2,
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedError:     "failed to delete document actor relationship with acp",
+			},
+
+			readUsersAction(2, userDocResult),
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_relationship_doc_actor
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const userPolicy = `
+name: Test Policy
+
+description: A Policy
+
+actor:
+  name: actor
+
+resources:
+  users:
+    permissions:
+      read:
+        expr: owner + reader + writer
+
+      write:
+        expr: owner + writer
+
+    relations:
+      owner:
+        types:
+          - actor
+
+      reader:
+        types:
+          - actor
+
+      writer:
+        types:
+          - actor
+
+      admin:
+        manages:
+          - reader
+        types:
+          - actor
+`
+
+// getSetupUserActions adds the user policy and schema, and creates a single private
+// user document owned by identity 1.
+func getSetupUserActions() []any {
+	return []any{
+		testUtils.AddPolicy{
+			Identity:         immutable.Some(1),
+			Policy:           userPolicy,
+			ExpectedPolicyID: "4ac0ccc1fd3931ea8e48d6917d2951311f24c34a0080d90faa08b8c21d3cafa0",
+		},
+
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users @policy(
+					id: "4ac0ccc1fd3931ea8e48d6917d2951311f24c34a0080d90faa08b8c21d3cafa0",
+					resource: "users"
+				) {
+					name: String
+					age: Int
+				}
+			`,
+		},
+
+		testUtils.CreateDoc{
+			Identity:     immutable.Some(1),
+			CollectionID: 0,
+			Doc: `
+				{
+					"name": "Shahzad",
+					"age": 28
+				}
+			`,
+		},
+	}
+}
+
+// readUsersAction returns a request action that reads all users with the given
+// identity and expects the given results.
+func readUsersAction(identity int, results []map[string]any) testUtils.Request {
+	return testUtils.Request{
+		Identity: immutable.Some(identity),
+		Request: `
+			query {
+				Users {
+					name
+					age
+				}
+			}
+		`,
+		Results: map[string]any{
+			"Users": results,
+		},
+	}
+}
+
+var userDocResult = []map[string]any{
+	{
+		"name": "Shahzad",
+		"age":  int64(28),
+	},
+}
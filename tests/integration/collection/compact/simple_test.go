@@ -0,0 +1,334 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package compact
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCompactCollection_WithDepth_CollapsesOlderHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 23
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Depth: immutable.Some[uint64](2),
+				}),
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					CompactedDocs: 1,
+					RemovedBlocks: 3,
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(4),
+						},
+						{
+							"height": int64(3),
+						},
+						{
+							"height": int64(2),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "1") {
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(3),
+						},
+						{
+							"height": int64(2),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Fred",
+							"age":  int64(23),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_WithinDepth_NoOp(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Depth: immutable.Some[uint64](2),
+				}),
+				ExpectedResult: immutable.Some(client.CompactionResult{}),
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(2),
+						},
+						{
+							"height": int64(1),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_UpdateAfterCompaction_Succeeds(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Islam"
+				}`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Depth: immutable.Some[uint64](1),
+				}),
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Andy"
+				}`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Depth: immutable.Some[uint64](1),
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(4),
+						},
+						{
+							"height": int64(3),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Andy",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_WithAge_RetainsRecentHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Age: immutable.Some(time.Hour),
+				}),
+				ExpectedResult: immutable.Some(client.CompactionResult{}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_WithoutPolicy_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CompactCollection{
+				ExpectedError: "no retention policy given and the collection has none",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_WithZeroDepth_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Depth: immutable.Some[uint64](0),
+				}),
+				ExpectedError: "invalid retention policy",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
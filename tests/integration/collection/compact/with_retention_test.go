@@ -0,0 +1,140 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package compact
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCompactCollection_WithRetentionDirective_UsesCollectionPolicy(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @retention(depth: 1) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Islam"
+				}`,
+			},
+			testUtils.CompactCollection{
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					CompactedDocs: 1,
+					RemovedBlocks: 3,
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(3),
+						},
+						{
+							"height": int64(2),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_WithPolicyAndRetentionDirective_UsesGivenPolicy(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @retention(depth: 1) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Depth: immutable.Some[uint64](5),
+				}),
+				ExpectedResult: immutable.Some(client.CompactionResult{}),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_WithInvalidRetentionDirective_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @retention(depth: 0) {
+						name: String
+					}
+				`,
+				ExpectedError: "invalid retention policy",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_WithRetentionDirectiveInvalidAge_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @retention(age: "a week") {
+						name: String
+					}
+				`,
+				ExpectedError: "retention directive with invalid age property",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
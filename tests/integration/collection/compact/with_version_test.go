@@ -0,0 +1,137 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package compact
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCompactCollection_QueryRetainedVersion_ReplaysSnapshot(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 23
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Depth: immutable.Some[uint64](2),
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-0b2f15e5-bfe7-5cb7-8045-471318d7dbc3",
+						cid: "bafyreih3zigeerbvrxhax4sdlklgpoyb3f5n67mznfnj25ck2oi3e4czle"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(23),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactCollection_QueryCompactedVersion_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 23
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.CompactCollection{
+				Policy: immutable.Some(client.RetentionPolicy{
+					Depth: immutable.Some[uint64](2),
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-0b2f15e5-bfe7-5cb7-8045-471318d7dbc3",
+						cid: "bafyreiagejfakt6nowjwiokahkizlhrdatdsc62cfn3u6fg5yhbajelwl4"
+					) {
+						name
+						age
+					}
+				}`,
+				ExpectedError: "the requested version has been removed by a compaction",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package upsert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var schema = `
+	type Users {
+		name: String
+		age: Int
+	}
+`
+
+func TestUpsertWithFilter_NoMatch_CreatesDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test upsert users with filter that matches no document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: schema,
+			},
+			testUtils.UpsertWithFilter{
+				CollectionID: 0,
+				Filter:       `{name: {_eq: "John"}}`,
+				Doc:          `{"name": "John", "age": 21}`,
+				Updater:      `{"age": 22}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestUpsertWithFilter_SingleMatch_UpdatesDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test upsert users with filter that matches a single document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: schema,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc:          `{"name": "John", "age": 21}`,
+			},
+			testUtils.UpsertWithFilter{
+				CollectionID: 0,
+				Filter:       `{name: {_eq: "John"}}`,
+				Doc:          `{"name": "John", "age": 21}`,
+				Updater:      `{"age": 22}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(22),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestUpsertWithFilter_MultipleMatches_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test upsert users with filter that matches multiple documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: schema,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc:          `{"name": "John", "age": 21}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc:          `{"name": "Fred", "age": 21}`,
+			},
+			testUtils.UpsertWithFilter{
+				CollectionID:  0,
+				Filter:        `{age: {_eq: 21}}`,
+				Doc:           `{"name": "Shahzad", "age": 21}`,
+				Updater:       `{"age": 22}`,
+				ExpectedError: "cannot upsert multiple matching documents",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
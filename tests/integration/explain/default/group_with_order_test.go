@@ -80,6 +80,8 @@ func TestDefaultExplainRequestWithDescendingOrderOnParentGroupBy(t *testing.T) {
 									"fields":    []string{"name"},
 								},
 							},
+							"sortEliminated": false,
+							"strategy":       "externalSort",
 						},
 					},
 				},
@@ -135,6 +137,8 @@ func TestDefaultExplainRequestWithAscendingOrderOnParentGroupBy(t *testing.T) {
 									"fields":    []string{"name"},
 								},
 							},
+							"sortEliminated": false,
+							"strategy":       "externalSort",
 						},
 					},
 				},
@@ -202,6 +206,8 @@ func TestDefaultExplainRequestWithOrderOnParentGroupByAndOnInnerGroupSelection(t
 									"fields":    []string{"name"},
 								},
 							},
+							"sortEliminated": false,
+							"strategy":       "externalSort",
 						},
 					},
 				},
@@ -78,6 +78,8 @@ func TestDefaultExplainRequestWithOrderFieldOnRelatedChild(t *testing.T) {
 									},
 								},
 							},
+							"sortEliminated": false,
+							"strategy":       "externalSort",
 						},
 					},
 				},
@@ -137,6 +139,8 @@ func TestDefaultExplainRequestWithOrderFieldOnParentAndRelatedChild(t *testing.T
 									},
 								},
 							},
+							"sortEliminated": false,
+							"strategy":       "externalSort",
 						},
 					},
 					{
@@ -152,6 +156,8 @@ func TestDefaultExplainRequestWithOrderFieldOnParentAndRelatedChild(t *testing.T
 									},
 								},
 							},
+							"sortEliminated": false,
+							"strategy":       "externalSort",
 						},
 					},
 				},
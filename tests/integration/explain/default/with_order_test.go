@@ -65,6 +65,8 @@ func TestDefaultExplainRequestWithAscendingOrderOnParent(t *testing.T) {
 									},
 								},
 							},
+							"sortEliminated": false,
+							"strategy":       "externalSort",
 						},
 					},
 				},
@@ -113,6 +115,50 @@ func TestDefaultExplainRequestWithMultiOrderFieldsOnParent(t *testing.T) {
 									},
 								},
 							},
+							"sortEliminated": false,
+							"strategy":       "externalSort",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
+
+func TestDefaultExplainRequestWithOrderAndLimitOnParent(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (default) request with order and limit on parent.",
+
+		Actions: []any{
+			explainUtils.SchemaForExplainTests,
+
+			testUtils.ExplainRequest{
+
+				Request: `query @explain {
+					Author(order: {age: DESC}, limit: 2, offset: 1) {
+						name
+						age
+					}
+				}`,
+
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "orderNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: dataMap{
+							"orderings": []dataMap{
+								{
+									"direction": "DESC",
+									"fields": []string{
+										"age",
+									},
+								},
+							},
+							"sortEliminated": false,
+							"strategy":       "topKSort",
 						},
 					},
 				},
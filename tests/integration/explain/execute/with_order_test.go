@@ -46,7 +46,9 @@ func TestExecuteExplainRequestWithOrderFieldOnParent(t *testing.T) {
 							{
 								"selectTopNode": dataMap{
 									"orderNode": dataMap{
-										"iterations": uint64(3),
+										"iterations":  uint64(3),
+										"strategy":    "externalSort",
+										"spilledRuns": uint64(0),
 										"selectNode": dataMap{
 											"filterMatches": uint64(2),
 											"iterations":    uint64(3),
@@ -132,7 +134,9 @@ func TestExecuteExplainRequestWithMultiOrderFieldsOnParent(t *testing.T) {
 							{
 								"selectTopNode": dataMap{
 									"orderNode": dataMap{
-										"iterations": uint64(5),
+										"iterations":  uint64(5),
+										"strategy":    "externalSort",
+										"spilledRuns": uint64(0),
 										"selectNode": dataMap{
 											"filterMatches": uint64(4),
 											"iterations":    uint64(5),
@@ -251,7 +255,9 @@ func TestExecuteExplainRequestWithOrderFieldOnBothParentAndChild(t *testing.T) {
 							{
 								"selectTopNode": dataMap{
 									"orderNode": dataMap{
-										"iterations": uint64(3),
+										"iterations":  uint64(3),
+										"strategy":    "externalSort",
+										"spilledRuns": uint64(0),
 										"selectNode": dataMap{
 											"iterations":    uint64(3),
 											"filterMatches": uint64(2),
@@ -318,3 +324,61 @@ func TestExecuteExplainRequestWhereParentFieldIsOrderedByChildField(t *testing.T
 
 	explainUtils.ExecuteTestCase(t, test)
 }
+
+func TestExecuteExplainRequestWithOrderAndLimitOnParent(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (execute) with order and limit on parent.",
+
+		Actions: []any{
+			explainUtils.SchemaForExplainTests,
+
+			create2AddressDocuments(),
+			create2AuthorContactDocuments(),
+			create2AuthorDocuments(),
+
+			testUtils.ExplainRequest{
+				Request: `query @explain(type: execute) {
+					Author(order: {age: ASC}, limit: 1) {
+						name
+						age
+					}
+				}`,
+
+				ExpectedFullGraph: dataMap{
+					"explain": dataMap{
+						"executionSuccess": true,
+						"sizeOfResult":     1,
+						"planExecutions":   uint64(2),
+						"operationNode": []dataMap{
+							{
+								"selectTopNode": dataMap{
+									"limitNode": dataMap{
+										"iterations": uint64(2),
+										"orderNode": dataMap{
+											"iterations":  uint64(1),
+											"strategy":    "topKSort",
+											"spilledRuns": uint64(0),
+											"selectNode": dataMap{
+												"filterMatches": uint64(2),
+												"iterations":    uint64(3),
+												"scanNode": dataMap{
+													"iterations":   uint64(3),
+													"docFetches":   uint64(2),
+													"fieldFetches": uint64(4),
+													"indexFetches": uint64(0),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
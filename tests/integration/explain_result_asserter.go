@@ -73,6 +73,12 @@ func (a *ExplainResultAsserter) Assert(t testing.TB, result map[string]any) {
 	require.Len(t, operationNode, 1)
 	selectTopNode, ok := operationNode[0]["selectTopNode"].(dataMap)
 	require.True(t, ok, "Expected selectTopNode")
+	// the select node can be wrapped by limit and order nodes
+	for _, nodeName := range []string{"limitNode", "orderNode"} {
+		if node, isNode := selectTopNode[nodeName].(dataMap); isNode {
+			selectTopNode = node
+		}
+	}
 	selectNode, ok := selectTopNode["selectNode"].(dataMap)
 	require.True(t, ok, "Expected selectNode")
 
@@ -0,0 +1,378 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func getProductDocs() []testUtils.CreateDoc {
+	return []testUtils.CreateDoc{
+		{
+			Doc: `{
+				"name": "Trail",
+				"description": "Shoes for runs"
+			}`,
+		},
+		{
+			Doc: `{
+				"name": "Umbrella",
+				"description": "Running in the rain"
+			}`,
+		},
+		{
+			Doc: `{
+				"name": "Sprint",
+				"description": "Blue shoes, running fast, running shoes"
+			}`,
+		},
+	}
+}
+
+func TestQueryWithFullTextIndex_WithSearchFilter_ShouldFetchMatchingDocs(t *testing.T) {
+	req := `query {
+		Product(filter: {description: {_search: "running shoes"}}, order: {_score: DESC}) {
+			name
+		}
+	}`
+	actions := []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Product {
+					name: String
+					description: String
+				}`,
+		},
+		testUtils.CreateIndex{
+			FieldName: "description",
+			Type:      client.IndexTypeFullText,
+		},
+	}
+	for _, doc := range getProductDocs() {
+		actions = append(actions, doc)
+	}
+	actions = append(actions,
+		testUtils.Request{
+			Request: req,
+			Results: map[string]any{
+				"Product": []map[string]any{
+					{"name": "Trail"},
+					{"name": "Sprint"},
+				},
+			},
+		},
+		testUtils.Request{
+			Request: makeExplainQuery(`query {
+				Product(filter: {description: {_search: "running shoes"}}) {
+					name
+				}
+			}`),
+			Asserter: testUtils.NewExplainAsserter().WithIndexFetches(5),
+		},
+	)
+
+	testUtils.ExecuteTestCase(t, testUtils.TestCase{
+		Description: "Test full-text index with _search filter",
+		Actions:     actions,
+	})
+}
+
+func TestQueryWithFullTextIndex_WithScore_ShouldReturnScore(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test full-text index returns the score of the documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						name: String
+						description: String
+					}`,
+			},
+			testUtils.CreateIndex{
+				FieldName: "description",
+				Type:      client.IndexTypeFullText,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Trail",
+					"description": "Shoes"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Umbrella",
+					"description": "Umbrella"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "shoe"}}) {
+						name
+						_score
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name":   "Trail",
+							"_score": float64(1),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Product {
+						name
+						_score
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name":   "Umbrella",
+							"_score": nil,
+						},
+						{
+							"name":   "Trail",
+							"_score": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_IfCreatedAfterDocs_ShouldIndexExistingDocs(t *testing.T) {
+	actions := []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Product {
+					name: String
+					description: String
+				}`,
+		},
+	}
+	for _, doc := range getProductDocs() {
+		actions = append(actions, doc)
+	}
+	actions = append(actions,
+		testUtils.CreateIndex{
+			FieldName: "description",
+			Type:      client.IndexTypeFullText,
+		},
+		testUtils.Request{
+			Request: `query {
+				Product(filter: {description: {_search: "rain"}}) {
+					name
+				}
+			}`,
+			Results: map[string]any{
+				"Product": []map[string]any{
+					{"name": "Umbrella"},
+				},
+			},
+		},
+	)
+
+	testUtils.ExecuteTestCase(t, testUtils.TestCase{
+		Description: "Test full-text index created after the documents",
+		Actions:     actions,
+	})
+}
+
+func TestQueryWithFullTextIndex_WithAnalyzerWithoutStemming_ShouldMatchExactWords(t *testing.T) {
+	actions := []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Product {
+					name: String
+					description: String
+				}`,
+		},
+		testUtils.CreateIndex{
+			FieldName: "description",
+			Type:      client.IndexTypeFullText,
+			Analyzer:  []client.IndexAnalyzerFilter{client.IndexAnalyzerLowercase},
+		},
+	}
+	for _, doc := range getProductDocs() {
+		actions = append(actions, doc)
+	}
+	actions = append(actions,
+		testUtils.Request{
+			Request: `query {
+				Product(filter: {description: {_search: "runs"}}) {
+					name
+				}
+			}`,
+			Results: map[string]any{
+				"Product": []map[string]any{
+					{"name": "Trail"},
+				},
+			},
+		},
+	)
+
+	testUtils.ExecuteTestCase(t, testUtils.TestCase{
+		Description: "Test full-text index with an analyzer without stemming",
+		Actions:     actions,
+	})
+}
+
+func TestQueryWithFullTextIndex_AfterUpdateAndDelete_ShouldFetchCurrentDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test full-text index is maintained on document update and delete",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						name: String
+						description: String
+					}`,
+			},
+			testUtils.CreateIndex{
+				FieldName: "description",
+				Type:      client.IndexTypeFullText,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Trail",
+					"description": "Shoes for runs"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Umbrella",
+					"description": "Running in the rain"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"description": "Boots for hikes"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 1,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "run"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "hiking boots"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Trail"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithoutFullTextIndex_WithSearchFilter_ShouldFetchMatchingDocs(t *testing.T) {
+	actions := []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Product {
+					name: String @index
+					description: String
+				}`,
+		},
+	}
+	for _, doc := range getProductDocs() {
+		actions = append(actions, doc)
+	}
+	actions = append(actions,
+		testUtils.Request{
+			Request: `query {
+				Product(filter: {description: {_search: "RUNNING shoes"}}) {
+					name
+				}
+			}`,
+			Results: map[string]any{
+				"Product": []map[string]any{
+					{"name": "Sprint"},
+					{"name": "Trail"},
+				},
+			},
+		},
+	)
+
+	testUtils.ExecuteTestCase(t, testUtils.TestCase{
+		Description: "Test _search filter without a full-text index",
+		Actions:     actions,
+	})
+}
+
+func TestCreateFullTextIndex_OnNonStringField_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test full-text index can not be created on a non String field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						name: String
+						price: Int
+					}`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "price",
+				Type:          client.IndexTypeFullText,
+				ExpectedError: "full-text index field must be a String",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateFullTextIndex_IfUnique_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test full-text index can not be unique",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						description: String
+					}`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "description",
+				Type:          client.IndexTypeFullText,
+				Unique:        true,
+				ExpectedError: "full-text index can not be unique",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithIndex_WithMinAndMax_ShouldFetchOnlyIndexEndpoints(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test min and max of an indexed field are fetched from the ends of the index",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: `query {
+					_min(User: {field: age})
+					_max(User: {field: age})
+				}`,
+				Results: map[string]any{
+					"_min": int64(20),
+					"_max": int64(55),
+				},
+			},
+			testUtils.ExplainRequest{
+				Request: makeExplainQuery(`query {
+					_min(User: {field: age})
+				}`),
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "scanNode",
+						IncludeChildNodes: true,
+						ExpectedAttributes: map[string]any{
+							"iterations":   uint64(1),
+							"docFetches":   uint64(1),
+							"fieldFetches": uint64(0),
+							"indexFetches": uint64(1),
+						},
+					},
+				},
+			},
+			testUtils.ExplainRequest{
+				Request: makeExplainQuery(`query {
+					_max(User: {field: age})
+				}`),
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "scanNode",
+						IncludeChildNodes: true,
+						ExpectedAttributes: map[string]any{
+							"iterations":   uint64(1),
+							"docFetches":   uint64(1),
+							"fieldFetches": uint64(0),
+							"indexFetches": uint64(1),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
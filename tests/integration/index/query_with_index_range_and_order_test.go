@@ -0,0 +1,299 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithIndex_WithGreaterThanAndLessThanFilter_ShouldFetchOnlyRange(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_gt: 28, _lt: 42}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test index filtering with _gt and _lt filter on the same field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "John"},
+						{"name": "Islam"},
+						{"name": "Andy"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(3),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_IfDescendingWithGreaterOrEqualFilter_ShouldFetchOnlyRange(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_ge: 42}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test descending index filtering with _ge filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index(direction: DESC)
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "Chris"},
+						{"name": "Keenan"},
+						{"name": "Roy"},
+						{"name": "Addo"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(4),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithOrderAndLimit_ShouldFetchOnlyLimitFromIndex(t *testing.T) {
+	req := `query {
+		User(order: {age: ASC}, limit: 3) {
+			name
+			age
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test ordering by an indexed field with a limit fetches only the limit from the index",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "Shahzad", "age": int64(20)},
+						{"name": "Bruno", "age": int64(23)},
+						{"name": "Fred", "age": int64(28)},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(3),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithDescendingOrderOnDescendingIndex_ShouldFetchInOrder(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_lt: 44}}, order: {age: DESC}, limit: 2) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test descending order and range filter served by a descending index",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index(direction: DESC)
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "Addo"},
+						{"name": "Andy"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithDescendingOrderOnAscendingIndex_ShouldFetchInOrder(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_gt: 40}}, order: {age: DESC}, limit: 2) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test descending order served by iterating an ascending index in reverse",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "Chris"},
+						{"name": "Keenan"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithRangeFilterAndOrder_ShouldExplainRangeAndEliminatedSort(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test explain shows the scanned index range and the eliminated sort",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.ExplainRequest{
+				Request: `query @explain {
+					User(filter: {age: {_gt: 30, _le: 50}}, order: {age: ASC}, limit: 2) {
+						name
+					}
+				}`,
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "orderNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: map[string]any{
+							"orderings": []map[string]any{
+								{
+									"direction": "ASC",
+									"fields":    []string{"age"},
+								},
+							},
+							"sortEliminated": true,
+							"strategy":       nil,
+						},
+					},
+					{
+						TargetNodeName:    "scanNode",
+						IncludeChildNodes: true,
+						ExpectedAttributes: map[string]any{
+							"collectionID":   "1",
+							"collectionName": "User",
+							"filter":         nil,
+							"spans": []map[string]any{
+								{
+									"start": "/1",
+									"end":   "/2",
+								},
+							},
+							"index": map[string]any{
+								"name": "User_age_ASC",
+								"range": map[string]any{
+									"age": map[string]any{
+										"_gt": int32(30),
+										"_le": int32(50),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			testUtils.ExplainRequest{
+				Request: `query @explain {
+					User(filter: {age: {_gt: 30}}, order: {age: DESC}) {
+						name
+					}
+				}`,
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "orderNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: map[string]any{
+							"orderings": []map[string]any{
+								{
+									"direction": "DESC",
+									"fields":    []string{"age"},
+								},
+							},
+							"sortEliminated": true,
+							"strategy":       nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package purge
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationPurge_WithID_RemovesDocAndHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					purge_User(docID: "bae-774fbeea-813b-52c8-82b0-d08515a075d7") {
+						_docID
+						_deleted
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"purge_User": []map[string]any{
+						{
+							"_docID":   "bae-774fbeea-813b-52c8-82b0-d08515a075d7",
+							"_deleted": true,
+							"name":     "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User(showDeleted: true) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"User": []map[string]any{},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(docID: "bae-774fbeea-813b-52c8-82b0-d08515a075d7") {
+						height
+						delta
+						links {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(1),
+							"delta":  nil,
+							"links":  []map[string]any{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationPurge_WithUnknownID_NoChange(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					purge_User(docID: "bae-22dacd35-4560-583a-9a80-8edbf28aa85c") {
+						_docID
+					}
+				}`,
+				Results: map[string]any{
+					"purge_User": []map[string]any{},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
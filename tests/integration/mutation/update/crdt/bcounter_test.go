@@ -0,0 +1,133 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestBoundedCounterUpdate_WithDecrementWithinAllowance_ShouldDecrement(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Decrement of a bounded counter within the local allowance",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						name: String
+						stock: Int @crdt(type: "bcounter")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shoes",
+					"stock": 10
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"stock": -4
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Products {
+						stock
+						_allowance
+					}
+				}`,
+				Results: map[string]any{
+					"Products": []map[string]any{
+						{
+							"stock":      int64(6),
+							"_allowance": `{"stock":6}`,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestBoundedCounterUpdate_WithDecrementBeyondAllowance_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Decrement of a bounded counter beyond the local allowance",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						name: String
+						stock: Int @crdt(type: "bcounter")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shoes",
+					"stock": 10
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"stock": -11
+				}`,
+				ExpectedError: "decrement exceeds the allowance of the local replica",
+			},
+			testUtils.Request{
+				Request: `query {
+					Products {
+						stock
+					}
+				}`,
+				Results: map[string]any{
+					"Products": []map[string]any{
+						{
+							"stock": int64(10),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestBoundedCounterCreate_WithNegativeValue_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Creation of a bounded counter with a negative value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						name: String
+						stock: Int @crdt(type: "bcounter")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shoes",
+					"stock": -1
+				}`,
+				ExpectedError: "decrement exceeds the allowance of the local replica",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
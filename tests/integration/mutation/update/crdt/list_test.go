@@ -0,0 +1,137 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestListUpdate_WithReorderedValues_ShouldUpdateValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						scores: [Int] @crdt(type: "list")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"scores": [1, 2, 3]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"scores": [3, null, 1, 1]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						scores
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"scores": []immutable.Option[int64]{
+								immutable.Some[int64](3),
+								immutable.None[int64](),
+								immutable.Some[int64](1),
+								immutable.Some[int64](1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestListUpdate_WithAppendAndRemoveOperators_ShouldUpdateValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						scores: [Int!] @crdt(type: "list")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"scores": [1, 2, 1, 3]
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(input: {_append: {scores: [4, 1]}, _remove: {scores: [1]}}) {
+						scores
+					}
+				}`,
+				Results: map[string]any{
+					"update_Users": []map[string]any{
+						{
+							"scores": []int64{2, 3, 4, 1},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestListUpdate_WithAppendOperatorOnNonArrayField_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						scores: [Int!] @crdt(type: "list")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"scores": [1]
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(input: {_append: {name: "Smith"}}) {
+						scores
+					}
+				}`,
+				ExpectedError: `Argument "input" has invalid value {_append: {name: "Smith"}}`,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
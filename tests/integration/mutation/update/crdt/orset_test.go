@@ -0,0 +1,124 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestORSetUpdate_WithDuplicateValues_ShouldStoreValuesOnce(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: "orset")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"tags": ["a"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"tags": ["b", "a", "b"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						tags
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"tags": []string{"a", "b"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestORSetUpdate_WithAppendAndRemoveOperators_ShouldUpdateValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: "orset")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"tags": ["a", "b"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(input: {_append: {tags: ["c", "a"]}, _remove: {tags: ["b"]}}) {
+						tags
+					}
+				}`,
+				Results: map[string]any{
+					"update_Users": []map[string]any{
+						{
+							"tags": []string{"a", "c"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestORSetCreate_WithAppendOperator_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: "orset")
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(input: {name: "John", _append: {tags: ["a"]}}) {
+						tags
+					}
+				}`,
+				ExpectedError: "In field \"_append\": Unknown field.",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
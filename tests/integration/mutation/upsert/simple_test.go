@@ -0,0 +1,325 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package upsert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpsertSimple_WithNoFilterMatch_CreatesNewDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple upsert mutation with no filter match",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"age": 40
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_Users(
+						filter: {name: {_eq: "Alice"}},
+						create: {name: "Alice", age: 40},
+						update: {age: 50}
+					) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"upsert_Users": []map[string]any{
+						{
+							"name": "Alice",
+							"age":  int64(40),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {name: ASC}) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Alice",
+							"age":  int64(40),
+						},
+						{
+							"name": "Bob",
+							"age":  int64(40),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpsertSimple_WithFilterMatch_UpdatesDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple upsert mutation with filter match",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"age": 40
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"age": 30
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_Users(
+						filter: {name: {_eq: "Bob"}},
+						create: {name: "Bob", age: 40},
+						update: {age: 50}
+					) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"upsert_Users": []map[string]any{
+						{
+							"name": "Bob",
+							"age":  int64(50),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {name: ASC}) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Alice",
+							"age":  int64(30),
+						},
+						{
+							"name": "Bob",
+							"age":  int64(50),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpsertSimple_WithMultipleFilterMatches_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple upsert mutation with multiple filter matches",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"age": 40
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"age": 40
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_Users(
+						filter: {age: {_eq: 40}},
+						create: {name: "Eve", age: 40},
+						update: {age: 50}
+					) {
+						name
+						age
+					}
+				}`,
+				ExpectedError: "cannot upsert multiple matching documents",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {name: ASC}) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Alice",
+							"age":  int64(40),
+						},
+						{
+							"name": "Bob",
+							"age":  int64(40),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpsertSimple_WithoutFilter_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple upsert mutation without filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_Users(
+						create: {name: "Alice", age: 40},
+						update: {age: 50}
+					) {
+						name
+					}
+				}`,
+				ExpectedError: `Field "upsert_Users" argument "filter" of type "UsersFilterArg!" is required but not provided.`,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpsertSimple_WithUpdateChangingFilteredField_ReturnsUpdatedDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple upsert mutation with update changing the filtered field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"age": 40
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_Users(
+						filter: {name: {_eq: "Bob"}},
+						create: {name: "Bob", age: 40},
+						update: {name: "Robert"}
+					) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"upsert_Users": []map[string]any{
+						{
+							"name": "Robert",
+							"age":  int64(40),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpsertSimple_WithCreateNotMatchingFilter_ReturnsCreatedDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple upsert mutation with create input not matching the filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_Users(
+						filter: {name: {_eq: "Bob"}},
+						create: {name: "Alice", age: 40},
+						update: {age: 50}
+					) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"upsert_Users": []map[string]any{
+						{
+							"name": "Alice",
+							"age":  int64(40),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package peer_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2PUpdate_WithListConcurrentInserts_ShouldMergeChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: "list")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"name": "John",
+					"tags": ["a", "b"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// Update John on each node while the nodes are not connected
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"tags": ["a", "x", "b"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"tags": ["a", "b", "y"]
+				}`,
+			},
+			testUtils.SyncCollections{
+				NodeID:       1,
+				SourceNodeID: 0,
+			},
+			testUtils.SyncCollections{
+				NodeID:       0,
+				SourceNodeID: 1,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						tags
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"tags": []string{"a", "x", "b", "y"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package sync_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2PSync_WithCreate_PullsDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.SyncCollections{
+				NodeID:        1,
+				SourceNodeID:  0,
+				CollectionIDs: []int{0},
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users {
+						Name
+						Age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+							"Age":  int64(21),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PSync_WithCreateAndNoCollections_PullsAllDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+					type Books {
+						Title: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID:       immutable.Some(0),
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				NodeID:       immutable.Some(0),
+				CollectionID: 1,
+				Doc: `{
+					"Title": "Painted House"
+				}`,
+			},
+			testUtils.SyncCollections{
+				NodeID:       1,
+				SourceNodeID: 0,
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users {
+						Name
+					}
+					Books {
+						Title
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+						},
+					},
+					"Books": []map[string]any{
+						{
+							"Title": "Painted House",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PSync_WithCreateInOtherCollection_DoesNotPullDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+					type Books {
+						Title: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID:       immutable.Some(0),
+				CollectionID: 1,
+				Doc: `{
+					"Title": "Painted House"
+				}`,
+			},
+			testUtils.SyncCollections{
+				NodeID:        1,
+				SourceNodeID:  0,
+				CollectionIDs: []int{0},
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Books {
+						Title
+					}
+				}`,
+				Results: map[string]any{
+					"Books": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PSync_FromSelf_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SyncCollections{
+				NodeID:        0,
+				SourceNodeID:  0,
+				CollectionIDs: []int{0},
+				ExpectedError: "can't sync collections from ourselves",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PSync_WithUnknownCollection_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				NodeID: immutable.Some(0),
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaUpdate{
+				NodeID: immutable.Some(1),
+				Schema: `
+					type Users {
+						Name: String
+					}
+					type Books {
+						Title: String
+					}
+				`,
+			},
+			testUtils.SyncCollections{
+				NodeID:        1,
+				SourceNodeID:  0,
+				CollectionIDs: []int{1},
+				ExpectedError: "collection not found",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package peer_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2PWithMultipleDocumentsSinglePurge(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"Name": "John",
+					"Age": 43
+				}`,
+			},
+			testUtils.CreateDoc{
+				// Create Andy on all nodes
+				Doc: `{
+					"Name": "Andy",
+					"Age": 74
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.PurgeDoc{
+				NodeID: immutable.Some(0),
+				DocID:  0,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Users(showDeleted: true) {
+						_deleted
+						Name
+						Age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"_deleted": false,
+							"Name":     "Andy",
+							"Age":      int64(74),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(docID: "bae-018d697a-4bf4-5023-a49b-fd8e8cf6ecce") {
+						height
+						links {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(1),
+							"links":  []map[string]any{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PWithSingleDocumentPurgeThenUpdateFromOtherPeer_UpdateIgnored(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 43
+				}`,
+			},
+			testUtils.PurgeDoc{
+				NodeID: immutable.Some(0),
+				DocID:  0,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				DocID:  0,
+				Doc: `{
+					"Age": 60
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				DocID:  0,
+				Doc: `{
+					"Age": 61
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				NodeID: immutable.Some(0),
+				Request: `query {
+					Users(showDeleted: true) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
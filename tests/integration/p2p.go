@@ -11,11 +11,13 @@
 package tests
 
 import (
+	"reflect"
 	"time"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/net"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/sourcenetwork/corelog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -70,6 +72,31 @@ type DeleteReplicator struct {
 	TargetNodeID int
 }
 
+// GetAllReplicators gets the replicators of the given node and compares them against the
+// expected results.
+//
+// The replicator status is updated asynchronously, so the action will wait for the replicators
+// to match the expected results before failing.
+type GetAllReplicators struct {
+	// NodeID is the node ID (index) of the node in which to get the replicators for.
+	NodeID int
+
+	// ExpectedReplicators are the replicators expected.
+	ExpectedReplicators []ExpectedReplicator
+}
+
+// ExpectedReplicator describes a replicator expected by a [GetAllReplicators] action.
+type ExpectedReplicator struct {
+	// TargetNodeID is the node ID (index) of the node to which data is replicated.
+	TargetNodeID int
+
+	// Status is the expected status of the replicator.
+	Status client.ReplicatorStatus
+
+	// PendingCount is the expected number of documents waiting to be pushed to the replicator.
+	PendingCount int
+}
+
 const (
 	// NonExistentCollectionID can be used to represent a non-existent collection ID, it will be substituted
 	// for a non-existent collection ID when used in actions that support this.
@@ -128,6 +155,29 @@ type GetAllP2PCollections struct {
 	ExpectedCollectionIDs []int
 }
 
+// SyncCollections pulls the documents of the given collections from another node and
+// merges them into the given node.
+//
+// The action only completes once the pulled documents have been merged.
+type SyncCollections struct {
+	// NodeID is the node ID (index) of the node pulling the documents.
+	NodeID int
+
+	// SourceNodeID is the node ID (index) of the node to pull the documents from.
+	SourceNodeID int
+
+	// CollectionIDs are the collection IDs (indexes) of the collections to sync.
+	//
+	// If empty, all collections will be synced.
+	CollectionIDs []int
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
 // WaitForSync is an action that instructs the test framework to wait for all document synchronization
 // to complete before progressing.
 //
@@ -156,6 +206,10 @@ func connectPeers(
 	s.nodeP2P[cfg.SourceNodeID].connections[cfg.TargetNodeID] = struct{}{}
 	s.nodeP2P[cfg.TargetNodeID].connections[cfg.SourceNodeID] = struct{}{}
 
+	// newly connected nodes pull the documents of their subscribed collections from each other
+	expectPulledDocHeads(s, cfg.SourceNodeID, cfg.TargetNodeID)
+	expectPulledDocHeads(s, cfg.TargetNodeID, cfg.SourceNodeID)
+
 	// Bootstrap triggers a bunch of async stuff for which we have no good way of waiting on.  It must be
 	// allowed to complete before documentation begins or it will not even try and sync it. So for now, we
 	// sleep a little.
@@ -200,6 +254,38 @@ func deleteReplicator(
 	waitForReplicatorDeleteEvent(s, cfg)
 }
 
+// getAllReplicators gets all the replicators of the given node and compares them against the
+// given expected results.
+//
+// Any errors generated during this process will result in a test failure.
+func getAllReplicators(
+	s *state,
+	action GetAllReplicators,
+) {
+	expected := make(map[peer.ID]ExpectedReplicator, len(action.ExpectedReplicators))
+	for _, rep := range action.ExpectedReplicators {
+		expected[s.nodes[rep.TargetNodeID].PeerInfo().ID] = rep
+	}
+
+	n := s.nodes[action.NodeID]
+	actual := make(map[peer.ID]ExpectedReplicator)
+	require.Eventually(s.t, func() bool {
+		reps, err := n.GetAllReplicators(s.ctx)
+		require.NoError(s.t, err)
+
+		clear(actual)
+		for _, rep := range reps {
+			exp := expected[rep.Info.ID]
+			actual[rep.Info.ID] = ExpectedReplicator{
+				TargetNodeID: exp.TargetNodeID,
+				Status:       rep.Status,
+				PendingCount: rep.PendingCount,
+			}
+		}
+		return reflect.DeepEqual(expected, actual)
+	}, 10*eventTimeout, 100*time.Millisecond, "replicators do not match: expected %v, actual %v", expected, actual)
+}
+
 // subscribeToCollection sets up a collection subscription on the given node/collection.
 //
 // Any errors generated during this process will result in a test failure.
@@ -289,6 +375,44 @@ func getAllP2PCollections(
 	assert.Equal(s.t, expectedCollections, cols)
 }
 
+// syncCollections pulls the documents of the given collections from the source node.
+//
+// Any errors generated during this process will result in a test failure.
+func syncCollections(
+	s *state,
+	action SyncCollections,
+) {
+	n := s.nodes[action.NodeID]
+	sourceNode := s.nodes[action.SourceNodeID]
+
+	collectionNames := []string{}
+	for _, collectionIndex := range action.CollectionIDs {
+		col := s.collections[action.NodeID][collectionIndex]
+		collectionNames = append(collectionNames, col.Name().Value())
+	}
+
+	err := n.SyncCollections(s.ctx, sourceNode.PeerInfo(), collectionNames)
+
+	expectedErrorRaised := AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+}
+
+// expectPulledDocHeads updates the expected document heads of the given node with the heads
+// of the documents, within its subscribed collections, that exist on the source node.
+func expectPulledDocHeads(s *state, nodeID int, sourceNodeID int) {
+	for collectionIndex := range s.nodeP2P[nodeID].peerCollections {
+		if collectionIndex >= len(s.docIDs) {
+			continue
+		}
+		for _, docID := range s.docIDs[collectionIndex] {
+			head, ok := s.nodeP2P[sourceNodeID].actualDocHeads[docID.String()]
+			if ok {
+				s.nodeP2P[nodeID].expectedDocHeads[docID.String()] = head
+			}
+		}
+	}
+}
+
 // reconnectPeers makes sure that all peers are connected after a node restart action.
 func reconnectPeers(s *state) {
 	for i, n := range s.nodeP2P {
@@ -0,0 +1,113 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package commits
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryCommitsWithSignature_WithoutIdentity_ReturnsNullSignature(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with signature, document created without identity",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+						"name":	"John",
+						"age":	21
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(fieldId: "C") {
+							fieldId
+							signature {
+								type
+								identity
+								value
+							}
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"fieldId":   "C",
+							"signature": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithSignature_WithIdentity_ReturnsSignatureOnCompositeCommit(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with signature, document created with identity",
+		// Only the Go client holds the private key of the identity, the http and cli
+		// clients only send a bearer token so the blocks cannot be signed.
+		SupportedClientTypes: immutable.Some([]testUtils.ClientType{
+			testUtils.GoClientType,
+		}),
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Identity:     immutable.Some(1),
+				CollectionID: 0,
+				Doc: `{
+						"name":	"John",
+						"age":	21
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits {
+							fieldId
+							signature {
+								type
+								identity
+								value
+							}
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"fieldId":   "1",
+							"signature": nil,
+						},
+						{
+							"fieldId":   "2",
+							"signature": nil,
+						},
+						{
+							"fieldId": "C",
+							"signature": map[string]any{
+								"type":     "ES256K",
+								"identity": "did:key:z7r8oqkfiiVe4bHLYBjHZTJqGiUqCuMo6q7qiNGNYogBb8CZhDZ6RmFocZYYrsxCLew1E9bdWJ5tC7bVCGosfQDrSy7nf",
+								"value":    "304402205d989a798629ab562d3551d210eb776bff525edfeb113ecae60752b59788321e02202f2546c1a67cba81648a088eac0ea732155edd23ebbc81b343e84f29d6be4e13",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,258 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithEqOpOnJSONPath_ShouldFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						custom: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "John",
+					"custom": "{\"tree\": \"maple\", \"age\": 250}",
+				},
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "Andy",
+					"custom": "{\"tree\": \"oak\", \"age\": 450}",
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {custom: {tree: {_eq: "oak"}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "Andy"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithGtOpOnJSONPath_ShouldFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						custom: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "John",
+					"custom": "{\"tree\": \"maple\", \"age\": 250}",
+				},
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "Andy",
+					"custom": "{\"tree\": \"oak\", \"age\": 450}",
+				},
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "Shahzad",
+					"custom": "{\"tree\": \"pine\"}",
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {custom: {age: {_gt: 300}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "Andy"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithLtOpOnStringJSONPath_ShouldFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						custom: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "John",
+					"custom": "{\"tree\": \"maple\"}",
+				},
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "Andy",
+					"custom": "{\"tree\": \"oak\"}",
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {custom: {tree: {_lt: "n"}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "John"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithNestedJSONPath_ShouldFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						custom: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "John",
+					"custom": "{\"tree\": {\"name\": \"maple\", \"leaves\": [\"green\"]}}",
+				},
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "Andy",
+					"custom": "{\"tree\": {\"name\": \"oak\"}}",
+				},
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "Shahzad",
+					"custom": "{\"tree\": \"oak\"}",
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {custom: {tree: {name: {_in: ["oak", "pine"]}}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "Andy"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithJSONPathAndOperatorOnSameLevel_ShouldFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						custom: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name":   "John",
+					"custom": "{\"tree\": \"maple\", \"age\": 250}",
+				},
+			},
+			testUtils.CreateDoc{
+				DocMap: map[string]any{
+					"name": "Andy",
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {custom: {_ne: null, age: {_le: 250}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "John"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithUnknownOperatorOnJSONPath_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						custom: JSON
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {custom: {tree: {_unknown: "oak"}}}) {
+						name
+					}
+				}`,
+				ExpectedError: "Argument \"filter\" has invalid value {custom: {tree: {_unknown: \"oak\"}}}.",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithMinAndMaxOnEmptyCollection(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, min and max on empty",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					_min(Users: {field: Age})
+					_max(Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_min": nil,
+					"_max": nil,
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMinAndMax(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, min and max of all comparable field kinds",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"HeightM": 1.82,
+					"CreatedAt": "2017-07-23T03:46:56-05:00"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30,
+					"HeightM": 1.65,
+					"CreatedAt": "2019-07-23T03:46:56-05:00"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					minAge: _min(Users: {field: Age})
+					maxAge: _max(Users: {field: Age})
+					minHeight: _min(Users: {field: HeightM})
+					maxHeight: _max(Users: {field: HeightM})
+					minCreatedAt: _min(Users: {field: CreatedAt})
+					maxCreatedAt: _max(Users: {field: CreatedAt})
+					minName: _min(Users: {field: Name})
+					maxName: _max(Users: {field: Name})
+				}`,
+				Results: map[string]any{
+					"minAge":       int64(21),
+					"maxAge":       int64(30),
+					"minHeight":    float64(1.65),
+					"maxHeight":    float64(1.82),
+					"minCreatedAt": testUtils.MustParseTime("2017-07-23T03:46:56-05:00"),
+					"maxCreatedAt": testUtils.MustParseTime("2019-07-23T03:46:56-05:00"),
+					"minName":      "Alice",
+					"maxName":      "John",
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMinWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, min with filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_min(Users: {field: Age, filter: {Age: {_gt: 26}}})
+				}`,
+				Results: map[string]any{
+					"_min": int64(30),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByAndMinAndMax(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, group by with min and max of the group",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 38
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Name], order: {_max: DESC}) {
+						Name
+						_min(_group: {field: Age})
+						_max(_group: {field: Age})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+							"_min": int64(21),
+							"_max": int64(38),
+						},
+						{
+							"Name": "Bob",
+							"_min": int64(30),
+							"_max": int64(30),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithOrderByUnselectedAggregate_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, order by an aggregate that is not selected",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Name], order: {_max: DESC}) {
+						Name
+					}
+				}`,
+				ExpectedError: "aggregates can only be ordered by when they are selected",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByAndCountDistinct(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, group by with count of the distinct values of a field",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Email": "john@source.hub",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 38
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Name]) {
+						Name
+						all: _count(_group: {})
+						ages: _count(_group: {field: Age})
+						distinctAges: _count(_group: {field: Age, distinct: true})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name":         "John",
+							"all":          4,
+							"ages":         3,
+							"distinctAges": 2,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -62,6 +62,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users__favouriteIntegers__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": "Boolean",
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -88,6 +94,18 @@ func TestSchemaAggregateInlineArrayCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": "Boolean",
+													},
+												},
+												map[string]any{
+													"name": "field",
+													"type": map[string]any{
+														"name": "UsersComparableFieldsArg",
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -114,6 +132,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users___version__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": "Boolean",
+													},
+												},
 												map[string]any{
 													"name": "limit",
 													"type": map[string]any{
@@ -393,6 +417,20 @@ var aggregateGroupArg = map[string]any{
 	"type": map[string]any{
 		"name": "Users__CountSelector",
 		"inputFields": []any{
+			map[string]any{
+				"name": "distinct",
+				"type": map[string]any{
+					"name":        "Boolean",
+					"inputFields": nil,
+				},
+			},
+			map[string]any{
+				"name": "field",
+				"type": map[string]any{
+					"name":        "UsersComparableFieldsArg",
+					"inputFields": nil,
+				},
+			},
 			map[string]any{
 				"name": "filter",
 				"type": map[string]any{
@@ -448,6 +486,13 @@ var aggregateVersionArg = map[string]any{
 	"type": map[string]any{
 		"name": "Users___version__CountSelector",
 		"inputFields": []any{
+			map[string]any{
+				"name": "distinct",
+				"type": map[string]any{
+					"name":        "Boolean",
+					"inputFields": nil,
+				},
+			},
 			map[string]any{
 				"name": "limit",
 				"type": map[string]any{
@@ -517,6 +562,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableBooleanCountFilter(t *tes
 										"type": map[string]any{
 											"name": "Users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -643,6 +695,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersBooleanCountFilter(t *testing.T)
 										"type": map[string]any{
 											"name": "Users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -769,6 +828,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableIntegerCountFilter(t *tes
 										"type": map[string]any{
 											"name": "Users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -919,6 +985,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersIntegerCountFilter(t *testing.T)
 										"type": map[string]any{
 											"name": "Users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1069,6 +1142,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableFloatCountFilter(t *testi
 										"type": map[string]any{
 											"name": "Users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1219,6 +1299,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersFloatCountFilter(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1369,6 +1456,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 										"type": map[string]any{
 											"name": "Users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1434,6 +1528,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_search",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 														},
 													},
 												},
@@ -1519,6 +1619,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1584,6 +1691,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_search",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 														},
 													},
 												},
@@ -59,6 +59,18 @@ func TestSchemaAggregateSimpleCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": "Boolean",
+													},
+												},
+												map[string]any{
+													"name": "field",
+													"type": map[string]any{
+														"name": "UsersComparableFieldsArg",
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -85,6 +97,12 @@ func TestSchemaAggregateSimpleCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users___version__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": "Boolean",
+													},
+												},
 												map[string]any{
 													"name": "limit",
 													"type": map[string]any{
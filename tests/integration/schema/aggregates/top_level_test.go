@@ -60,6 +60,18 @@ func TestSchemaAggregateTopLevelCreatesCountGivenSchema(t *testing.T) {
 											"type": map[string]any{
 												"name": "Users__CountSelector",
 												"inputFields": []any{
+													map[string]any{
+														"name": "distinct",
+														"type": map[string]any{
+															"name": "Boolean",
+														},
+													},
+													map[string]any{
+														"name": "field",
+														"type": map[string]any{
+															"name": "UsersComparableFieldsArg",
+														},
+													},
 													map[string]any{
 														"name": "filter",
 														"type": map[string]any{
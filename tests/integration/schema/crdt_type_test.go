@@ -225,3 +225,171 @@ func TestSchemaCreate_ContainsPCounterTypeWithWrongKind_Error(t *testing.T) {
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestSchemaCreate_ContainsORSetTypeWithArrayKind_NoError(t *testing.T) {
+	schemaVersionID := "bafkreia274s23f2lhmvncfd6ms6ynsdqftguvzihszbm7cct4yjwyrh63y"
+
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						tags: [String!] @crdt(type: "orset")
+					}
+				`,
+			},
+			testUtils.GetSchema{
+				VersionID: immutable.Some(schemaVersionID),
+				ExpectedResults: []client.SchemaDescription{
+					{
+						Name:      "Users",
+						VersionID: schemaVersionID,
+						Root:      schemaVersionID,
+						Fields: []client.SchemaFieldDescription{
+							{
+								Name: "_docID",
+								Kind: client.FieldKind_DocID,
+							},
+							{
+								Name: "tags",
+								Kind: client.FieldKind_STRING_ARRAY,
+								Typ:  client.OR_SET,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_ContainsORSetTypeWithWrongKind_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						tags: String @crdt(type: "orset")
+					}
+				`,
+				ExpectedError: "CRDT type orset can't be assigned to field kind String",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_ContainsListTypeWithArrayKind_NoError(t *testing.T) {
+	schemaVersionID := "bafkreielqjxgf2huvrhjazdvkaidv7lwgy77ix4rxr2jbdqs7tzb7wwb5a"
+
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						scores: [Int] @crdt(type: "list")
+					}
+				`,
+			},
+			testUtils.GetSchema{
+				VersionID: immutable.Some(schemaVersionID),
+				ExpectedResults: []client.SchemaDescription{
+					{
+						Name:      "Users",
+						VersionID: schemaVersionID,
+						Root:      schemaVersionID,
+						Fields: []client.SchemaFieldDescription{
+							{
+								Name: "_docID",
+								Kind: client.FieldKind_DocID,
+							},
+							{
+								Name: "scores",
+								Kind: client.FieldKind_NILLABLE_INT_ARRAY,
+								Typ:  client.LIST,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_ContainsListTypeWithWrongKind_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						scores: Int @crdt(type: "list")
+					}
+				`,
+				ExpectedError: "CRDT type list can't be assigned to field kind Int",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_ContainsBoundedCounterTypeWithIntKind_NoError(t *testing.T) {
+	schemaVersionID := "bafkreihosxh74hnueia56py7zfjdbpo4jjhe3n6rejwc7yy7wxz4fdul6e"
+
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						stock: Int @crdt(type: "bcounter")
+					}
+				`,
+			},
+			testUtils.GetSchema{
+				VersionID: immutable.Some(schemaVersionID),
+				ExpectedResults: []client.SchemaDescription{
+					{
+						Name:      "Products",
+						VersionID: schemaVersionID,
+						Root:      schemaVersionID,
+						Fields: []client.SchemaFieldDescription{
+							{
+								Name: "_docID",
+								Kind: client.FieldKind_DocID,
+							},
+							{
+								Name: "stock",
+								Kind: client.FieldKind_NILLABLE_INT,
+								Typ:  client.BOUNDED_COUNTER,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_ContainsBoundedCounterTypeWithFloatKind_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						stock: Float @crdt(type: "bcounter")
+					}
+				`,
+				ExpectedError: "CRDT type bcounter can't be assigned to field kind Float",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -126,6 +126,20 @@ var aggregateFields = fields{
 			"name": "Int",
 		},
 	},
+	map[string]any{
+		"name": "_max",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "Comparable",
+		},
+	},
+	map[string]any{
+		"name": "_min",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "Comparable",
+		},
+	},
 	map[string]any{
 		"name": "_sum",
 		"type": map[string]any{
@@ -205,7 +219,12 @@ type argDef struct {
 
 func buildOrderArg(objectName string, fields []argDef) Field {
 	inputFields := []any{
+		makeInputObject("_avg", "Ordering", nil),
+		makeInputObject("_count", "Ordering", nil),
 		makeInputObject("_docID", "Ordering", nil),
+		makeInputObject("_max", "Ordering", nil),
+		makeInputObject("_min", "Ordering", nil),
+		makeInputObject("_sum", "Ordering", nil),
 	}
 
 	for _, field := range fields {
@@ -97,6 +97,20 @@ func TestInputTypeOfOrderFieldWhereSchemaHasManyRelationType(t *testing.T) {
 											"name":   "groupOrderArg",
 											"ofType": nil,
 											"inputFields": []any{
+												map[string]any{
+													"name": "_avg",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
+												map[string]any{
+													"name": "_count",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
 												map[string]any{
 													"name": "_docID",
 													"type": map[string]any{
@@ -104,6 +118,27 @@ func TestInputTypeOfOrderFieldWhereSchemaHasManyRelationType(t *testing.T) {
 														"ofType": nil,
 													},
 												},
+												map[string]any{
+													"name": "_max",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
+												map[string]any{
+													"name": "_min",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
+												map[string]any{
+													"name": "_sum",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
 											},
 										},
 									},
@@ -186,6 +221,20 @@ func TestInputTypeOfOrderFieldWhereSchemaHasRelationType(t *testing.T) {
 											"name":   "authorOrderArg",
 											"ofType": nil,
 											"inputFields": []any{
+												map[string]any{
+													"name": "_avg",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
+												map[string]any{
+													"name": "_count",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
 												map[string]any{
 													"name": "_docID",
 													"type": map[string]any{
@@ -193,6 +242,27 @@ func TestInputTypeOfOrderFieldWhereSchemaHasRelationType(t *testing.T) {
 														"ofType": nil,
 													},
 												},
+												map[string]any{
+													"name": "_max",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
+												map[string]any{
+													"name": "_min",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
+												map[string]any{
+													"name": "_sum",
+													"type": map[string]any{
+														"name":   "Ordering",
+														"ofType": nil,
+													},
+												},
 												map[string]any{
 													"name": "age",
 													"type": map[string]any{
@@ -16,7 +16,7 @@ import (
 	testUtils "github.com/sourcenetwork/defradb/tests/integration"
 )
 
-func TestSchemaUpdatesRemoveFieldErrors(t *testing.T) {
+func TestSchemaUpdatesRemoveField(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Test schema update, remove field",
 		Actions: []any{
@@ -28,13 +28,146 @@ func TestSchemaUpdatesRemoveFieldErrors(t *testing.T) {
 					}
 				`,
 			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"email": "john@source.hub"
+				}`,
+			},
 			testUtils.SchemaPatch{
 				Patch: `
 					[
 						{ "op": "remove", "path": "/Users/Fields/2" }
 					]
 				`,
-				ExpectedError: "deleting an existing field is not supported. Name: name",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						email
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"email": "john@source.hub",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				ExpectedError: `Cannot query field "name" on type "Users".`,
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesRemoveField_ThenAddFieldWithSameName_DoesNotReturnOldValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove field and add it back",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						email: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"email": "john@source.hub"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Fields/2" }
+					]
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "name", "Kind": "String"} }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						email
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":  nil,
+							"email": "john@source.hub",
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesRemoveDocIDFieldErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove docID field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Fields/0" }
+					]
+				`,
+				ExpectedError: "deleting a built-in or relation field is not supported. Name: _docID",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesRemoveRelationFieldErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove relation field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						pet: Pets
+					}
+					type Pets {
+						name: String
+						owner: Users @primary
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Pets/Fields/2" }
+					]
+				`,
+				ExpectedError: "deleting a built-in or relation field is not supported. Name: owner",
 			},
 		},
 	}
@@ -59,7 +192,7 @@ func TestSchemaUpdatesRemoveAllFieldsErrors(t *testing.T) {
 						{ "op": "remove", "path": "/Users/Fields" }
 					]
 				`,
-				ExpectedError: "deleting an existing field is not supported",
+				ExpectedError: "deleting a built-in or relation field is not supported. Name: _docID",
 			},
 		},
 	}
@@ -84,7 +217,7 @@ func TestSchemaUpdatesRemoveFieldNameErrors(t *testing.T) {
 						{ "op": "remove", "path": "/Users/Fields/2/Name" }
 					]
 				`,
-				ExpectedError: "deleting an existing field is not supported. Name: name",
+				ExpectedError: "Names must match /^[_a-zA-Z][_a-zA-Z0-9]*$/ but \"\" does not.",
 			},
 		},
 	}
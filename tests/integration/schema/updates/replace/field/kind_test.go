@@ -0,0 +1,245 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replace
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesReplaceFieldKind_IntToFloat(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind Int with Float",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "Float" }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"age": 21.5
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {age: ASC}) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  float64(21),
+						},
+						{
+							"name": "Shahzad",
+							"age":  float64(21.5),
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_IntArrayToNillableFloatArray(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind [Int!] with [Float]",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						scores: [Int!]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"scores": [1, 2]
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/scores/Kind", "value": "[Float]" }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						scores
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"scores": []immutable.Option[float64]{
+								immutable.Some[float64](1),
+								immutable.Some[float64](2),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_IntToFloatWithIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind Int with Float on an indexed field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "Float" }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {age: {_eq: 21}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_StringToIntWithoutMigration_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind String with Int without a migration",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "Int" }
+					]
+				`,
+				ExpectedError: "changing the kind of a field requires a migration. " +
+					"Name: age, ExistingKind: String, ProposedKind: Int",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_FloatToIntWithoutMigration_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind Float with Int without a migration",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Float
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "Int" }
+					]
+				`,
+				ExpectedError: "changing the kind of a field requires a migration. " +
+					"Name: age, ExistingKind: Float, ProposedKind: Int",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_ToRelationErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind with a relation",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "Users" }
+					]
+				`,
+				ExpectedError: "mutating an existing field is not supported. ProposedName: age",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
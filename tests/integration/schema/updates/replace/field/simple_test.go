@@ -16,7 +16,7 @@ import (
 	testUtils "github.com/sourcenetwork/defradb/tests/integration"
 )
 
-func TestSchemaUpdatesReplaceFieldErrors(t *testing.T) {
+func TestSchemaUpdatesReplaceField(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Test schema update, replace field",
 		Actions: []any{
@@ -28,20 +28,41 @@ func TestSchemaUpdatesReplaceFieldErrors(t *testing.T) {
 					}
 				`,
 			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"email": "john@source.hub"
+				}`,
+			},
 			testUtils.SchemaPatch{
 				Patch: `
 					[
 						{ "op": "replace", "path": "/Users/Fields/2", "value": {"Name": "Fax", "Kind": 11} }
 					]
 				`,
-				ExpectedError: "deleting an existing field is not supported. Name: name",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Fax
+						email
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Fax":   nil,
+							"email": "john@source.hub",
+						},
+					},
+				},
 			},
 		},
 	}
 	testUtils.ExecuteTestCase(t, test)
 }
 
-func TestSchemaUpdatesReplaceFieldWithIDErrors(t *testing.T) {
+func TestSchemaUpdatesReplaceFieldWithID(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Test schema update, replace field with correct ID",
 		Actions: []any{
@@ -53,13 +74,34 @@ func TestSchemaUpdatesReplaceFieldWithIDErrors(t *testing.T) {
 					}
 				`,
 			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"email": "john@source.hub"
+				}`,
+			},
 			testUtils.SchemaPatch{
 				Patch: `
 					[
 						{ "op": "replace", "path": "/Users/Fields/2", "value": {"Name": "fax", "Kind": 11} }
 					]
 				`,
-				ExpectedError: "deleting an existing field is not supported. Name: name",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						fax
+						email
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"fax":   nil,
+							"email": "john@source.hub",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -81,6 +81,26 @@ type ConfigureNode func() []net.NodeOpt
 // Restart is an action that will close and then start all nodes.
 type Restart struct{}
 
+// Close is an action that will close a node.
+//
+// Closed nodes must be started again, using a [Start] action, before the end of the test.
+type Close struct {
+	// NodeID may hold the ID (index) of a node to close.
+	//
+	// If a value is not provided all nodes will be closed.
+	NodeID immutable.Option[int]
+}
+
+// Start is an action that will start a node that has been previously closed.
+//
+// The node will be reconnected to the peers it was connected to before it was closed.
+type Start struct {
+	// NodeID may hold the ID (index) of a node to start.
+	//
+	// If a value is not provided all nodes will be started.
+	NodeID immutable.Option[int]
+}
+
 // SchemaUpdate is an action that will update the database schema.
 //
 // WARNING: getCollectionNames will not work with schemas ending in `type`, e.g. `user_type`
@@ -344,6 +364,31 @@ type DeleteDoc struct {
 	ExpectedError string
 }
 
+// PurgeDoc will attempt to purge the given document, removing its history along with it.
+type PurgeDoc struct {
+	// NodeID may hold the ID (index) of a node to apply this purge to.
+	//
+	// If a value is not provided the document will be purged in all nodes.
+	NodeID immutable.Option[int]
+
+	// The identity of this request. Optional.
+	Identity immutable.Option[int]
+
+	// The collection in which this document should be purged.
+	CollectionID int
+
+	// The index-identifier of the document within the collection.  This is based on
+	// the order in which it was created, not the ordering of the document within the
+	// database.
+	DocID int
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
 // UpdateDoc will attempt to update the given document using the set [MutationType].
 type UpdateDoc struct {
 	// NodeID may hold the ID (index) of a node to apply this update to.
@@ -421,6 +466,41 @@ type UpdateWithFilter struct {
 	SkipLocalUpdateEvent bool
 }
 
+// UpsertWithFilter will update the document that matches the given filter, or create
+// the given document if no document matches the filter.
+type UpsertWithFilter struct {
+	// NodeID may hold the ID (index) of a node to apply this upsert to.
+	//
+	// If a value is not provided the upsert will be applied to all nodes.
+	NodeID immutable.Option[int]
+
+	// The identity of this request. Optional.
+	//
+	// If an Identity is not provided then can only update public document(s).
+	//
+	// If an Identity is provided and the collection has a policy, then
+	// can also update private document(s) that are owned by this Identity.
+	Identity immutable.Option[int]
+
+	// The collection in which this document exists.
+	CollectionID int
+
+	// The filter to match documents against.
+	Filter any
+
+	// The document to create if no document matches the filter.
+	Doc string
+
+	// The update to apply to the matched document.
+	Updater string
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
 // IndexField describes a field to be indexed.
 type IndexedField struct {
 	// Name contains the name of the field.
@@ -452,6 +532,12 @@ type CreateIndex struct {
 	// If Unique is true, the index will be created as a unique index.
 	Unique bool
 
+	// The type of the index. Optional, defaults to an index on the field values.
+	Type client.IndexType
+
+	// The filters of the analyzer of a full-text index. Optional.
+	Analyzer []client.IndexAnalyzerFilter
+
 	// Any error expected from the action. Optional.
 	//
 	// String can be a partial, and the test will pass if an error is returned that
@@ -507,6 +593,32 @@ type GetIndexes struct {
 	ExpectedError string
 }
 
+// CompactCollection will attempt to compact the history of the documents in the given collection
+// using the db api.
+type CompactCollection struct {
+	// NodeID may hold the ID (index) of a node to compact the collection on.
+	//
+	// If a value is not provided the collection will be compacted on all nodes.
+	NodeID immutable.Option[int]
+
+	// The collection which should be compacted.
+	CollectionID int
+
+	// The retention policy to compact the collection with.
+	//
+	// If a value is not provided the retention policy of the collection is used.
+	Policy immutable.Option[client.RetentionPolicy]
+
+	// The expected result of the compaction. Optional.
+	ExpectedResult immutable.Option[client.CompactionResult]
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
 // ResultAsserter is an interface that can be implemented to provide custom result
 // assertions.
 type ResultAsserter interface {
@@ -231,7 +231,7 @@ func executeTestCase(
 
 	// It is very important that the databases are always closed, otherwise resources will leak
 	// as tests run.  This is particularly important for file based datastores.
-	defer closeNodes(s)
+	defer closeNodes(s, immutable.None[int]())
 
 	// Documents and Collections may already exist in the database if actions have been split
 	// by the change detector so we should fetch them here at the start too (if they exist).
@@ -272,6 +272,12 @@ func performAction(
 	case Restart:
 		restartNodes(s)
 
+	case Close:
+		stopNodes(s, action.NodeID)
+
+	case Start:
+		startNodes(s, action.NodeID)
+
 	case ConnectPeers:
 		connectPeers(s, action)
 
@@ -287,9 +293,15 @@ func performAction(
 	case UnsubscribeToCollection:
 		unsubscribeToCollection(s, action)
 
+	case GetAllReplicators:
+		getAllReplicators(s, action)
+
 	case GetAllP2PCollections:
 		getAllP2PCollections(s, action)
 
+	case SyncCollections:
+		syncCollections(s, action)
+
 	case SchemaUpdate:
 		updateSchema(s, action)
 
@@ -320,18 +332,30 @@ func performAction(
 	case AddPolicy:
 		addPolicyACP(s, action)
 
+	case AddDocActorRelationship:
+		addDocActorRelationshipACP(s, action)
+
+	case DeleteDocActorRelationship:
+		deleteDocActorRelationshipACP(s, action)
+
 	case CreateDoc:
 		createDoc(s, action)
 
 	case DeleteDoc:
 		deleteDoc(s, action)
 
+	case PurgeDoc:
+		purgeDoc(s, action)
+
 	case UpdateDoc:
 		updateDoc(s, action)
 
 	case UpdateWithFilter:
 		updateWithFilter(s, action)
 
+	case UpsertWithFilter:
+		upsertWithFilter(s, action)
+
 	case CreateIndex:
 		createIndex(s, action)
 
@@ -341,6 +365,9 @@ func performAction(
 	case GetIndexes:
 		getIndexes(s, action)
 
+	case CompactCollection:
+		compactCollection(s, action)
+
 	case BackupExport:
 		backupExport(s, action)
 
@@ -528,11 +555,14 @@ func getCollectionNamesFromSchema(result map[string]int, schema string, nextInde
 	return nextIndex
 }
 
-// closeNodes closes all the given nodes, ensuring that resources are properly released.
+// closeNodes closes the given nodes, ensuring that resources are properly released.
+//
+// If nodeID has a value it will close that node only, otherwise all nodes will be closed.
 func closeNodes(
 	s *state,
+	nodeID immutable.Option[int],
 ) {
-	for _, node := range s.nodes {
+	for _, node := range getNodes(nodeID, s.nodes) {
 		node.Close()
 	}
 }
@@ -697,10 +727,46 @@ func restartNodes(
 	if s.dbt == badgerIMType || s.dbt == defraIMType {
 		return
 	}
-	closeNodes(s)
+	closeNodes(s, immutable.None[int]())
+	startNodes(s, immutable.None[int]())
+}
+
+// stopNodes closes the given nodes so that they may be started again by a [Start] action.
+//
+// If nodeID has a value it will close that node only, otherwise all nodes will be closed.
+//
+// In-memory nodes cannot be closed and started again without losing their state, so
+// this is a no-op for in-memory database types.
+func stopNodes(
+	s *state,
+	nodeID immutable.Option[int],
+) {
+	if s.dbt == badgerIMType || s.dbt == defraIMType {
+		return
+	}
+	closeNodes(s, nodeID)
+}
+
+// startNodes starts the given nodes that have been previously closed.
+//
+// If nodeID has a value it will start that node only, otherwise all nodes will be started.
+//
+// In-memory nodes cannot be closed and started again without losing their state, so
+// this is a no-op for in-memory database types.
+func startNodes(
+	s *state,
+	nodeID immutable.Option[int],
+) {
+	if s.dbt == badgerIMType || s.dbt == defraIMType {
+		return
+	}
 
 	// We need to restart the nodes in reverse order, to avoid dial backoff issues.
 	for i := len(s.nodes) - 1; i >= 0; i-- {
+		if nodeID.HasValue() && nodeID.Value() != i {
+			continue // node is not selected
+		}
+
 		originalPath := databaseDir
 		databaseDir = s.dbPaths[i]
 		node, _, err := setupNode(s)
@@ -730,7 +796,7 @@ func restartNodes(
 		nodeOpts := s.nodeConfigs[i]
 		nodeOpts = append(nodeOpts, net.WithListenAddresses(addresses...))
 
-		node.Peer, err = net.NewPeer(s.ctx, node.DB.Blockstore(), node.DB.Events(), nodeOpts...)
+		node.Peer, err = net.NewPeer(s.ctx, node.DB, node.DB.Events(), nodeOpts...)
 		require.NoError(s.t, err)
 
 		c, err := setupClient(s, node)
@@ -811,7 +877,7 @@ func configureNode(
 	nodeOpts := action()
 	nodeOpts = append(nodeOpts, net.WithPrivateKey(privateKey))
 
-	node.Peer, err = net.NewPeer(s.ctx, node.DB.Blockstore(), node.DB.Events(), nodeOpts...)
+	node.Peer, err = net.NewPeer(s.ctx, node.DB, node.DB.Events(), nodeOpts...)
 	require.NoError(s.t, err)
 
 	s.nodeAddresses = append(s.nodeAddresses, node.Peer.PeerInfo())
@@ -1395,6 +1461,39 @@ func deleteDoc(
 	}
 }
 
+func purgeDoc(
+	s *state,
+	action PurgeDoc,
+) {
+	docID := s.docIDs[action.CollectionID][action.DocID]
+
+	var expectedErrorRaised bool
+	actionNodes := getNodes(action.NodeID, s.nodes)
+	for nodeID, collections := range getNodeCollections(action.NodeID, s.collections) {
+		identity := getIdentity(s, nodeID, action.Identity)
+		ctx := db.SetContextIdentity(s.ctx, identity)
+
+		err := withRetry(
+			actionNodes,
+			nodeID,
+			func() error {
+				_, err := collections[action.CollectionID].Purge(ctx, docID)
+				return err
+			},
+		)
+		expectedErrorRaised = AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+	}
+
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+
+	if action.ExpectedError == "" {
+		docIDs := map[string]struct{}{
+			docID.String(): {},
+		}
+		waitForUpdateEvents(s, action.NodeID, docIDs)
+	}
+}
+
 // updateDoc updates a document using the chosen [mutationType].
 func updateDoc(
 	s *state,
@@ -1535,6 +1634,38 @@ func updateWithFilter(s *state, action UpdateWithFilter) {
 	}
 }
 
+// upsertWithFilter updates the matched document, or creates a new one if none match.
+func upsertWithFilter(s *state, action UpsertWithFilter) {
+	var res *client.UpsertResult
+	var expectedErrorRaised bool
+	actionNodes := getNodes(action.NodeID, s.nodes)
+	for nodeID, collections := range getNodeCollections(action.NodeID, s.collections) {
+		identity := getIdentity(s, nodeID, action.Identity)
+		ctx := db.SetContextIdentity(s.ctx, identity)
+
+		collection := collections[action.CollectionID]
+		doc, err := client.NewDocFromJSON([]byte(action.Doc), collection.Definition())
+		require.NoError(s.t, err)
+
+		err = withRetry(
+			actionNodes,
+			nodeID,
+			func() error {
+				var err error
+				res, err = collection.Upsert(ctx, action.Filter, doc, action.Updater)
+				return err
+			},
+		)
+		expectedErrorRaised = AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+	}
+
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+
+	if action.ExpectedError == "" {
+		waitForUpdateEvents(s, action.NodeID, map[string]struct{}{res.DocID: {}})
+	}
+}
+
 // createIndex creates a secondary index using the collection api.
 func createIndex(
 	s *state,
@@ -1565,6 +1696,8 @@ func createIndex(
 			}
 		}
 		indexDesc.Unique = action.Unique
+		indexDesc.Type = action.Type
+		indexDesc.Analyzer = action.Analyzer
 		err := withRetry(
 			actionNodes,
 			nodeID,
@@ -1612,6 +1745,36 @@ func dropIndex(
 	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
 }
 
+// compactCollection compacts the history of the documents in a collection using the db api.
+func compactCollection(
+	s *state,
+	action CompactCollection,
+) {
+	var expectedErrorRaised bool
+	actionNodes := getNodes(action.NodeID, s.nodes)
+	for nodeID, collections := range getNodeCollections(action.NodeID, s.collections) {
+		name := collections[action.CollectionID].Name().Value()
+
+		var result client.CompactionResult
+		err := withRetry(
+			actionNodes,
+			nodeID,
+			func() error {
+				var err error
+				result, err = actionNodes[nodeID].CompactCollection(s.ctx, name, action.Policy)
+				return err
+			},
+		)
+		expectedErrorRaised = AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+
+		if !expectedErrorRaised && action.ExpectedResult.HasValue() {
+			assert.Equal(s.t, action.ExpectedResult.Value(), result, s.testCase.Description)
+		}
+	}
+
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+}
+
 // backupExport generates a backup using the db api.
 func backupExport(
 	s *state,